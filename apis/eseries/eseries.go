@@ -14,6 +14,7 @@ import (
 	"fmt"
 
 	"strconv"
+	"strings"
 
 	"github.com/netapp/netappdvp/utils"
 
@@ -22,6 +23,9 @@ import (
 
 const maxNameLength int = 30
 
+// optionTagPrefix marks the volume metadata tags that record the options a volume was created with
+const optionTagPrefix string = "opt."
+
 // VolumeInfo hold all the information about a constructed volume on E-Series array and Docker Host Mapping
 type VolumeInfo struct {
 	VolumeGroupRef string
@@ -37,6 +41,13 @@ type VolumeInfo struct {
 	IsVolumeMapped bool
 	LunMappingRef  string
 	LunNumber      int
+
+	Opts map[string]string
+
+	//Set for snapshot volumes, which are created as clones of a snapshot image of another volume
+	IsSnapshotVolume bool
+	CloneSource      string
+	CloneSnapshot    string
 }
 
 // DriverConfig holds the configuration data for Driver objects
@@ -57,6 +68,8 @@ type DriverConfig struct {
 
 	//Host Connectivity
 	HostDataIP string //for iSCSI with multipathing this can be either IP on host
+	HostGroup  string //host group the hosts of the cluster share, empty to map volumes to each host
+	HostType   string //host type of created hosts, one of the keys of HostTypes
 
 	//Internal Config Variables
 	ArrayID string //Unique ID for array once added to web proxy services
@@ -355,6 +368,8 @@ func (d Driver) VerifyVolumeExists(name string) (err error) {
 				tmpVolumeInfo.LunNumber = f.LunNumber
 			}
 
+			tmpVolumeInfo.Opts = optionsFromTags(e.VolumeTags)
+
 			//Add it to map
 			d.config.Volumes[name] = &tmpVolumeInfo
 
@@ -363,6 +378,15 @@ func (d Driver) VerifyVolumeExists(name string) (err error) {
 		}
 	}
 
+	if !foundVolume {
+		//Clones are snapshot volumes, which are listed separately from standard volumes
+		foundSnapshotVolume, err := d.verifySnapshotVolumeExists(name, responseJSON)
+		if err != nil {
+			return err
+		}
+		foundVolume = foundSnapshotVolume
+	}
+
 	if !foundVolume {
 		return fmt.Errorf("ESeriesStorageDriver::VerifyVolumeExists - volume with name %s not found on array! Are you sure you created a volume with this name?", name)
 	}
@@ -428,7 +452,7 @@ func (d Driver) IsVolumeAlreadyMappedToHost(name string, hostRef string) (isMapp
 	return false, -1, nil
 }
 
-func (d Driver) CreateVolume(name string, volumeGroupRef string, size string, mediaType string) (volumeRef string, err error) {
+func (d Driver) CreateVolume(name string, volumeGroupRef string, size string, mediaType string, opts map[string]string) (volumeRef string, err error) {
 
 	//Verify we have a valid array id
 	if d.config.ArrayID == "" {
@@ -447,7 +471,7 @@ func (d Driver) CreateVolume(name string, volumeGroupRef string, size string, me
 	msgCreateVolume.Name = name
 	msgCreateVolume.SizeUnit = "kb" //bytes, b, kb, mb, gb, tb, pb, eb, zb, yb
 	msgCreateVolume.SegmentSize = 128
-	msgCreateVolume.VolumeTags = volumeTags
+	msgCreateVolume.VolumeTags = append(append([]VolumeTag{}, volumeTags...), optionTags(opts)...)
 
 	//Convert size string to int64
 	convertedSize, convertErr := utils.ConvertSizeToBytes64(size)
@@ -506,6 +530,7 @@ func (d Driver) CreateVolume(name string, volumeGroupRef string, size string, me
 		tmpVolumeInfo.IsVolumeMapped = false
 		tmpVolumeInfo.LunMappingRef = ""
 		tmpVolumeInfo.LunNumber = -1
+		tmpVolumeInfo.Opts = utils.CopyOpts(opts)
 
 		//Add it to map
 		d.config.Volumes[name] = &tmpVolumeInfo
@@ -532,6 +557,36 @@ func (d Driver) CreateVolume(name string, volumeGroupRef string, size string, me
 	return "", fmt.Errorf("Unreachable Code Path!")
 }
 
+// GetVolumeOpts returns the options recorded in the metadata of the named volume
+func (d Driver) GetVolumeOpts(name string) (opts map[string]string, err error) {
+
+	if err := d.VerifyVolumeExists(name); err != nil {
+		return nil, err
+	}
+
+	return utils.CopyOpts(d.config.Volumes[name].Opts), nil
+}
+
+// optionTags converts volume create options into metadata tags
+func optionTags(opts map[string]string) []VolumeTag {
+	tags := make([]VolumeTag, 0, len(opts))
+	for k, v := range opts {
+		tags = append(tags, VolumeTag{Key: optionTagPrefix + k, Value: v})
+	}
+	return tags
+}
+
+// optionsFromTags recovers the volume create options recorded by optionTags
+func optionsFromTags(tags []VolumeTag) map[string]string {
+	opts := make(map[string]string)
+	for _, tag := range tags {
+		if strings.HasPrefix(tag.Key, optionTagPrefix) {
+			opts[strings.TrimPrefix(tag.Key, optionTagPrefix)] = tag.Value
+		}
+	}
+	return opts
+}
+
 func (d Driver) VerifyHostIQN(iqn string) (hostRef string, err error) {
 
 	//Verify we have a valid array id
@@ -718,8 +773,14 @@ func (d Driver) DestroyVolume(name string) (err error) {
 		panic("ERROR - volume was found in volumeInfo map but has invalid volumeRef!")
 	}
 
+	//Snapshot volumes are removed through their own resource
+	resource := "/volumes/"
+	if tmpVolumeInfo.IsSnapshotVolume {
+		resource = "/snapshot-volumes/"
+	}
+
 	//Send a DELETE to remove this volume from storage array
-	resp, err := d.SendMsg(nil, "DELETE", "/volumes/"+tmpVolumeInfo.VolumeRef)
+	resp, err := d.SendMsg(nil, "DELETE", resource+tmpVolumeInfo.VolumeRef)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != GenericResponseOkay && resp.StatusCode != GenericResponseNoContent {
@@ -59,13 +59,16 @@ type MsgVolumeExResponse struct {
 	VolumeGroupRef string       `json:"volumeGroupRef"`
 	ListOfMappings []LUNMapping `json:"listOfMappings"`
 	IsMapped       bool         `json:"mapped"`
+	VolumeTags     []VolumeTag  `json:"metadata"`
 }
 
 //Obtain information about all hosts on array
 type HostExResponse struct {
-	HostRef    string            `json:"hostRef"`
-	Label      string            `json:"label"`
-	Initiators []HostExInitiator `json:"initiators"`
+	HostRef       string            `json:"hostRef"`
+	ClusterRef    string            `json:"clusterRef"` //host group, all zeros if none
+	Label         string            `json:"label"`
+	HostTypeIndex int               `json:"hostTypeIndex"`
+	Initiators    []HostExInitiator `json:"initiators"`
 }
 
 type HostExInitiator struct {
@@ -83,6 +86,43 @@ type HostExHostPort struct {
 	//TODO - I think this would be used to support Fiber Channel
 }
 
+//Host types known to the array
+type HostType struct {
+	Index int    `json:"index"`
+	Code  string `json:"code,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+//Create a host with its iSCSI ports
+type HostCreateRequest struct {
+	Name     string     `json:"name"`
+	HostType HostType   `json:"hostType"`
+	GroupID  string     `json:"groupId,omitempty"`
+	Ports    []HostPort `json:"ports"`
+}
+
+type HostPort struct {
+	Type  string `json:"type"` //iscsi, fc, sas, ib
+	Port  string `json:"port"`
+	Label string `json:"label"`
+}
+
+//Move an existing host into a host group
+type HostUpdateRequest struct {
+	GroupID string `json:"groupId"`
+}
+
+//Host groups, which volumes may be mapped to instead of a single host
+type HostGroupCreateRequest struct {
+	Name  string   `json:"name"`
+	Hosts []string `json:"hosts"`
+}
+
+type HostGroup struct {
+	ClusterRef string `json:"clusterRef"`
+	Label      string `json:"label"`
+}
+
 //Request to map a created volume to a host
 type VolumeMappingCreateRequest struct {
 	MappableObjectId string `json:"mappableObjectId"`
@@ -105,3 +145,52 @@ type CallResponseError struct {
 	ReturnCode   string `json:"retcode"`
 	CodeType     string `json:"codeType"` //'symbol', 'webservice', 'systemerror', 'devicemgrerror'
 }
+
+//Create a snapshot group, which holds the snapshot images of a base volume
+type SnapshotGroupCreateRequest struct {
+	BaseMappableObjectID string `json:"baseMappableObjectId"`
+	Name                 string `json:"name"`
+	RepositoryPercentage int    `json:"repositoryPercentage"`
+	WarningThreshold     int    `json:"warningThreshold"`
+	AutoDeleteLimit      int    `json:"autoDeleteLimit"`
+	FullPolicy           string `json:"fullPolicy"` //failbasewrites, purgepit
+	StoragePoolID        string `json:"storagePoolId,omitempty"`
+}
+
+type SnapshotGroup struct {
+	PitGroupRef string `json:"pitGroupRef"`
+	Label       string `json:"label"`
+	BaseVolume  string `json:"baseVolume"`
+}
+
+//Create a point-in-time snapshot image in a snapshot group
+type SnapshotImageCreateRequest struct {
+	GroupID string `json:"groupId"`
+}
+
+type SnapshotImage struct {
+	PitRef            string `json:"pitRef"`
+	PitGroupRef       string `json:"pitGroupRef"`
+	BaseVolume        string `json:"baseVol"`
+	PitTimestamp      string `json:"pitTimestamp"` //seconds since the epoch
+	PitSequenceNumber string `json:"pitSequenceNumber"`
+}
+
+//Create a snapshot volume, a mappable view of a snapshot image
+type SnapshotVolumeCreateRequest struct {
+	SnapshotImageID      string `json:"snapshotImageId"`
+	FullThreshold        int    `json:"fullThreshold"`
+	Name                 string `json:"name"`
+	ViewMode             string `json:"viewMode"` //readWrite, readOnly
+	RepositoryPercentage int    `json:"repositoryPercentage"`
+	RepositoryPoolID     string `json:"repositoryPoolId,omitempty"`
+}
+
+type SnapshotVolume struct {
+	ViewRef        string       `json:"viewRef"`
+	Label          string       `json:"label"`
+	BaseVolume     string       `json:"baseVol"`
+	BasePIT        string       `json:"basePIT"`
+	ListOfMappings []LUNMapping `json:"listOfMappings"`
+	IsMapped       bool         `json:"mapped"`
+}
@@ -0,0 +1,192 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package eseries
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// HostTypes maps the host types accepted in the config file to the codes the array knows them by
+var HostTypes = map[string]string{
+	"linux_dm_mp":       "LnxALUA",
+	"linux_atto":        "LnxTPGSALUA",
+	"linux_mpp_rdac":    "LNX",
+	"linux_pathmanager": "LnxTPGSALUA_PM",
+}
+
+// DefaultHostType is the type of the hosts created for Linux hosts using DM-MPIO multipathing
+const DefaultHostType = "linux_dm_mp"
+
+// inHostGroup reports whether the host belongs to a host group; the clusterRef of other hosts is all zeros
+func inHostGroup(host HostExResponse) bool {
+	return strings.Trim(host.ClusterRef, "0") != ""
+}
+
+// GetMappingTarget returns the reference that volumes are mapped to for the host with the given IQNs: the
+// configured host group if there is one, otherwise the host itself.  If create is set, a missing host is created
+// with the configured host type and an iSCSI port for each IQN, and a missing host group is created and the host
+// added to it.
+func (d Driver) GetMappingTarget(hostname string, iqns []string, create bool) (string, error) {
+
+	//Verify we have a valid array id
+	if d.config.ArrayID == "" {
+		return "", fmt.Errorf("ArrayID is invalid!")
+	}
+
+	host, err := d.getHost(iqns)
+	if err != nil {
+		return "", err
+	}
+
+	var group *HostGroup
+	if d.config.HostGroup != "" {
+		group, err = d.getHostGroup(d.config.HostGroup, create)
+		if err != nil {
+			return "", err
+		}
+		if group == nil {
+			return "", fmt.Errorf("Host group %s not found on array!", d.config.HostGroup)
+		}
+	}
+
+	if host == nil {
+		if !create {
+			//Volumes mapped to the host group can still be found without this host
+			if group != nil {
+				return group.ClusterRef, nil
+			}
+			return "", fmt.Errorf("Host reference not found on array for host IQNs %v!", iqns)
+		}
+		if host, err = d.createHost(hostname, iqns, group); err != nil {
+			return "", err
+		}
+	} else if group != nil && host.ClusterRef != group.ClusterRef {
+		if inHostGroup(*host) {
+			return "", fmt.Errorf("Host %s is in a different host group than %s!", host.Label, group.Label)
+		}
+		if create {
+			if err := d.sendJSON("POST", "/hosts/"+host.HostRef, HostUpdateRequest{GroupID: group.ClusterRef}, nil); err != nil {
+				return "", fmt.Errorf("Error adding host %s to host group %s: %v", host.Label, group.Label, err)
+			}
+			log.Debugf("Added host %s to host group %s", host.Label, group.Label)
+		}
+	}
+
+	if group != nil {
+		return group.ClusterRef, nil
+	}
+	return host.HostRef, nil
+}
+
+// getHost returns the host that has an iSCSI initiator with any of the given IQNs; nil means there is none
+func (d Driver) getHost(iqns []string) (*HostExResponse, error) {
+
+	hosts := make([]HostExResponse, 0)
+	if err := d.sendJSON("GET", "/hosts", nil, &hosts); err != nil {
+		return nil, err
+	}
+
+	for i, e := range hosts {
+		for _, f := range e.Initiators {
+			if f.NodeName.IoInterfaceType != "iscsi" {
+				continue
+			}
+			for _, iqn := range iqns {
+				if f.NodeName.IscsiNodeName == iqn {
+					log.Debugf("Found host %s (%s) with iqn=%s", e.Label, e.HostRef, iqn)
+					return &hosts[i], nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getHostGroup returns the named host group, creating it if create is set; nil means there is none
+func (d Driver) getHostGroup(name string, create bool) (*HostGroup, error) {
+
+	groups := make([]HostGroup, 0)
+	if err := d.sendJSON("GET", "/host-groups", nil, &groups); err != nil {
+		return nil, err
+	}
+
+	for i, e := range groups {
+		if e.Label == name {
+			return &groups[i], nil
+		}
+	}
+
+	if !create {
+		return nil, nil
+	}
+
+	group := &HostGroup{}
+	if err := d.sendJSON("POST", "/host-groups", HostGroupCreateRequest{Name: name, Hosts: []string{}}, group); err != nil {
+		return nil, fmt.Errorf("Error creating host group %s: %v", name, err)
+	}
+
+	log.Debugf("Created host group %s (%s)", group.Label, group.ClusterRef)
+
+	return group, nil
+}
+
+// createHost creates a host of the configured type with an iSCSI port for each IQN, in the group if there is one
+func (d Driver) createHost(hostname string, iqns []string, group *HostGroup) (*HostExResponse, error) {
+
+	if len(iqns) == 0 {
+		return nil, fmt.Errorf("No iSCSI initiator IQNs to create host %s with!", hostname)
+	}
+
+	hostType := d.config.HostType
+	if hostType == "" {
+		hostType = DefaultHostType
+	}
+	code, ok := HostTypes[hostType]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported host type %s!", hostType)
+	}
+
+	hostTypes := make([]HostType, 0)
+	if err := d.sendJSON("GET", "/host-types", nil, &hostTypes); err != nil {
+		return nil, err
+	}
+
+	request := HostCreateRequest{Name: hostname, HostType: HostType{Index: -1}}
+	for _, e := range hostTypes {
+		if e.Code == code {
+			request.HostType = HostType{Index: e.Index}
+		}
+	}
+	if request.HostType.Index == -1 {
+		return nil, fmt.Errorf("Host type %s (%s) not supported by the array!", hostType, code)
+	}
+
+	// Ensure that we do not exceed the maximum allowed name length, for the host or its port labels
+	if len(request.Name) > maxNameLength {
+		request.Name = request.Name[:maxNameLength]
+	}
+	labelPrefix := request.Name
+	if len(labelPrefix) > maxNameLength-3 {
+		labelPrefix = labelPrefix[:maxNameLength-3]
+	}
+	for i, iqn := range iqns {
+		request.Ports = append(request.Ports, HostPort{Type: "iscsi", Port: iqn, Label: fmt.Sprintf("%s_%d", labelPrefix, i)})
+	}
+
+	if group != nil {
+		request.GroupID = group.ClusterRef
+	}
+
+	host := &HostExResponse{}
+	if err := d.sendJSON("POST", "/hosts", request, host); err != nil {
+		return nil, fmt.Errorf("Error creating host %s: %v", request.Name, err)
+	}
+
+	log.Debugf("Created host %s (%s) with host type %s", host.Label, host.HostRef, code)
+
+	return host, nil
+}
@@ -0,0 +1,347 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package eseries
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// Snapshot groups and snapshot volumes keep their copy-on-write data in a repository volume allocated from the
+// base volume's pool, sized as a percentage of the base volume
+const (
+	snapshotRepositoryPercentage = 20
+	snapshotWarningThreshold     = 80
+)
+
+// sendJSON sends the request, if any, to the web services proxy and decodes the reply into response, if any
+func (d Driver) sendJSON(httpMethod, msgType string, request, response interface{}) error {
+
+	var data []byte
+	if request != nil {
+		var err error
+		if data, err = json.Marshal(request); err != nil {
+			return fmt.Errorf("Error defining JSON body: %v", err)
+		}
+	}
+
+	resp, err := d.SendMsg(data, httpMethod, msgType)
+	if err != nil {
+		return fmt.Errorf("Error communicating with the Web Services Proxy: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	log.Debugf("response Body:\n%s", string(body))
+
+	if resp.StatusCode != GenericResponseOkay && resp.StatusCode != GenericResponseSuccess && resp.StatusCode != GenericResponseNoContent {
+		//Offer more information about what went wrong with request than just HTTP error information
+		responseData := CallResponseError{}
+		if err := json.Unmarshal(body, &responseData); err == nil && responseData.ErrorMsg != "" {
+			return fmt.Errorf("%s %s failed! ErrorMsg=%s LocalizedMsg=%s StatusCode=%v", httpMethod, msgType, responseData.ErrorMsg, responseData.LocalizedMsg, resp.StatusCode)
+		}
+		return fmt.Errorf("%s %s failed! StatusCode=%v Status=%s", httpMethod, msgType, resp.StatusCode, resp.Status)
+	}
+
+	if response != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, response); err != nil {
+			return fmt.Errorf("Unable to deserialize JSON response: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// SnapshotImageName returns the name a snapshot image is known by; images have no label on the array, so they are
+// named after the time they were taken, followed by their sequence number in case several are taken in a second
+func SnapshotImageName(image SnapshotImage) string {
+	t, err := snapshotImageTime(image)
+	if err != nil {
+		return image.PitRef
+	}
+	return t.Format("20060102T150405Z") + "_" + image.PitSequenceNumber
+}
+
+// SnapshotImageCreated returns the UTC time the snapshot image was taken, in RFC3339 format
+func SnapshotImageCreated(image SnapshotImage) string {
+	t, err := snapshotImageTime(image)
+	if err != nil {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05Z")
+}
+
+func snapshotImageTime(image SnapshotImage) (time.Time, error) {
+	seconds, err := strconv.ParseInt(image.PitTimestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid timestamp %s on snapshot image %s", image.PitTimestamp, image.PitRef)
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// snapshotGroupName returns the label of the snapshot group created for the named volume
+func snapshotGroupName(name string) string {
+	if len(name) > maxNameLength-3 {
+		name = name[:maxNameLength-3]
+	}
+	return name + "_SG"
+}
+
+// GetSnapshotGroup returns the snapshot group holding the snapshot images of the named volume, creating it if
+// create is set; nil means the volume has no snapshot group
+func (d Driver) GetSnapshotGroup(name string, create bool) (*SnapshotGroup, error) {
+
+	if err := d.VerifyVolumeExists(name); err != nil {
+		return nil, err
+	}
+
+	tmpVolumeInfo := d.config.Volumes[name]
+	if tmpVolumeInfo.IsSnapshotVolume {
+		return nil, fmt.Errorf("Volume %s is a snapshot volume, which cannot have snapshots of its own", name)
+	}
+
+	groups := make([]SnapshotGroup, 0)
+	if err := d.sendJSON("GET", "/snapshot-groups", nil, &groups); err != nil {
+		return nil, err
+	}
+
+	for i, e := range groups {
+		if e.BaseVolume == tmpVolumeInfo.VolumeRef {
+			return &groups[i], nil
+		}
+	}
+
+	if !create {
+		return nil, nil
+	}
+
+	request := SnapshotGroupCreateRequest{
+		BaseMappableObjectID: tmpVolumeInfo.VolumeRef,
+		Name:                 snapshotGroupName(name),
+		RepositoryPercentage: snapshotRepositoryPercentage,
+		WarningThreshold:     snapshotWarningThreshold,
+		FullPolicy:           "failbasewrites", //never purge a snapshot image that a clone may be using
+		StoragePoolID:        tmpVolumeInfo.VolumeGroupRef,
+	}
+
+	group := &SnapshotGroup{}
+	if err := d.sendJSON("POST", "/snapshot-groups", request, group); err != nil {
+		return nil, fmt.Errorf("Error creating snapshot group for volume %s: %v", name, err)
+	}
+
+	log.Debugf("Created snapshot group %s (%s) for volume %s", group.Label, group.PitGroupRef, name)
+
+	return group, nil
+}
+
+// GetSnapshotImages returns the snapshot images of the named volume
+func (d Driver) GetSnapshotImages(name string) ([]SnapshotImage, error) {
+
+	if err := d.VerifyVolumeExists(name); err != nil {
+		return nil, err
+	}
+
+	tmpVolumeInfo := d.config.Volumes[name]
+	if tmpVolumeInfo.IsSnapshotVolume {
+		return []SnapshotImage{}, nil
+	}
+
+	images := make([]SnapshotImage, 0)
+	if err := d.sendJSON("GET", "/snapshot-images", nil, &images); err != nil {
+		return nil, err
+	}
+
+	volumeImages := make([]SnapshotImage, 0)
+	for _, e := range images {
+		if e.BaseVolume == tmpVolumeInfo.VolumeRef {
+			volumeImages = append(volumeImages, e)
+		}
+	}
+
+	return volumeImages, nil
+}
+
+// FindSnapshotImage returns the snapshot image of the named volume that has the given name
+func (d Driver) FindSnapshotImage(name, snapshot string) (*SnapshotImage, error) {
+
+	images, err := d.GetSnapshotImages(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, e := range images {
+		if SnapshotImageName(e) == snapshot {
+			return &images[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("Snapshot %s of volume %s not found on array!", snapshot, name)
+}
+
+// CreateSnapshotImage takes a new snapshot image of the named volume, creating its snapshot group if needed
+func (d Driver) CreateSnapshotImage(name string) (*SnapshotImage, error) {
+
+	group, err := d.GetSnapshotGroup(name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	image := &SnapshotImage{}
+	if err := d.sendJSON("POST", "/snapshot-images", SnapshotImageCreateRequest{GroupID: group.PitGroupRef}, image); err != nil {
+		return nil, fmt.Errorf("Error creating snapshot of volume %s: %v", name, err)
+	}
+
+	log.Debugf("Created snapshot image %s (%s) of volume %s", SnapshotImageName(*image), image.PitRef, name)
+
+	return image, nil
+}
+
+// CreateSnapshotVolume creates a writable snapshot volume named name from a snapshot image of the source volume;
+// the new volume shares the unchanged blocks of the source and can be mapped like any other volume
+func (d Driver) CreateSnapshotVolume(name, source string, image SnapshotImage) error {
+
+	// Ensure that we do not exceed the maximum allowed volume length
+	if len(name) > maxNameLength {
+		return fmt.Errorf("The volume name of %v exceeds the maximum allowed length of %d characters",
+			name, maxNameLength)
+	}
+
+	if err := d.VerifyVolumeExists(source); err != nil {
+		return err
+	}
+	sourceInfo := d.config.Volumes[source]
+
+	request := SnapshotVolumeCreateRequest{
+		SnapshotImageID:      image.PitRef,
+		FullThreshold:        snapshotWarningThreshold,
+		Name:                 name,
+		ViewMode:             "readWrite",
+		RepositoryPercentage: snapshotRepositoryPercentage,
+		RepositoryPoolID:     sourceInfo.VolumeGroupRef,
+	}
+
+	view := SnapshotVolume{}
+	if err := d.sendJSON("POST", "/snapshot-volumes", request, &view); err != nil {
+		return fmt.Errorf("Error creating snapshot volume %s from volume %s: %v", name, source, err)
+	}
+
+	log.Debugf("Label=%s ViewRef=%s", view.Label, view.ViewRef)
+
+	//A snapshot volume has the size and media of its base volume
+	tmpVolumeInfo := *sourceInfo
+	tmpVolumeInfo.VolumeRef = view.ViewRef
+	tmpVolumeInfo.IsVolumeMapped = false
+	tmpVolumeInfo.LunMappingRef = ""
+	tmpVolumeInfo.LunNumber = -1
+	tmpVolumeInfo.IsSnapshotVolume = true
+	tmpVolumeInfo.CloneSource = source
+	tmpVolumeInfo.CloneSnapshot = SnapshotImageName(image)
+
+	//Add it to map
+	d.config.Volumes[name] = &tmpVolumeInfo
+
+	return nil
+}
+
+// verifySnapshotVolumeExists adds the named snapshot volume to the persistant map, if it exists; volumes are the
+// standard volumes on the array, one of which is its base volume
+func (d Driver) verifySnapshotVolumeExists(name string, volumes []MsgVolumeExResponse) (bool, error) {
+
+	views := make([]SnapshotVolume, 0)
+	if err := d.sendJSON("GET", "/snapshot-volumes", nil, &views); err != nil {
+		return false, err
+	}
+
+	for _, view := range views {
+		if view.Label != name {
+			continue
+		}
+
+		//Look up the base volume, which determines the size, media type and options of the snapshot volume
+		var source string
+		for _, e := range volumes {
+			if e.VolumeRef == view.BaseVolume {
+				source = e.Label
+			}
+		}
+		if source == "" {
+			return false, fmt.Errorf("Base volume %s of snapshot volume %s not found on array!", view.BaseVolume, name)
+		}
+		if err := d.VerifyVolumeExists(source); err != nil {
+			return false, err
+		}
+
+		images := make([]SnapshotImage, 0)
+		if err := d.sendJSON("GET", "/snapshot-images", nil, &images); err != nil {
+			return false, err
+		}
+
+		tmpVolumeInfo := *d.config.Volumes[source]
+		tmpVolumeInfo.VolumeRef = view.ViewRef
+		tmpVolumeInfo.IsVolumeMapped = view.IsMapped
+		tmpVolumeInfo.LunMappingRef = ""
+		tmpVolumeInfo.LunNumber = -1
+		for _, f := range view.ListOfMappings {
+			tmpVolumeInfo.LunMappingRef = f.LunMappingRef
+			tmpVolumeInfo.LunNumber = f.LunNumber
+		}
+		tmpVolumeInfo.IsSnapshotVolume = true
+		tmpVolumeInfo.CloneSource = source
+		for _, image := range images {
+			if image.PitRef == view.BasePIT {
+				tmpVolumeInfo.CloneSnapshot = SnapshotImageName(image)
+			}
+		}
+
+		//Add it to map
+		d.config.Volumes[name] = &tmpVolumeInfo
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// GetClones returns the names of the snapshot volumes created from the named volume
+func (d Driver) GetClones(name string) ([]string, error) {
+
+	if err := d.VerifyVolumeExists(name); err != nil {
+		return nil, err
+	}
+
+	tmpVolumeInfo := d.config.Volumes[name]
+	if tmpVolumeInfo.IsSnapshotVolume {
+		return []string{}, nil
+	}
+
+	views := make([]SnapshotVolume, 0)
+	if err := d.sendJSON("GET", "/snapshot-volumes", nil, &views); err != nil {
+		return nil, err
+	}
+
+	clones := make([]string, 0)
+	for _, view := range views {
+		if view.BaseVolume == tmpVolumeInfo.VolumeRef {
+			clones = append(clones, view.Label)
+		}
+	}
+
+	return clones, nil
+}
+
+// GetCloneSource returns the volume and snapshot the named volume was cloned from; both are empty unless it is
+// a snapshot volume
+func (d Driver) GetCloneSource(name string) (source, snapshot string, err error) {
+
+	if err := d.VerifyVolumeExists(name); err != nil {
+		return "", "", err
+	}
+
+	tmpVolumeInfo := d.config.Volumes[name]
+	return tmpVolumeInfo.CloneSource, tmpVolumeInfo.CloneSnapshot, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"fmt"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+// Transports that may be used to talk to the Filer, set with apiTransport in the config file
+const (
+	TransportZAPI = "zapi" // the ONTAPI XML interface (default)
+	TransportREST = "rest" // the ONTAP REST API, available from ONTAP 9.6
+)
+
+// API is the set of operations the storage drivers perform on the Filer.  Driver implements it over ZAPI and
+// RestDriver over the ONTAP REST API; both report results with the azgo response types and return an *APIError
+// when a call does not pass, so callers check errors the same way regardless of transport.
+type API interface {
+	IgroupCreate(initiatorGroupName, initiatorGroupType, osType string) (azgo.IgroupCreateResponse, error)
+	IgroupAdd(initiatorGroupName, initiator string) (azgo.IgroupAddResponse, error)
+	IgroupRemove(initiatorGroupName, initiator string, force bool) (azgo.IgroupRemoveResponse, error)
+	IgroupDestroy(initiatorGroupName string) (azgo.IgroupDestroyResponse, error)
+
+	LunCreate(lunPath string, sizeInBytes int, osType string, spaceReserved bool) (azgo.LunCreateBySizeResponse, error)
+	LunGetSerialNumber(lunPath string) (azgo.LunGetSerialNumberResponse, error)
+	LunMap(initiatorGroupName, lunPath string, lunID int) (azgo.LunMapResponse, error)
+	LunMapListInfo(lunPath string) (azgo.LunMapListInfoResponse, error)
+	LunOffline(lunPath string) (azgo.LunOfflineResponse, error)
+	LunOnline(lunPath string) (azgo.LunOnlineResponse, error)
+	LunDestroy(lunPath string) (azgo.LunDestroyResponse, error)
+	LunGet(lunPath string) (azgo.LunGetIterResponse, error)
+	LunSetAttribute(lunPath, name, value string) (azgo.LunSetAttributeResponse, error)
+	LunGetAttribute(lunPath, name string) (azgo.LunGetAttributeResponse, error)
+	LunCloneCreate(volumeName, source, destination, snapshot string) (azgo.CloneCreateResponse, error)
+
+	VolumeCreate(name, aggregateName, size, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy,
+		securityStyle, tieringPolicy string, snapshotReserve int, encrypt bool) (azgo.VolumeCreateResponse, error)
+	VolumeSetAutosize(name, mode, maximumSize string) (azgo.VolumeAutosizeSetResponse, error)
+	SisEnable(name string) (azgo.SisEnableResponse, error)
+	SisSetConfig(name string, compression, inlineCompression bool) (azgo.SisSetConfigResponse, error)
+	VolumeCloneCreate(name, source, snapshot string) (azgo.VolumeCloneCreateResponse, error)
+	VolumeCloneGet(name string) (azgo.VolumeCloneGetResponse, error)
+	VolumeCloneSplitStart(name string) (azgo.VolumeCloneSplitStartResponse, error)
+	VolumeCloneSplitStatus(name string) (azgo.VolumeCloneSplitStatusResponse, error)
+	VolumeListClones(parent string) (azgo.VolumeGetIterResponse, error)
+	VolumeDisableSnapshotDirectoryAccess(name string) (azgo.VolumeModifyIterResponse, error)
+	VolumeSetQosPolicyGroupName(name, policyGroup string) (azgo.VolumeModifyIterResponse, error)
+	VolumeSetComment(name, comment string) (azgo.VolumeModifyIterResponse, error)
+	VolumeGet(name string) (azgo.VolumeGetIterResponse, error)
+	VolumeSize(name string) (azgo.VolumeSizeResponse, error)
+	VolumeSetSize(name, newSize string) (azgo.VolumeSizeResponse, error)
+	VolumeMount(name, junctionPath string) (azgo.VolumeMountResponse, error)
+	VolumeUnmount(name string, force bool) (azgo.VolumeUnmountResponse, error)
+	VolumeOffline(name string) (azgo.VolumeOfflineResponse, error)
+	VolumeDestroy(name string, force bool) (azgo.VolumeDestroyResponse, error)
+
+	VolumeCreateAsync(name string, aggregateNames []string, size, spaceReserve, snapshotPolicy, unixPermissions,
+		exportPolicy, securityStyle, tieringPolicy string, snapshotReserve int,
+		encrypt bool) (azgo.VolumeCreateAsyncResponse, error)
+	VolumeCloneCreateAsync(name, source, snapshot string) (azgo.VolumeCloneCreateAsyncResponse, error)
+	VolumeDisableSnapshotDirectoryAccessAsync(name string) (azgo.VolumeModifyIterAsyncResponse, error)
+	VolumeSetQosPolicyGroupNameAsync(name, policyGroup string) (azgo.VolumeModifyIterAsyncResponse, error)
+	VolumeSetCommentAsync(name, comment string) (azgo.VolumeModifyIterAsyncResponse, error)
+	VolumeDestroyAsync(name string, force bool) (azgo.VolumeDestroyAsyncResponse, error)
+
+	SnapshotCreate(name, volumeName string) (azgo.SnapshotCreateResponse, error)
+	SnapshotGetByVolume(volumeName string) (azgo.SnapshotGetIterResponse, error)
+	SnapshotDelete(name, volumeName string) (azgo.SnapshotDeleteResponse, error)
+
+	JobGet(jobID int) (azgo.JobGetIterResponse, error)
+	JobGetByDescription(description string) (azgo.JobGetIterResponse, error)
+
+	CifsShareCreate(shareName, path, comment string) (azgo.CifsShareCreateResponse, error)
+	CifsShareDelete(shareName string) (azgo.CifsShareDeleteResponse, error)
+
+	QosPolicyGroupCreate(name, maxThroughput string) (azgo.QosPolicyGroupCreateResponse, error)
+	QosPolicyGroupGet(name string) (azgo.QosPolicyGroupGetIterResponse, error)
+	QosPolicyGroupDelete(name string, force bool) (azgo.QosPolicyGroupDeleteResponse, error)
+
+	NetInterfaceGet() (azgo.NetInterfaceGetIterResponse, error)
+	SystemGetVersion() (azgo.SystemGetVersionResponse, error)
+	SystemGetOntapiVersion() (azgo.SystemGetOntapiVersionResponse, error)
+	NegotiateOntapiVersion() (Capabilities, error)
+	VserverGetIterRequest() (azgo.VserverGetIterResponse, error)
+	VserverShowAggrGetIter() (azgo.VserverShowAggrGetIterResponse, error)
+	AggrGetIter() (azgo.AggrGetIterResponse, error)
+	EmsAutosupportLog(appVersion string, autoSupport bool, category string, computerName string,
+		eventDescription string, eventID int, eventSource string, logLevel int) (azgo.EmsAutosupportLogResponse, error)
+}
+
+// NewAPI returns an API for the named transport; empty means ZAPI
+func NewAPI(transport string, config DriverConfig) (API, error) {
+	switch transport {
+	case "", TransportZAPI:
+		return NewDriver(config), nil
+	case TransportREST:
+		return NewRestDriver(config), nil
+	}
+	return nil, fmt.Errorf("Unsupported apiTransport: %v, expected %v or %v", transport, TransportZAPI, TransportREST)
+}
+
+// both transports implement the full API
+var (
+	_ API = &Driver{}
+	_ API = &RestDriver{}
+)
@@ -0,0 +1,514 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+// fakeVolume is a volume held by fakeFiler
+type fakeVolume struct {
+	UUID    string `json:"uuid"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+	Size    int64  `json:"size"`
+}
+
+// fakeFiler is an in-memory storage system that answers both ZAPI and REST requests
+type fakeFiler struct {
+	sync.Mutex
+	volumes    map[string]*fakeVolume
+	igroups    map[string]bool
+	shares     map[string]bool
+	svms       []string
+	snapshots  []string
+	nextUUID   int
+	lastCreate map[string]interface{}
+	envelope   string // ONTAPI version of the last ZAPI request
+}
+
+func newFakeFiler() *fakeFiler {
+	return &fakeFiler{
+		volumes: make(map[string]*fakeVolume),
+		igroups: make(map[string]bool),
+		shares:  make(map[string]bool),
+		svms:    []string{"svm1", "svm2"},
+	}
+}
+
+func (f *fakeFiler) uuid() string {
+	f.nextUUID++
+	return fmt.Sprintf("uuid-%d", f.nextUUID)
+}
+
+func (f *fakeFiler) volumeByUUID(uuid string) *fakeVolume {
+	for _, volume := range f.volumes {
+		if volume.UUID == uuid {
+			return volume
+		}
+	}
+	return nil
+}
+
+// serveZAPI answers ZAPI requests; the arguments of a request are flattened into a map of element name to text
+func (f *fakeFiler) serveZAPI(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	api, args := "", make(map[string]string)
+	decoder := xml.NewDecoder(r.Body)
+	element := ""
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "netapp" {
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "version" {
+						f.envelope = attr.Value
+					}
+				}
+			} else if api == "" {
+				api = t.Name.Local
+			}
+			element = t.Name.Local
+		case xml.CharData:
+			if text := strings.TrimSpace(string(t)); text != "" {
+				args[element] = text
+			}
+		}
+	}
+
+	result := `<results status="passed"/>`
+	failed := func(errno, reason string) string {
+		return fmt.Sprintf(`<results status="failed" errno="%v" reason="%v"/>`, errno, reason)
+	}
+
+	switch api {
+	case "system-get-version":
+		result = `<results status="passed"><version>NetApp Release 9.1</version></results>`
+	case "system-get-ontapi-version":
+		result = `<results status="passed"><major-version>1</major-version><minor-version>110</minor-version></results>`
+	case "volume-create":
+		f.volumes[args["volume"]] = &fakeVolume{UUID: f.uuid(), Name: args["volume"]}
+	case "volume-size":
+		if _, ok := f.volumes[args["volume"]]; !ok {
+			result = failed(azgo.EVOLUMEDOESNOTEXIST, "volume does not exist")
+		} else {
+			result = `<results status="passed"><volume-size>1g</volume-size></results>`
+		}
+	case "volume-modify-iter":
+		if volume, ok := f.volumes[args["name"]]; ok {
+			volume.Comment = args["comment"]
+		}
+	case "volume-get-iter":
+		if volume, ok := f.volumes[args["name"]]; ok {
+			result = fmt.Sprintf(`<results status="passed"><attributes-list><volume-attributes><volume-id-attributes>`+
+				`<name>%v</name><comment>%v</comment></volume-id-attributes></volume-attributes></attributes-list>`+
+				`<num-records>1</num-records></results>`, volume.Name, volume.Comment)
+		} else {
+			result = `<results status="passed"><num-records>0</num-records></results>`
+		}
+	case "volume-create-async":
+		f.volumes[args["volume-name"]] = &fakeVolume{UUID: f.uuid(), Name: args["volume-name"]}
+		result = `<results status="passed"><result-status>in_progress</result-status><result-jobid>42</result-jobid></results>`
+	case "volume-destroy":
+		if _, ok := f.volumes[args["name"]]; !ok {
+			result = failed(azgo.EVOLUMEDOESNOTEXIST, "volume does not exist")
+		}
+		delete(f.volumes, args["name"])
+	case "snapshot-get-iter":
+		// the tag is the index of the first snapshot of the page
+		start, _ := strconv.Atoi(args["tag"])
+		end, _ := strconv.Atoi(args["max-records"])
+		end += start
+		if end > len(f.snapshots) {
+			end = len(f.snapshots)
+		}
+		records := ""
+		for _, name := range f.snapshots[start:end] {
+			records += "<snapshot-info><name>" + name + "</name></snapshot-info>"
+		}
+		nextTag := ""
+		if end < len(f.snapshots) {
+			nextTag = "<next-tag>" + strconv.Itoa(end) + "</next-tag>"
+		}
+		result = fmt.Sprintf(`<results status="passed"><attributes-list>%v</attributes-list>%v`+
+			`<num-records>%v</num-records></results>`, records, nextTag, end-start)
+	case "job-get-iter":
+		if (args["job-description"] == "* vol1" || args["job-id"] == "42") && args["job-vserver"] == "svm1" {
+			result = `<results status="passed"><attributes-list><job-info><job-id>42</job-id>` +
+				`<job-description>Create vol1</job-description><job-state>success</job-state></job-info>` +
+				`</attributes-list><num-records>1</num-records></results>`
+		} else {
+			result = `<results status="passed"><num-records>0</num-records></results>`
+		}
+	case "igroup-create":
+		if f.igroups[args["initiator-group-name"]] {
+			result = failed(azgo.EVDISK_ERROR_INITGROUP_EXISTS, "initiator group already exists")
+		}
+		f.igroups[args["initiator-group-name"]] = true
+	case "cifs-share-create":
+		if f.shares[args["share-name"]] {
+			result = failed(azgo.EDUPLICATEENTRY, "share already exists")
+		}
+		f.shares[args["share-name"]] = true
+	case "cifs-share-delete":
+		if !f.shares[args["share-name"]] {
+			result = failed(azgo.EOBJECTNOTFOUND, "share does not exist")
+		}
+		delete(f.shares, args["share-name"])
+	default:
+		result = failed("13005", "Unable to find API: "+api)
+	}
+
+	io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>`+
+		`<netapp version="1.21" xmlns="http://www.netapp.com/filer/admin">`+result+`</netapp>`)
+}
+
+// serveREST answers REST requests; volumes are created and destroyed by jobs, and SVMs are listed one per page
+func (f *fakeFiler) serveREST(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	reply := func(status int, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(body)
+	}
+	fail := func(status int, message string) {
+		reply(status, map[string]interface{}{"error": map[string]interface{}{"message": message, "code": "4"}})
+	}
+	job := func() {
+		reply(http.StatusAccepted, map[string]interface{}{"job": map[string]interface{}{"uuid": f.uuid()}})
+	}
+
+	var body map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&body)
+	query := r.URL.Query()
+	path := r.URL.Path
+
+	switch {
+	case r.Method == "GET" && path == "/api/cluster":
+		reply(http.StatusOK, map[string]interface{}{"version": map[string]interface{}{
+			"full": "NetApp Release 9.8", "generation": 9, "major": 8, "minor": 0}})
+
+	case r.Method == "GET" && strings.HasPrefix(path, "/api/cluster/jobs/"):
+		reply(http.StatusOK, map[string]interface{}{"state": "success"})
+
+	case r.Method == "GET" && path == "/api/svm/svms":
+		start, _ := strconv.Atoi(query.Get("start"))
+		page := map[string]interface{}{"records": []map[string]interface{}{{"name": f.svms[start]}}}
+		if start+1 < len(f.svms) {
+			page["_links"] = map[string]interface{}{"next": map[string]interface{}{
+				"href": "/api/svm/svms?start=" + strconv.Itoa(start+1)}}
+		}
+		reply(http.StatusOK, page)
+
+	case r.Method == "GET" && path == "/api/storage/volumes":
+		records := make([]*fakeVolume, 0)
+		if volume, ok := f.volumes[query.Get("name")]; ok {
+			records = append(records, volume)
+		}
+		reply(http.StatusOK, map[string]interface{}{"records": records, "num_records": len(records)})
+
+	case r.Method == "POST" && path == "/api/storage/volumes":
+		f.lastCreate = body
+		name := body["name"].(string)
+		f.volumes[name] = &fakeVolume{UUID: f.uuid(), Name: name, Size: int64(body["size"].(float64))}
+		job()
+
+	case r.Method == "PATCH" && strings.HasPrefix(path, "/api/storage/volumes/"):
+		volume := f.volumeByUUID(strings.TrimPrefix(path, "/api/storage/volumes/"))
+		if volume == nil {
+			fail(http.StatusNotFound, "volume not found")
+			return
+		}
+		if comment, ok := body["comment"].(string); ok {
+			volume.Comment = comment
+		}
+		if size, ok := body["size"].(float64); ok {
+			volume.Size = int64(size)
+		}
+		reply(http.StatusOK, map[string]interface{}{})
+
+	case r.Method == "DELETE" && strings.HasPrefix(path, "/api/storage/volumes/"):
+		volume := f.volumeByUUID(strings.TrimPrefix(path, "/api/storage/volumes/"))
+		if volume == nil {
+			fail(http.StatusNotFound, "volume not found")
+			return
+		}
+		delete(f.volumes, volume.Name)
+		job()
+
+	case r.Method == "GET" && path == "/api/protocols/san/igroups":
+		records := make([]map[string]interface{}, 0)
+		if name := query.Get("name"); f.igroups[name] {
+			records = append(records, map[string]interface{}{"name": name, "uuid": "igroup-" + name})
+		}
+		reply(http.StatusOK, map[string]interface{}{"records": records, "num_records": len(records)})
+
+	case r.Method == "POST" && path == "/api/protocols/san/igroups":
+		f.igroups[body["name"].(string)] = true
+		reply(http.StatusCreated, map[string]interface{}{})
+
+	case r.Method == "GET" && path == "/api/protocols/cifs/shares":
+		records := make([]map[string]interface{}, 0)
+		if name := query.Get("name"); f.shares[name] {
+			records = append(records, map[string]interface{}{"name": name,
+				"svm": map[string]interface{}{"name": query.Get("svm.name"), "uuid": "svm-uuid"}})
+		}
+		reply(http.StatusOK, map[string]interface{}{"records": records, "num_records": len(records)})
+
+	case r.Method == "POST" && path == "/api/protocols/cifs/shares":
+		f.shares[body["name"].(string)] = true
+		reply(http.StatusCreated, map[string]interface{}{})
+
+	case r.Method == "DELETE" && strings.HasPrefix(path, "/api/protocols/cifs/shares/svm-uuid/"):
+		name := strings.TrimPrefix(path, "/api/protocols/cifs/shares/svm-uuid/")
+		if !f.shares[name] {
+			fail(http.StatusNotFound, "share not found")
+			return
+		}
+		delete(f.shares, name)
+		reply(http.StatusOK, map[string]interface{}{})
+
+	default:
+		fail(http.StatusNotFound, "no such endpoint "+r.Method+" "+path)
+	}
+}
+
+// newFakeAPI starts a fake storage system for the transport and returns an API that talks to it
+func newFakeAPI(t *testing.T, transport string, f *fakeFiler) (API, *httptest.Server) {
+	handler := f.serveZAPI
+	if transport == TransportREST {
+		handler = f.serveREST
+	}
+	server := httptest.NewTLSServer(http.HandlerFunc(handler))
+
+	api, err := NewAPI(transport, DriverConfig{
+		ManagementLIF: server.Listener.Addr().String(),
+		SVM:           "svm1",
+		Username:      "admin",
+		Password:      "secret",
+	})
+	if err != nil {
+		server.Close()
+		t.Fatalf("Could not create %v API: %v", transport, err)
+	}
+	return api, server
+}
+
+// testAPI checks the behaviour the storage drivers rely on, which both transports must share
+func testAPI(t *testing.T, api API) {
+	r0, err := api.SystemGetVersion()
+	if err != nil || r0.Result.ResultStatusAttr != "passed" || r0.Result.VersionPtr == nil {
+		t.Errorf("Could not get system version: %v %v", r0.Result, err)
+	}
+	capabilities, err := api.NegotiateOntapiVersion()
+	if err != nil || !capabilities.Supports(FeatureVolumeEncryption) {
+		t.Errorf("Expected a version with volume encryption: %v %v", capabilities.Version, err)
+	}
+
+	r1, err := api.VolumeCreate("vol1", "aggr1", "1g", "none", "none", "---rwxr-xr-x", "default", "", "", -1, false)
+	if err != nil || r1.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not create volume: %v %v", r1.Result, err)
+	}
+
+	r2, err := api.VolumeSize("vol1")
+	if err != nil || r2.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not get volume size: %v %v", r2.Result, err)
+	}
+	r2, err = api.VolumeSize("missing")
+	if !IsNotFound(err) || r2.Result.ResultStatusAttr != "failed" || r2.Result.ResultErrnoAttr != azgo.EVOLUMEDOESNOTEXIST {
+		t.Errorf("Expected missing volume to fail with %v: %v %v", azgo.EVOLUMEDOESNOTEXIST, r2.Result, err)
+	}
+	if apiErr, ok := err.(*APIError); !ok || apiErr.API != "volume-size" {
+		t.Errorf("Expected an APIError for volume-size: %v", err)
+	}
+
+	r2, err = api.VolumeSetSize("vol1", "+1g")
+	if err != nil || r2.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not grow volume: %v %v", r2.Result, err)
+	}
+	r2, err = api.VolumeSetSize("missing", "+1g")
+	if !IsNotFound(err) {
+		t.Errorf("Expected growing a missing volume to fail: %v %v", r2.Result, err)
+	}
+
+	r3, err := api.VolumeSetComment("vol1", "hello")
+	if err != nil || r3.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not set volume comment: %v %v", r3.Result, err)
+	}
+	r4, err := api.VolumeGet("vol1")
+	if err != nil || r4.Result.ResultStatusAttr != "passed" || len(r4.Result.AttributesList()) != 1 {
+		t.Fatalf("Could not get volume: %v %v", r4.Result, err)
+	}
+	if comment := r4.Result.AttributesList()[0].VolumeIdAttributesPtr.Comment(); comment != "hello" {
+		t.Errorf("Expected comment hello, got %v", comment)
+	}
+	r4, err = api.VolumeGet("missing")
+	if err != nil || r4.Result.ResultStatusAttr != "passed" || len(r4.Result.AttributesList()) != 0 {
+		t.Errorf("Expected no attributes for missing volume: %v %v", r4.Result, err)
+	}
+
+	r5, err := api.IgroupCreate("igroup1", "iscsi", "linux")
+	if err != nil || r5.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not create igroup: %v %v", r5.Result, err)
+	}
+	r5, err = api.IgroupCreate("igroup1", "iscsi", "linux")
+	if !IsAlreadyExists(err) || r5.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_EXISTS {
+		t.Errorf("Expected existing igroup to fail with %v: %v %v", azgo.EVDISK_ERROR_INITGROUP_EXISTS, r5.Result, err)
+	}
+
+	r7, err := api.CifsShareCreate("vol1", "/vol1", "")
+	if err != nil || r7.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not create CIFS share: %v %v", r7.Result, err)
+	}
+	r7, err = api.CifsShareCreate("vol1", "/vol1", "")
+	if !IsAlreadyExists(err) || r7.Result.ResultErrnoAttr != azgo.EDUPLICATEENTRY {
+		t.Errorf("Expected existing CIFS share to fail with %v: %v %v", azgo.EDUPLICATEENTRY, r7.Result, err)
+	}
+	r8, err := api.CifsShareDelete("vol1")
+	if err != nil || r8.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not delete CIFS share: %v %v", r8.Result, err)
+	}
+	r8, err = api.CifsShareDelete("vol1")
+	if !IsNotFound(err) {
+		t.Errorf("Expected deleted CIFS share to be not found: %v %v", r8.Result, err)
+	}
+
+	r6, err := api.VolumeDestroy("vol1", true)
+	if err != nil || r6.Result.ResultStatusAttr != "passed" {
+		t.Errorf("Could not destroy volume: %v %v", r6.Result, err)
+	}
+	r6, err = api.VolumeDestroy("vol1", true)
+	if !IsNotFound(err) || r6.Result.ResultErrnoAttr != azgo.EVOLUMEDOESNOTEXIST {
+		t.Errorf("Expected destroyed volume to fail with %v: %v %v", azgo.EVOLUMEDOESNOTEXIST, r6.Result, err)
+	}
+}
+
+func TestZAPIAgainstFakeFiler(t *testing.T) {
+	f := newFakeFiler()
+	api, server := newFakeAPI(t, TransportZAPI, f)
+	defer server.Close()
+
+	testAPI(t, api)
+
+	// requests after the negotiation use the version of the Filer
+	if f.envelope != "1.110" {
+		t.Errorf("Expected ONTAPI 1.110 requests, got %v", f.envelope)
+	}
+}
+
+func TestRESTAgainstFakeFiler(t *testing.T) {
+	f := newFakeFiler()
+	api, server := newFakeAPI(t, TransportREST, f)
+	defer server.Close()
+
+	testAPI(t, api)
+
+	// sizes and permissions are converted to the REST representation
+	if size := f.lastCreate["size"]; size != float64(1073741824) {
+		t.Errorf("Expected size 1073741824, got %v", size)
+	}
+	if nas, ok := f.lastCreate["nas"].(map[string]interface{}); !ok || nas["unix_permissions"] != float64(755) {
+		t.Errorf("Expected unix_permissions 755, got %v", f.lastCreate["nas"])
+	}
+
+	// relative sizes are applied to the current size
+	if _, err := api.VolumeCreate("vol2", "aggr1", "1g", "none", "none", "", "default", "", "", -1, false); err != nil {
+		t.Fatalf("Could not create volume: %v", err)
+	}
+	if _, err := api.VolumeSetSize("vol2", "+1g"); err != nil || f.volumes["vol2"].Size != 2147483648 {
+		t.Errorf("Expected size 2147483648 after growing, got %v %v", f.volumes["vol2"].Size, err)
+	}
+	if _, err := api.VolumeSetSize("vol2", "-512m"); err != nil || f.volumes["vol2"].Size != 1610612736 {
+		t.Errorf("Expected size 1610612736 after shrinking, got %v %v", f.volumes["vol2"].Size, err)
+	}
+
+	// collections are read across pages
+	r0, err := api.VserverGetIterRequest()
+	if err != nil || r0.Result.NumRecords() != 2 {
+		t.Errorf("Expected 2 SVMs, got %v %v", r0.Result, err)
+	}
+}
+
+func TestNewAPI(t *testing.T) {
+	if _, err := NewAPI("", DriverConfig{}); err != nil {
+		t.Errorf("Expected ZAPI by default: %v", err)
+	}
+	if _, err := NewAPI("soap", DriverConfig{}); err == nil {
+		t.Error("Expected unsupported transport to fail")
+	}
+}
+
+func TestRestUnixPermissions(t *testing.T) {
+	tests := map[string]int{"---rwxr-xr-x": 755, "rw-r-----": 640, "0755": 755, "700": 700}
+	for permissions, expected := range tests {
+		if actual, err := restUnixPermissions(permissions); err != nil || actual != expected {
+			t.Errorf("restUnixPermissions(%v) = %v, %v; expected %v", permissions, actual, err, expected)
+		}
+	}
+	for _, permissions := range []string{"---rwxr-xr-q", "0999", "rwx"} {
+		if _, err := restUnixPermissions(permissions); err == nil {
+			t.Errorf("Expected restUnixPermissions(%v) to fail", permissions)
+		}
+	}
+}
+
+func TestRestFixedQos(t *testing.T) {
+	fixed, err := restFixedQos("1000iops,100MB/s")
+	if err != nil || fixed["max_throughput_iops"] != int64(1000) || fixed["max_throughput_mbps"] != int64(100) {
+		t.Errorf("Unexpected fixed QoS %v, %v", fixed, err)
+	}
+	fixed, err = restFixedQos("1GB/s")
+	if err != nil || fixed["max_throughput_mbps"] != int64(1024) {
+		t.Errorf("Unexpected fixed QoS %v, %v", fixed, err)
+	}
+	fixed, err = restFixedQos("512KB/s")
+	if err != nil || fixed["max_throughput_mbps"] != int64(1) {
+		t.Errorf("Unexpected fixed QoS %v, %v", fixed, err)
+	}
+	if _, err = restFixedQos("fast"); err == nil {
+		t.Error("Expected invalid throughput to fail")
+	}
+}
+
+func TestZAPIGetIterFollowsNextTag(t *testing.T) {
+	f := newFakeFiler()
+	for i := 0; i < 7; i++ {
+		f.snapshots = append(f.snapshots, fmt.Sprintf("snap%d", i))
+	}
+	server := httptest.NewTLSServer(http.HandlerFunc(f.serveZAPI))
+	defer server.Close()
+
+	api := NewDriver(DriverConfig{ManagementLIF: server.Listener.Addr().String(), SVM: "svm1", MaxRecords: 3})
+	response, err := api.SnapshotGetByVolume("vol1")
+	if err != nil || response.Result.ResultStatusAttr != "passed" {
+		t.Fatalf("Could not list snapshots: %v %v", response.Result, err)
+	}
+	if response.Result.NumRecords() != 7 || len(response.Result.AttributesList()) != 7 {
+		t.Errorf("Expected 7 snapshots, got %v", response.Result.NumRecords())
+	}
+	if response.Result.NextTagPtr != nil {
+		t.Errorf("Expected no next-tag after the last page, got %v", response.Result.NextTag())
+	}
+	if name := response.Result.AttributesList()[6].Name(); name != "snap6" {
+		t.Errorf("Expected snap6 last, got %v", name)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+// APIError is returned when the Filer answers a call with a result that did not pass
+type APIError struct {
+	API    string // the ZAPI call, such as volume-create
+	Status string
+	Errno  string
+	Reason string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%v failed: status: %v errno: %v reason: %v", e.API, e.Status, e.Errno, e.Reason)
+}
+
+// checkResult returns err if the Filer could not be reached, or an *APIError if the result of the call did not pass
+func checkResult(api, status, reason, errno string, err error) error {
+	if err != nil {
+		return err
+	}
+	if status != "passed" {
+		return &APIError{API: api, Status: status, Errno: errno, Reason: reason}
+	}
+	return nil
+}
+
+// IsErrno reports whether err is an *APIError with one of the specified errnos
+func IsErrno(err error, errnos ...string) bool {
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		return false
+	}
+	for _, errno := range errnos {
+		if apiErr.Errno == errno {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err says that the volume, lun, initiator group or other object does not exist
+func IsNotFound(err error) bool {
+	return IsErrno(err, azgo.EVOLUMEDOESNOTEXIST, azgo.EOBJECTNOTFOUND, azgo.EVDISK_ERROR_NO_SUCH_INITGROUP,
+		azgo.EVDISK_ERROR_NO_SUCH_VOLUME, azgo.EAGGRDOESNOTEXIST)
+}
+
+// IsAlreadyExists reports whether err says that the object to create already exists: an existing volume, lun,
+// initiator group or CIFS share, an initiator already in the group, or a lun id already in use in the group
+func IsAlreadyExists(err error) bool {
+	return IsErrno(err, azgo.EONTAPI_EEXIST, azgo.EVDISK_ERROR_VDISK_EXISTS, azgo.EVDISK_ERROR_INITGROUP_EXISTS,
+		azgo.EVDISK_ERROR_INITGROUP_HAS_NODE, azgo.EVDISK_ERROR_INITGROUP_HAS_LUN, azgo.EDUPLICATEENTRY)
+}
+
+// IsJobExists reports whether err says that ONTAP is still running a job for an earlier identical request,
+// which it does when a volume create is retried before the first one completes
+func IsJobExists(err error) bool {
+	return IsErrno(err, azgo.EAPIERROR) && strings.HasSuffix(strings.TrimSpace(err.(*APIError).Reason), "Job exists")
+}
@@ -0,0 +1,52 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+func TestCheckResult(t *testing.T) {
+	if err := checkResult("volume-create", "passed", "", "", nil); err != nil {
+		t.Errorf("Expected no error for a passed result: %v", err)
+	}
+
+	transportErr := fmt.Errorf("connection refused")
+	if err := checkResult("volume-create", "", "", "", transportErr); err != transportErr {
+		t.Errorf("Expected the transport error, got %v", err)
+	}
+
+	err := checkResult("volume-create", "failed", "Duplicate volume name vol1", azgo.EONTAPI_EEXIST, nil)
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Expected an APIError, got %v", err)
+	}
+	if apiErr.API != "volume-create" || apiErr.Errno != azgo.EONTAPI_EEXIST || apiErr.Reason != "Duplicate volume name vol1" {
+		t.Errorf("Unexpected APIError %v", apiErr)
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	notFound := &APIError{API: "volume-size", Status: "failed", Errno: azgo.EVOLUMEDOESNOTEXIST}
+	exists := &APIError{API: "igroup-create", Status: "failed", Errno: azgo.EVDISK_ERROR_INITGROUP_EXISTS}
+	jobExists := &APIError{API: "volume-create", Status: "failed", Errno: azgo.EAPIERROR,
+		Reason: "Unable to create volume: Job exists"}
+	apiFailure := &APIError{API: "volume-create", Status: "failed", Errno: azgo.EAPIERROR, Reason: "Internal error"}
+	other := fmt.Errorf("connection refused")
+
+	if !IsNotFound(notFound) || IsNotFound(exists) || IsNotFound(other) || IsNotFound(nil) {
+		t.Error("IsNotFound matched the wrong errors")
+	}
+	if !IsAlreadyExists(exists) || IsAlreadyExists(notFound) || IsAlreadyExists(other) {
+		t.Error("IsAlreadyExists matched the wrong errors")
+	}
+	if !IsJobExists(jobExists) || IsJobExists(apiFailure) || IsJobExists(notFound) || IsJobExists(other) {
+		t.Error("IsJobExists matched the wrong errors")
+	}
+	if !IsErrno(apiFailure, azgo.EONTAPI_EEXIST, azgo.EAPIERROR) || IsErrno(apiFailure, azgo.EONTAPI_EEXIST) {
+		t.Error("IsErrno matched the wrong errnos")
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import "github.com/netapp/netappdvp/azgo"
+
+// DefaultMaxRecords is the page size of get-iter calls when DriverConfig.MaxRecords is not set
+const DefaultMaxRecords = azgo.DefaultMaxRecords
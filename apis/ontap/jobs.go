@@ -0,0 +1,158 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/netapp/netappdvp/azgo"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// Job states reported by job-get-iter that mean the job has ended
+const (
+	JobStateSuccess = "success"
+	JobStateFailure = "failure"
+	JobStateError   = "error"
+	JobStateQuit    = "quit"
+	JobStateDead    = "dead"
+)
+
+// Outcomes reported by asynchronous ZAPI calls such as volume-create-async
+const (
+	AsyncStatusSucceeded  = "succeeded"
+	AsyncStatusInProgress = "in_progress"
+	AsyncStatusFailed     = "failed"
+)
+
+// DefaultJobTimeout is how long WaitForJob and WaitForJobsByDescription wait for jobs to end
+const DefaultJobTimeout = 5 * time.Minute
+
+// jobPollInterval is how often jobs are checked while waiting for them
+var jobPollInterval = 2 * time.Second
+
+// JobError is returned when a job ends without success, or is still running when the wait times out
+type JobError struct {
+	ID          int
+	Description string
+	State       string // the last state seen, which is not an ending state if the wait timed out
+	Completion  string // the completion message, which explains a failure
+	TimedOut    bool
+}
+
+func (e *JobError) Error() string {
+	if e.TimedOut {
+		return fmt.Sprintf("Timed out waiting for job %v (%v), state: %v", e.ID, e.Description, e.State)
+	}
+	return fmt.Sprintf("Job %v (%v) ended in state %v: %v", e.ID, e.Description, e.State, e.Completion)
+}
+
+// jobEnded reports whether the state is one a job ends in
+func jobEnded(state string) bool {
+	switch state {
+	case JobStateSuccess, JobStateFailure, JobStateError, JobStateQuit, JobStateDead:
+		return true
+	}
+	return false
+}
+
+// newJobError describes a job that did not succeed
+func newJobError(job azgo.JobInfoType, timedOut bool) *JobError {
+	e := &JobError{TimedOut: timedOut}
+	if job.JobIdPtr != nil {
+		e.ID = job.JobId()
+	}
+	if job.JobDescriptionPtr != nil {
+		e.Description = job.JobDescription()
+	}
+	if job.JobStatePtr != nil {
+		e.State = job.JobState()
+	}
+	if job.JobCompletionPtr != nil {
+		e.Completion = job.JobCompletion()
+	}
+	return e
+}
+
+// waitForJobs polls the jobs returned by list until they have all ended, returning a *JobError for the first
+// that did not succeed.  Jobs that are no longer listed have ended and been removed.
+func waitForJobs(list func() (azgo.JobGetIterResponse, error), timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		response, err := list()
+		if err != nil {
+			return err
+		}
+
+		var running *azgo.JobInfoType
+		for _, job := range response.Result.AttributesList() {
+			job := job
+			state := ""
+			if job.JobStatePtr != nil {
+				state = job.JobState()
+			}
+			if !jobEnded(state) {
+				running = &job
+				continue
+			}
+			if state != JobStateSuccess {
+				return newJobError(job, false)
+			}
+		}
+		if running == nil {
+			return nil
+		}
+
+		pending := newJobError(*running, true)
+		if time.Now().After(deadline) {
+			return pending
+		}
+		log.Debugf("Waiting for job %v (%v), state: %v", pending.ID, pending.Description, pending.State)
+		time.Sleep(jobPollInterval)
+	}
+}
+
+// WaitForJob waits for the job with the id to end, returning a *JobError unless it succeeds
+func WaitForJob(api API, jobID int, timeout time.Duration) error {
+	return waitForJobs(func() (azgo.JobGetIterResponse, error) { return api.JobGet(jobID) }, timeout)
+}
+
+// WaitForJobsByDescription waits for the jobs of the SVM whose description matches to end, returning a
+// *JobError unless they all succeed.  This is used when ONTAP refuses a request with "Job exists" because
+// another host made the same request and its job is still running.
+func WaitForJobsByDescription(api API, description string, timeout time.Duration) error {
+	return waitForJobs(func() (azgo.JobGetIterResponse, error) { return api.JobGetByDescription(description) }, timeout)
+}
+
+// WaitForAsyncJob waits for the job started by an asynchronous call such as volume-create-async.  The call reports
+// its status, the id of the job while it is in progress and a message if it failed, any of which may be missing
+// from the response; a call that failed, or a job that does not succeed, returns a *JobError.
+func WaitForAsyncJob(api API, status *string, jobID *int, message *string, timeout time.Duration) error {
+	if status == nil || *status == AsyncStatusSucceeded {
+		return nil
+	}
+
+	id := 0
+	if jobID != nil {
+		id = *jobID
+	}
+	if *status == AsyncStatusFailed {
+		e := &JobError{ID: id, State: JobStateFailure}
+		if message != nil {
+			e.Completion = *message
+		}
+		return e
+	}
+	if jobID == nil {
+		return nil
+	}
+	return WaitForJob(api, id, timeout)
+}
+
+// IsJobFailed reports whether err says that a job ended without success
+func IsJobFailed(err error) bool {
+	jobErr, ok := err.(*JobError)
+	return ok && !jobErr.TimedOut
+}
@@ -0,0 +1,113 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+// jobList returns a list function that reports the job in each of the states in turn, then no job at all
+func jobList(states ...string) func() (azgo.JobGetIterResponse, error) {
+	return func() (azgo.JobGetIterResponse, error) {
+		response := azgo.JobGetIterResponse{}
+		jobs := make([]azgo.JobInfoType, 0)
+		if len(states) > 0 {
+			job := azgo.NewJobInfoType().SetJobId(42).SetJobDescription("Create vol1").SetJobState(states[0]).
+				SetJobCompletion("Volume create failed")
+			jobs = append(jobs, *job)
+			states = states[1:]
+		}
+		response.Result.SetAttributesList(jobs).SetNumRecords(len(jobs))
+		return response, nil
+	}
+}
+
+func TestWaitForJobs(t *testing.T) {
+	defer func(interval time.Duration) { jobPollInterval = interval }(jobPollInterval)
+	jobPollInterval = time.Millisecond
+
+	if err := waitForJobs(jobList("queued", "running", JobStateSuccess), time.Minute); err != nil {
+		t.Errorf("Expected the job to succeed: %v", err)
+	}
+
+	// jobs that are gone have ended
+	if err := waitForJobs(jobList("running"), time.Minute); err != nil {
+		t.Errorf("Expected a removed job to have ended: %v", err)
+	}
+
+	err := waitForJobs(jobList("running", JobStateFailure), time.Minute)
+	jobErr, ok := err.(*JobError)
+	if !ok || !IsJobFailed(err) || jobErr.ID != 42 || jobErr.State != JobStateFailure ||
+		jobErr.Completion != "Volume create failed" {
+		t.Errorf("Expected the failed job, got %v", err)
+	}
+
+	err = waitForJobs(jobList("running", "running", "running"), 0)
+	if jobErr, ok := err.(*JobError); !ok || !jobErr.TimedOut || IsJobFailed(err) || jobErr.State != "running" {
+		t.Errorf("Expected the wait to time out, got %v", err)
+	}
+
+	listErr := fmt.Errorf("connection refused")
+	err = waitForJobs(func() (azgo.JobGetIterResponse, error) { return azgo.JobGetIterResponse{}, listErr }, time.Minute)
+	if err != listErr {
+		t.Errorf("Expected the error listing jobs, got %v", err)
+	}
+}
+
+func TestZAPIJobGetByDescription(t *testing.T) {
+	f := newFakeFiler()
+	server := httptest.NewTLSServer(http.HandlerFunc(f.serveZAPI))
+	defer server.Close()
+
+	api := NewDriver(DriverConfig{ManagementLIF: server.Listener.Addr().String(), SVM: "svm1"})
+	response, err := api.JobGetByDescription("* vol1")
+	if err != nil || response.Result.NumRecords() != 1 {
+		t.Fatalf("Could not get jobs: %v %v", response.Result, err)
+	}
+	job := response.Result.AttributesList()[0]
+	if job.JobDescription() != "Create vol1" || job.JobState() != JobStateSuccess {
+		t.Errorf("Unexpected job %v", job)
+	}
+	if err := WaitForJobsByDescription(api, "* vol1", time.Minute); err != nil {
+		t.Errorf("Expected the job to have succeeded: %v", err)
+	}
+}
+
+func TestWaitForAsyncJob(t *testing.T) {
+	f := newFakeFiler()
+	server := httptest.NewTLSServer(http.HandlerFunc(f.serveZAPI))
+	defer server.Close()
+
+	api := NewDriver(DriverConfig{ManagementLIF: server.Listener.Addr().String(), SVM: "svm1"})
+	response, err := api.VolumeCreateAsync("vol1", []string{"aggr1", "aggr2"}, "1t", "none", "none",
+		"---rwxr-xr-x", "default", "", "", -1, false)
+	if err != nil {
+		t.Fatalf("Could not create FlexGroup: %v %v", response.Result, err)
+	}
+	err = WaitForAsyncJob(api, response.Result.ResultStatusPtr, response.Result.ResultJobidPtr,
+		response.Result.ResultErrorMessagePtr, time.Minute)
+	if err != nil {
+		t.Errorf("Expected the create job to have succeeded: %v", err)
+	}
+	if volumes, err := api.VolumeGet("vol1"); err != nil || volumes.Result.NumRecords() != 1 {
+		t.Errorf("Expected the FlexGroup to exist: %v %v", volumes.Result, err)
+	}
+
+	succeeded, failed, message := AsyncStatusSucceeded, AsyncStatusFailed, "Aggregate aggr3 is full"
+	if err := WaitForAsyncJob(api, &succeeded, nil, nil, time.Minute); err != nil {
+		t.Errorf("Expected a call that finished at once to need no wait: %v", err)
+	}
+	if err := WaitForAsyncJob(api, nil, nil, nil, time.Minute); err != nil {
+		t.Errorf("Expected a result without status to need no wait: %v", err)
+	}
+	err = WaitForAsyncJob(api, &failed, nil, &message, time.Minute)
+	if jobErr, ok := err.(*JobError); !ok || !IsJobFailed(err) || jobErr.Completion != message {
+		t.Errorf("Expected the failed call, got %v", err)
+	}
+}
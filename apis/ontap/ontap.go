@@ -3,9 +3,12 @@
 package ontap
 
 import (
+	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/netapp/netappdvp/azgo"
+	"github.com/netapp/netappdvp/utils"
 )
 
 // DriverConfig holds the configuration data for Driver objects
@@ -14,6 +17,7 @@ type DriverConfig struct {
 	SVM           string
 	Username      string
 	Password      string
+	MaxRecords    int // page size of get-iter calls, DefaultMaxRecords if not set
 }
 
 // Driver is the object to use for interacting with the Filer
@@ -50,6 +54,8 @@ func (d Driver) IgroupCreate(initiatorGroupName, initiatorGroupType, osType stri
 		SetInitiatorGroupType(initiatorGroupType).
 		SetOsType(osType).
 		ExecuteUsing(d.zr)
+	err = checkResult("igroup-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -60,6 +66,8 @@ func (d Driver) IgroupAdd(initiatorGroupName, initiator string) (response azgo.I
 		SetInitiatorGroupName(initiatorGroupName).
 		SetInitiator(initiator).
 		ExecuteUsing(d.zr)
+	err = checkResult("igroup-add", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -70,6 +78,8 @@ func (d Driver) IgroupRemove(initiatorGroupName, initiator string, force bool) (
 		SetInitiator(initiator).
 		SetForce(force).
 		ExecuteUsing(d.zr)
+	err = checkResult("igroup-remove", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -78,6 +88,8 @@ func (d Driver) IgroupDestroy(initiatorGroupName string) (response azgo.IgroupDe
 	response, err = azgo.NewIgroupDestroyRequest().
 		SetInitiatorGroupName(initiatorGroupName).
 		ExecuteUsing(d.zr)
+	err = checkResult("igroup-destroy", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -96,6 +108,8 @@ func (d Driver) LunCreate(lunPath string, sizeInBytes int, osType string, spaceR
 		SetOstype(osType).
 		SetSpaceReservationEnabled(spaceReserved).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-create-by-size", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -104,6 +118,8 @@ func (d Driver) LunGetSerialNumber(lunPath string) (response azgo.LunGetSerialNu
 	response, err = azgo.NewLunGetSerialNumberRequest().
 		SetPath(lunPath).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-get-serial-number", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -115,6 +131,8 @@ func (d Driver) LunMap(initiatorGroupName, lunPath string, lunID int) (response
 		SetPath(lunPath).
 		SetLunId(lunID).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-map", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -124,6 +142,8 @@ func (d Driver) LunMapListInfo(lunPath string) (response azgo.LunMapListInfoResp
 	response, err = azgo.NewLunMapListInfoRequest().
 		SetPath(lunPath).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-map-list-info", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -133,6 +153,8 @@ func (d Driver) LunOffline(lunPath string) (response azgo.LunOfflineResponse, er
 	response, err = azgo.NewLunOfflineRequest().
 		SetPath(lunPath).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-offline", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -142,6 +164,8 @@ func (d Driver) LunOnline(lunPath string) (response azgo.LunOnlineResponse, err
 	response, err = azgo.NewLunOnlineRequest().
 		SetPath(lunPath).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-online", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -151,6 +175,63 @@ func (d Driver) LunDestroy(lunPath string) (response azgo.LunDestroyResponse, er
 	response, err = azgo.NewLunDestroyRequest().
 		SetPath(lunPath).
 		ExecuteUsing(d.zr)
+	err = checkResult("lun-destroy", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// LunGet returns the luns whose path matches, which may contain wildcards
+// equivalent to filer::> lun show -vserver iscsi_vs -path /vol/v/*
+func (d Driver) LunGet(lunPath string) (response azgo.LunGetIterResponse, err error) {
+	query := azgo.NewLunInfoType().SetPath(lunPath)
+
+	response, err = azgo.NewLunGetIterRequest().
+		SetQuery(*query).
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("lun-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// LunSetAttribute sets a named attribute of a lun
+func (d Driver) LunSetAttribute(lunPath, name, value string) (response azgo.LunSetAttributeResponse, err error) {
+	response, err = azgo.NewLunSetAttributeRequest().
+		SetPath(lunPath).
+		SetName(name).
+		SetValue(value).
+		ExecuteUsing(d.zr)
+	err = checkResult("lun-set-attribute", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// LunGetAttribute returns a named attribute of a lun
+func (d Driver) LunGetAttribute(lunPath, name string) (response azgo.LunGetAttributeResponse, err error) {
+	response, err = azgo.NewLunGetAttributeRequest().
+		SetPath(lunPath).
+		SetName(name).
+		ExecuteUsing(d.zr)
+	err = checkResult("lun-get-attribute", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// LunCloneCreate creates a file clone of a lun in the same volume, from the active file system or a snapshot
+// equivalent to filer::> volume file clone create -vserver iscsi_vs -volume v -source-path lun1 -destination-path lun2
+func (d Driver) LunCloneCreate(volumeName, source, destination, snapshot string) (response azgo.CloneCreateResponse, err error) {
+	request := azgo.NewCloneCreateRequest().
+		SetVolume(volumeName).
+		SetSourcePath(source).
+		SetDestinationPath(destination).
+		SetSpaceReserve(false)
+
+	if snapshot != "" {
+		request.SetSnapshotName(snapshot)
+	}
+
+	response, err = request.ExecuteUsing(d.zr)
+	err = checkResult("clone-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -162,16 +243,78 @@ func (d Driver) LunDestroy(lunPath string) (response azgo.LunDestroyResponse, er
 
 // VolumeCreate creates a volume with the specified options
 // equivalent to filer::> volume create -vserver iscsi_vs -volume v -aggregate aggr1 -size 1g -state online -type RW -policy default -unix-permissions ---rwxr-xr-x -space-guarantee none -snapshot-policy none
-func (d Driver) VolumeCreate(name, aggregateName, size, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy string) (response azgo.VolumeCreateResponse, err error) {
-	response, err = azgo.NewVolumeCreateRequest().
+// unixPermissions, securityStyle and tieringPolicy are left to ONTAP when empty, as is snapshotReserve when negative; encrypt
+// is only sent when true so that systems without volume encryption still accept the request
+func (d Driver) VolumeCreate(name, aggregateName, size, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy,
+	securityStyle, tieringPolicy string, snapshotReserve int, encrypt bool) (response azgo.VolumeCreateResponse, err error) {
+	request := azgo.NewVolumeCreateRequest().
 		SetVolume(name).
 		SetContainingAggrName(aggregateName).
 		SetSize(size).
 		SetSpaceReserve(spaceReserve).
 		SetSnapshotPolicy(snapshotPolicy).
-		SetUnixPermissions(unixPermissions).
-		SetExportPolicy(exportPolicy).
+		SetExportPolicy(exportPolicy)
+
+	if unixPermissions != "" {
+		request.SetUnixPermissions(unixPermissions)
+	}
+	if securityStyle != "" {
+		request.SetVolumeSecurityStyle(securityStyle)
+	}
+	if tieringPolicy != "" {
+		request.SetTieringPolicy(tieringPolicy)
+	}
+	if snapshotReserve >= 0 {
+		request.SetPercentageSnapshotReserve(snapshotReserve)
+	}
+	if encrypt {
+		request.SetEncrypt(true)
+	}
+
+	response, err = request.ExecuteUsing(d.zr)
+	err = checkResult("volume-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeSetAutosize sets the autosize mode and maximum size of the specified volume
+// equivalent to filer::> volume autosize -vserver iscsi_vs -volume v -mode grow -maximum-size 10g
+func (d Driver) VolumeSetAutosize(name, mode, maximumSize string) (response azgo.VolumeAutosizeSetResponse, err error) {
+	request := azgo.NewVolumeAutosizeSetRequest().
+		SetVolume(name).
+		SetMode(mode)
+
+	if maximumSize != "" {
+		request.SetMaximumSize(maximumSize)
+	}
+
+	response, err = request.ExecuteUsing(d.zr)
+	err = checkResult("volume-autosize-set", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// SisEnable enables storage efficiency (deduplication) on the specified volume
+// equivalent to filer::> volume efficiency on -vserver iscsi_vs -volume v
+func (d Driver) SisEnable(name string) (response azgo.SisEnableResponse, err error) {
+	response, err = azgo.NewSisEnableRequest().
+		SetPath("/vol/" + name).
 		ExecuteUsing(d.zr)
+	err = checkResult("sis-enable", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// SisSetConfig configures compression on the specified volume, which must already have efficiency enabled
+// equivalent to filer::> volume efficiency modify -vserver iscsi_vs -volume v -compression true -inline-compression true
+func (d Driver) SisSetConfig(name string, compression, inlineCompression bool) (response azgo.SisSetConfigResponse, err error) {
+	response, err = azgo.NewSisSetConfigRequest().
+		SetPath("/vol/" + name).
+		SetEnableCompression(compression).
+		SetEnableInlineCompression(inlineCompression).
+		ExecuteUsing(d.zr)
+	err = checkResult("sis-set-config", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -182,6 +325,56 @@ func (d Driver) VolumeCloneCreate(name, source, snapshot string) (response azgo.
 		SetParentVolume(source).
 		SetParentSnapshot(snapshot).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-clone-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeCloneGet returns the clone information of the specified volume, including its parent volume and snapshot
+// equivalent to filer::> volume clone show -vserver iscsi_vs -flexclone v
+func (d Driver) VolumeCloneGet(name string) (response azgo.VolumeCloneGetResponse, err error) {
+	response, err = azgo.NewVolumeCloneGetRequest().
+		SetVolume(name).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-clone-get", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeCloneSplitStart starts splitting the specified clone from its parent volume
+// equivalent to filer::> volume clone split start -vserver iscsi_vs -flexclone v
+func (d Driver) VolumeCloneSplitStart(name string) (response azgo.VolumeCloneSplitStartResponse, err error) {
+	response, err = azgo.NewVolumeCloneSplitStartRequest().
+		SetVolume(name).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-clone-split-start", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeCloneSplitStatus returns the progress of a split of the specified clone
+// equivalent to filer::> volume clone split show -vserver iscsi_vs -flexclone v
+func (d Driver) VolumeCloneSplitStatus(name string) (response azgo.VolumeCloneSplitStatusResponse, err error) {
+	response, err = azgo.NewVolumeCloneSplitStatusRequest().
+		SetVolume(name).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-clone-split-status", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeListClones returns the volumes that are clones of the specified volume
+// equivalent to filer::> volume clone show -vserver iscsi_vs -parent-volume v
+func (d Driver) VolumeListClones(parent string) (response azgo.VolumeGetIterResponse, err error) {
+	parentattr := azgo.NewVolumeCloneParentAttributesType().SetName(azgo.VolumeNameType(parent))
+	cloneattr := azgo.NewVolumeCloneAttributesType().SetVolumeCloneParentAttributes(*parentattr)
+	queryattr := azgo.NewVolumeAttributesType().SetVolumeCloneAttributes(*cloneattr)
+
+	response, err = azgo.NewVolumeGetIterRequest().
+		SetQuery(*queryattr).
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("volume-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -197,6 +390,55 @@ func (d Driver) VolumeDisableSnapshotDirectoryAccess(name string) (response azgo
 		SetQuery(*queryattr).
 		SetAttributes(*volattr).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-modify-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeSetQosPolicyGroupName assigns the specified volume to a QoS policy group
+// equivalent to filer::> volume modify -vserver iscsi_vs -volume v -qos-policy-group pg
+func (d Driver) VolumeSetQosPolicyGroupName(name, policyGroup string) (response azgo.VolumeModifyIterResponse, err error) {
+	qosattr := azgo.NewVolumeQosAttributesType().SetPolicyGroupName(policyGroup)
+	volattr := azgo.NewVolumeAttributesType().SetVolumeQosAttributes(*qosattr)
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	queryattr := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+
+	response, err = azgo.NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-modify-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeSetComment sets the comment on the specified volume
+func (d Driver) VolumeSetComment(name, comment string) (response azgo.VolumeModifyIterResponse, err error) {
+	idattr := azgo.NewVolumeIdAttributesType().SetComment(comment)
+	volattr := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*idattr)
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	queryattr := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+
+	response, err = azgo.NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-modify-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeGet returns the attributes of the specified volume
+// equivalent to filer::> volume show -vserver iscsi_vs -volume v
+func (d Driver) VolumeGet(name string) (response azgo.VolumeGetIterResponse, err error) {
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	queryattr := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+
+	response, err = azgo.NewVolumeGetIterRequest().
+		SetQuery(*queryattr).
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("volume-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -205,6 +447,20 @@ func (d Driver) VolumeSize(name string) (response azgo.VolumeSizeResponse, err e
 	response, err = azgo.NewVolumeSizeRequest().
 		SetVolume(name).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-size", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeSetSize sets the size of the specified volume; a leading + or - grows or shrinks it by that much
+// equivalent to filer::> volume size -vserver iscsi_vs -volume v -new-size +1g
+func (d Driver) VolumeSetSize(name, newSize string) (response azgo.VolumeSizeResponse, err error) {
+	response, err = azgo.NewVolumeSizeRequest().
+		SetVolume(name).
+		SetNewSize(newSize).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-size", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -214,6 +470,8 @@ func (d Driver) VolumeMount(name, junctionPath string) (response azgo.VolumeMoun
 		SetVolumeName(name).
 		SetJunctionPath(junctionPath).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-mount", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -223,6 +481,8 @@ func (d Driver) VolumeUnmount(name string, force bool) (response azgo.VolumeUnmo
 		SetVolumeName(name).
 		SetForce(force).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-unmount", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -231,6 +491,8 @@ func (d Driver) VolumeOffline(name string) (response azgo.VolumeOfflineResponse,
 	response, err = azgo.NewVolumeOfflineRequest().
 		SetName(name).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-offline", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -240,6 +502,114 @@ func (d Driver) VolumeDestroy(name string, force bool) (response azgo.VolumeDest
 		SetName(name).
 		SetUnmountAndOffline(force).
 		ExecuteUsing(d.zr)
+	err = checkResult("volume-destroy", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeCreateAsync creates a FlexGroup volume across the specified aggregates; ONTAP creates it in a job,
+// whose id is returned if it did not finish at once.  The options are as for VolumeCreate.
+// equivalent to filer::> volume create -vserver nfs_vs -volume v -aggr-list aggr1,aggr2 -size 1t
+func (d Driver) VolumeCreateAsync(name string, aggregateNames []string, size, spaceReserve, snapshotPolicy,
+	unixPermissions, exportPolicy, securityStyle, tieringPolicy string, snapshotReserve int,
+	encrypt bool) (response azgo.VolumeCreateAsyncResponse, err error) {
+	sizeInBytes, err := utils.ConvertSizeToBytes64(size)
+	if err != nil {
+		return response, fmt.Errorf("Invalid size %v: %v", size, err)
+	}
+	bytes, err := strconv.Atoi(sizeInBytes)
+	if err != nil {
+		return response, fmt.Errorf("Invalid size %v: %v", size, err)
+	}
+
+	aggrList := make([]azgo.AggrNameType, 0, len(aggregateNames))
+	for _, aggregateName := range aggregateNames {
+		aggrList = append(aggrList, azgo.AggrNameType(aggregateName))
+	}
+
+	request := azgo.NewVolumeCreateAsyncRequest().
+		SetVolumeName(name).
+		SetAggrList(aggrList).
+		SetSize(bytes).
+		SetSpaceReserve(spaceReserve).
+		SetSnapshotPolicy(snapshotPolicy).
+		SetExportPolicy(exportPolicy)
+
+	if unixPermissions != "" {
+		request.SetUnixPermissions(unixPermissions)
+	}
+	if securityStyle != "" {
+		request.SetVolumeSecurityStyle(securityStyle)
+	}
+	if tieringPolicy != "" {
+		request.SetTieringPolicy(tieringPolicy)
+	}
+	if snapshotReserve >= 0 {
+		request.SetPercentageSnapshotReserve(snapshotReserve)
+	}
+	if encrypt {
+		request.SetEncrypt(true)
+	}
+
+	response, err = request.ExecuteUsing(d.zr)
+	err = checkResult("volume-create-async", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeCloneCreateAsync clones a FlexGroup volume from a snapshot in a job
+func (d Driver) VolumeCloneCreateAsync(name, source, snapshot string) (response azgo.VolumeCloneCreateAsyncResponse, err error) {
+	response, err = azgo.NewVolumeCloneCreateAsyncRequest().
+		SetVolume(name).
+		SetParentVolume(source).
+		SetParentSnapshot(snapshot).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-clone-create-async", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// volumeModifyIterAsync changes the attributes of the specified FlexGroup volume in a job
+func (d Driver) volumeModifyIterAsync(name string, volattr *azgo.VolumeAttributesType) (response azgo.VolumeModifyIterAsyncResponse, err error) {
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	queryattr := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+
+	response, err = azgo.NewVolumeModifyIterAsyncRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		SetReturnSuccessList(true).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-modify-iter-async", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VolumeDisableSnapshotDirectoryAccessAsync disables access to the ".snapshot" directory of a FlexGroup volume
+func (d Driver) VolumeDisableSnapshotDirectoryAccessAsync(name string) (azgo.VolumeModifyIterAsyncResponse, error) {
+	ssattr := azgo.NewVolumeSnapshotAttributesType().SetSnapdirAccessEnabled(false)
+	return d.volumeModifyIterAsync(name, azgo.NewVolumeAttributesType().SetVolumeSnapshotAttributes(*ssattr))
+}
+
+// VolumeSetQosPolicyGroupNameAsync assigns the specified FlexGroup volume to a QoS policy group
+func (d Driver) VolumeSetQosPolicyGroupNameAsync(name, policyGroup string) (azgo.VolumeModifyIterAsyncResponse, error) {
+	qosattr := azgo.NewVolumeQosAttributesType().SetPolicyGroupName(policyGroup)
+	return d.volumeModifyIterAsync(name, azgo.NewVolumeAttributesType().SetVolumeQosAttributes(*qosattr))
+}
+
+// VolumeSetCommentAsync sets the comment on the specified FlexGroup volume
+func (d Driver) VolumeSetCommentAsync(name, comment string) (azgo.VolumeModifyIterAsyncResponse, error) {
+	idattr := azgo.NewVolumeIdAttributesType().SetComment(comment)
+	return d.volumeModifyIterAsync(name, azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*idattr))
+}
+
+// VolumeDestroyAsync destroys a FlexGroup volume in a job
+func (d Driver) VolumeDestroyAsync(name string, force bool) (response azgo.VolumeDestroyAsyncResponse, err error) {
+	response, err = azgo.NewVolumeDestroyAsyncRequest().
+		SetVolumeName(name).
+		SetUnmountAndOffline(force).
+		ExecuteUsing(d.zr)
+	err = checkResult("volume-destroy-async", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -255,6 +625,8 @@ func (d Driver) SnapshotCreate(name, volumeName string) (response azgo.SnapshotC
 		SetSnapshot(name).
 		SetVolume(volumeName).
 		ExecuteUsing(d.zr)
+	err = checkResult("snapshot-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -264,20 +636,143 @@ func (d Driver) SnapshotGetByVolume(volumeName string) (response azgo.SnapshotGe
 
 	response, err = azgo.NewSnapshotGetIterRequest().
 		SetQuery(*query).
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("snapshot-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// SnapshotDelete deletes a snapshot of a volume
+// equivalent to filer::> volume snapshot delete -vserver iscsi_vs -volume v -snapshot s
+func (d Driver) SnapshotDelete(name, volumeName string) (response azgo.SnapshotDeleteResponse, err error) {
+	response, err = azgo.NewSnapshotDeleteRequest().
+		SetSnapshot(name).
+		SetVolume(volumeName).
 		ExecuteUsing(d.zr)
+	err = checkResult("snapshot-delete", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
 // SNAPSHOT operations END
 /////////////////////////////////////////////////////////////////////////////
 
+/////////////////////////////////////////////////////////////////////////////
+// CIFS operations BEGIN
+
+// CifsShareCreate shares the specified path over CIFS
+// equivalent to filer::> vserver cifs share create -vserver cifs_vs -share-name v -path /v
+func (d Driver) CifsShareCreate(shareName, path, comment string) (response azgo.CifsShareCreateResponse, err error) {
+	request := azgo.NewCifsShareCreateRequest().
+		SetShareName(shareName).
+		SetPath(path)
+
+	if comment != "" {
+		request.SetComment(comment)
+	}
+
+	response, err = request.ExecuteUsing(d.zr)
+	err = checkResult("cifs-share-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// CifsShareDelete deletes the specified CIFS share
+// equivalent to filer::> vserver cifs share delete -vserver cifs_vs -share-name v
+func (d Driver) CifsShareDelete(shareName string) (response azgo.CifsShareDeleteResponse, err error) {
+	response, err = azgo.NewCifsShareDeleteRequest().
+		SetShareName(shareName).
+		ExecuteUsing(d.zr)
+	err = checkResult("cifs-share-delete", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// CIFS operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// QOS operations BEGIN
+
+// QosPolicyGroupCreate creates a QoS policy group in the SVM with the specified throughput ceiling
+// equivalent to filer::> qos policy-group create -policy-group pg -vserver iscsi_vs -max-throughput 1000iops
+func (d Driver) QosPolicyGroupCreate(name, maxThroughput string) (response azgo.QosPolicyGroupCreateResponse, err error) {
+	response, err = azgo.NewQosPolicyGroupCreateRequest().
+		SetPolicyGroup(name).
+		SetVserver(d.config.SVM).
+		SetMaxThroughput(maxThroughput).
+		ExecuteUsing(d.zr)
+	err = checkResult("qos-policy-group-create", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// QosPolicyGroupGet returns the specified QoS policy group
+// equivalent to filer::> qos policy-group show -policy-group pg
+func (d Driver) QosPolicyGroupGet(name string) (response azgo.QosPolicyGroupGetIterResponse, err error) {
+	queryattr := azgo.NewQosPolicyGroupInfoType().SetPolicyGroup(name)
+
+	response, err = azgo.NewQosPolicyGroupGetIterRequest().
+		SetQuery(*queryattr).
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("qos-policy-group-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// QosPolicyGroupDelete deletes the specified QoS policy group
+// equivalent to filer::> qos policy-group delete -policy-group pg
+func (d Driver) QosPolicyGroupDelete(name string, force bool) (response azgo.QosPolicyGroupDeleteResponse, err error) {
+	response, err = azgo.NewQosPolicyGroupDeleteRequest().
+		SetPolicyGroup(name).
+		SetForce(force).
+		ExecuteUsing(d.zr)
+	err = checkResult("qos-policy-group-delete", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// QOS operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// JOB operations BEGIN
+
+// jobGetIter returns the jobs matching the query
+func (d Driver) jobGetIter(query *azgo.JobInfoType) (response azgo.JobGetIterResponse, err error) {
+	response, err = azgo.NewJobGetIterRequest().
+		SetQuery(*query).
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("job-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// JobGet returns the job with the specified id; a finished job may already have been removed
+// equivalent to filer::> job show -id 1234
+func (d Driver) JobGet(jobID int) (azgo.JobGetIterResponse, error) {
+	return d.jobGetIter(azgo.NewJobInfoType().SetJobId(jobID))
+}
+
+// JobGetByDescription returns the jobs of the SVM whose description matches, which may contain wildcards
+// equivalent to filer::> job show -vserver iscsi_vs -description "Create v"
+func (d Driver) JobGetByDescription(description string) (azgo.JobGetIterResponse, error) {
+	return d.jobGetIter(azgo.NewJobInfoType().SetJobVserver(d.config.SVM).SetJobDescription(description))
+}
+
+// JOB operations END
+/////////////////////////////////////////////////////////////////////////////
+
 /////////////////////////////////////////////////////////////////////////////
 // MISC operations BEGIN
 
 // NetInterfaceGet returns the list of network interfaces with associated metadata
 // equivalent to filer::> net interface list
 func (d Driver) NetInterfaceGet() (response azgo.NetInterfaceGetIterResponse, err error) {
-	response, err = azgo.NewNetInterfaceGetIterRequest().ExecuteUsing(d.zr)
+	response, err = azgo.NewNetInterfaceGetIterRequest().
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("net-interface-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -285,13 +780,62 @@ func (d Driver) NetInterfaceGet() (response azgo.NetInterfaceGetIterResponse, er
 // equivalent to filer::> version
 func (d Driver) SystemGetVersion() (response azgo.SystemGetVersionResponse, err error) {
 	response, err = azgo.NewSystemGetVersionRequest().ExecuteUsing(d.zr)
+	err = checkResult("system-get-version", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
+// SystemGetOntapiVersion returns the newest ONTAPI version the system speaks
+// equivalent to filer::> set diag; system node image show-api-version
+func (d Driver) SystemGetOntapiVersion() (response azgo.SystemGetOntapiVersionResponse, err error) {
+	response, err = azgo.NewSystemGetOntapiVersionRequest().ExecuteUsing(d.zr)
+	err = checkResult("system-get-ontapi-version", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// NegotiateOntapiVersion picks the newest ONTAPI version both the system and the bindings speak, sends all
+// further requests with it, and returns the features it has
+func (d Driver) NegotiateOntapiVersion() (Capabilities, error) {
+	response, err := d.SystemGetOntapiVersion()
+	if err != nil {
+		return Capabilities{}, err
+	}
+	version, err := negotiateOntapiVersion(response)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	d.zr.OntapiVersion = version.String()
+	return Capabilities{Version: version}, nil
+}
+
 // VserverGetIterRequest returns the vservers on the system
 // equivalent to filer::> vserver show
 func (d Driver) VserverGetIterRequest() (response azgo.VserverGetIterResponse, err error) {
-	response, err = azgo.NewVserverGetIterRequest().ExecuteUsing(d.zr)
+	response, err = azgo.NewVserverGetIterRequest().
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("vserver-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// VserverShowAggrGetIter returns the aggregates assigned to the SVM, along with their available space
+// equivalent to filer::> vserver show-aggregates -vserver iscsi_vs
+func (d Driver) VserverShowAggrGetIter() (response azgo.VserverShowAggrGetIterResponse, err error) {
+	response, err = azgo.NewVserverShowAggrGetIterRequest().
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("vserver-show-aggr-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
+	return
+}
+
+// AggrGetIter returns the aggregates on the cluster; this requires cluster scoped credentials
+// equivalent to filer::> storage aggregate show
+func (d Driver) AggrGetIter() (response azgo.AggrGetIterResponse, err error) {
+	response, err = azgo.NewAggrGetIterRequest().
+		ExecuteAllUsing(d.zr, d.config.MaxRecords)
+	err = checkResult("aggr-get-iter", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
@@ -316,6 +860,8 @@ func (d Driver) EmsAutosupportLog(
 		SetEventSource(eventSource).
 		SetLogLevel(logLevel).
 		ExecuteUsing(d.zr)
+	err = checkResult("ems-autosupport-log", response.Result.ResultStatusAttr, response.Result.ResultReasonAttr,
+		response.Result.ResultErrnoAttr, err)
 	return
 }
 
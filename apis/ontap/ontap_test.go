@@ -86,21 +86,15 @@ func TestIgroup(t *testing.T) {
 
 	// check wrong os type fails
 	response, err := d.IgroupCreate(initiatorGroupName, "iscsi", "leenux")
-	if response.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	if !IsErrno(err, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive invalid input error for incorrect ostype 'leenux'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check wrong group type fails
 	response, err = d.IgroupCreate(initiatorGroupName, "eyescsi", "linux")
-	if response.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	if !IsErrno(err, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive invalid input error for incorrect group type 'eyescsi'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check create passes
 	response, err = d.IgroupCreate(initiatorGroupName, "iscsi", "linux")
@@ -113,21 +107,15 @@ func TestIgroup(t *testing.T) {
 
 	// check double create fails
 	response, err = d.IgroupCreate(initiatorGroupName, "iscsi", "linux")
-	if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_EXISTS {
+	if !IsErrno(err, azgo.EVDISK_ERROR_INITGROUP_EXISTS) {
 		t.Error("Expected to fail to create existing igroup that we should made")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check igroup add fails
 	response2, err2 := d.IgroupAdd(initiatorGroupName, "bad")
-	if response2.Result.ResultErrnoAttr != azgo.EAPIERROR {
+	if !IsErrno(err2, azgo.EAPIERROR) {
 		t.Error("Expected to fail to add an invalid initiator name")
 	}
-	if err2 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check igroup add passes
 	initiator := "iqn.1993-08.org.debian:01:9031309bbebd"
@@ -141,12 +129,9 @@ func TestIgroup(t *testing.T) {
 
 	// check igroup double add fails
 	response2, err2 = d.IgroupAdd(initiatorGroupName, initiator)
-	if response2.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_HAS_NODE {
+	if !IsErrno(err2, azgo.EVDISK_ERROR_INITGROUP_HAS_NODE) {
 		t.Error("Expected to fail to add an initiator twice to the same initiator group")
 	}
-	if err2 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check igroup remove passes
 	response3, err3 := d.IgroupRemove(initiatorGroupName, initiator, true)
@@ -159,12 +144,9 @@ func TestIgroup(t *testing.T) {
 
 	// check igroup initiator remove fails if not in group
 	response3, err3 = d.IgroupRemove(initiatorGroupName, initiator, true)
-	if response3.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_NODE_NOT_IN_INITGROUP {
+	if !IsErrno(err3, azgo.EVDISK_ERROR_NODE_NOT_IN_INITGROUP) {
 		t.Error("Expected to fail to remove a non existant initiator from an initiator group")
 	}
-	if err3 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check destroy passes
 	response4, err4 := d.IgroupDestroy(initiatorGroupName)
@@ -177,12 +159,9 @@ func TestIgroup(t *testing.T) {
 
 	// check double destroy fails
 	response4, err4 = d.IgroupDestroy(initiatorGroupName)
-	if response4.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_NO_SUCH_INITGROUP {
+	if !IsErrno(err4, azgo.EVDISK_ERROR_NO_SUCH_INITGROUP) {
 		t.Error("Expected to fail to delete nonexisting igroup")
 	}
-	if err4 != nil {
-		t.Error("Unexpected error found")
-	}
 }
 
 func TestLun(t *testing.T) {
@@ -193,30 +172,21 @@ func TestLun(t *testing.T) {
 
 	// check wrong os type fails
 	response, err := d.LunCreate(lunPath, 1, "leenux", false)
-	if response.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	if !IsErrno(err, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive invalid input error for incorrect ostype 'leenux'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check missing volume fails
 	response, err = d.LunCreate("/vol/baddddVolume/lun0", 1, "linux", false)
-	if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_NO_SUCH_VOLUME {
+	if !IsErrno(err, azgo.EVDISK_ERROR_NO_SUCH_VOLUME) {
 		t.Error("Expected to receive invalid input error for nonexisting volume 'baddddVolume'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check invalid size fails
 	response, err = d.LunCreate(lunPath, 1, "linux", false)
-	if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_SIZE_TOO_SMALL {
+	if !IsErrno(err, azgo.EVDISK_ERROR_SIZE_TOO_SMALL) {
 		t.Error("Expected to receive invalid size error for lun of size '1'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check valid settings create lun of 1gb
 	response, err = d.LunCreate(lunPath, 1048576*1024, "linux", false)
@@ -229,12 +199,9 @@ func TestLun(t *testing.T) {
 
 	// check double create fails (which validates lun was created as a side effect)
 	response, err = d.LunCreate(lunPath, 1048576*1024, "linux", false)
-	if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_VDISK_EXISTS {
+	if !IsErrno(err, azgo.EVDISK_ERROR_VDISK_EXISTS) {
 		t.Error("Expected to receive disk exists error for already created lun")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check lun offline passes
 	response2, err2 := d.LunOffline(lunPath)
@@ -256,12 +223,9 @@ func TestLun(t *testing.T) {
 
 	// check lun online 2x fails
 	response3, err3 = d.LunOnline(lunPath)
-	if response3.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_VDISK_NOT_DISABLED {
+	if !IsErrno(err3, azgo.EVDISK_ERROR_VDISK_NOT_DISABLED) {
 		t.Error("Expected to receive disk not disabled error for already onlined lun")
 	}
-	if err3 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check lun offline passes
 	response2, err2 = d.LunOffline(lunPath)
@@ -274,12 +238,9 @@ func TestLun(t *testing.T) {
 
 	// check lun offline 2x fails
 	response2, err2 = d.LunOffline(lunPath)
-	if response2.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_VDISK_NOT_ENABLED {
+	if !IsErrno(err2, azgo.EVDISK_ERROR_VDISK_NOT_ENABLED) {
 		t.Error("Expected to receive disk not enabled error for already offlined lun")
 	}
-	if err2 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check lun destroy passes
 	response4, err4 := d.LunDestroy(lunPath)
@@ -292,12 +253,9 @@ func TestLun(t *testing.T) {
 
 	// check lun 2x destroy fails (lun already deleted)
 	response4, err4 = d.LunDestroy(lunPath)
-	if response4.Result.ResultErrnoAttr != azgo.EOBJECTNOTFOUND {
+	if !IsErrno(err4, azgo.EOBJECTNOTFOUND) {
 		t.Error("Expected to receive object missing error for already deleted lun")
 	}
-	if err4 != nil {
-		t.Error("Unexpected error found")
-	}
 }
 
 func TestLunMapping(t *testing.T) {
@@ -323,21 +281,15 @@ func TestLunMapping(t *testing.T) {
 
 	// check lun map fails if already mapped
 	response, err = d.LunMap(initiatorGroupName, lunPath, 0)
-	if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_HAS_VDISK {
+	if !IsErrno(err, azgo.EVDISK_ERROR_INITGROUP_HAS_VDISK) {
 		t.Error("Expected to error because LUN already mapped")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check lun map fails if in use
 	response, err = d.LunMap(initiatorGroupName, lunPath+"b", 0)
-	if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_HAS_LUN {
+	if !IsErrno(err, azgo.EVDISK_ERROR_INITGROUP_HAS_LUN) {
 		t.Error("Expected to error because LUN id in use")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check if lun is NOT mapped behavior
 	response2, err2 := d.LunMapListInfo(lunPath + "b")
@@ -381,61 +333,43 @@ func TestVolumeAndSnapshot(t *testing.T) {
 	exportPolicy := "default"
 
 	// check bad volume name fails
-	response, err := d.VolumeCreate("bad/bad", aggr, "1g", "none", "none", unixPerms, exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EAPIERROR {
+	response, err := d.VolumeCreate("bad/bad", aggr, "1g", "none", "none", unixPerms, exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EAPIERROR) {
 		t.Error("Expected to receive invalid api error for name 'bad/bad'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check bad unix permissions fails
-	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "none", "bad", exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "none", "bad", exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive invalid input error for invalid unix permissions 'bad'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check missing aggregate fails
-	response, err = d.VolumeCreate(volName, "missingAggrBad", "1g", "none", "none", unixPerms, exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EAGGRDOESNOTEXIST {
+	response, err = d.VolumeCreate(volName, "missingAggrBad", "1g", "none", "none", unixPerms, exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EAGGRDOESNOTEXIST) {
 		t.Error("Expected to receive aggr doesn't exist error for invalid aggregrate 'missingAggrBad'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check bad size fails
-	response, err = d.VolumeCreate(volName, aggr, "badSize", "none", "none", unixPerms, exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	response, err = d.VolumeCreate(volName, aggr, "badSize", "none", "none", unixPerms, exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive error for invalid size 'badSize'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check bad space reserve fails
-	response, err = d.VolumeCreate(volName, aggr, "1g", "badSpaceReserve", "none", unixPerms, exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	response, err = d.VolumeCreate(volName, aggr, "1g", "badSpaceReserve", "none", unixPerms, exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive error for invalid space reserve 'badSpaceReserve'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check bad snapshotPolicy fails
-	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "badSnapshotPolicy", unixPerms, exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EAPIERROR {
+	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "badSnapshotPolicy", unixPerms, exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EAPIERROR) {
 		t.Error("Expected to receive error for invalid snapshot policy 'badSnapshotPolicy'")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check create passes
-	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "none", unixPerms, exportPolicy)
+	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "none", unixPerms, exportPolicy, "", "", -1, false)
 	if response.Result.ResultStatusAttr != "passed" {
 		t.Error("Expected to create volume")
 	}
@@ -444,22 +378,16 @@ func TestVolumeAndSnapshot(t *testing.T) {
 	}
 
 	// check double create fails
-	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "none", unixPerms, exportPolicy)
-	if response.Result.ResultErrnoAttr != azgo.EONTAPI_EEXIST {
+	response, err = d.VolumeCreate(volName, aggr, "1g", "none", "none", unixPerms, exportPolicy, "", "", -1, false)
+	if !IsErrno(err, azgo.EONTAPI_EEXIST) {
 		t.Error("Expected to receive error for creating an already existing volume")
 	}
-	if err != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check bad volume mount fails
 	response2, err2 := d.VolumeMount(volName, "-"+volName)
-	if response2.Result.ResultErrnoAttr != azgo.EINVALIDINPUTERROR {
+	if !IsErrno(err2, azgo.EINVALIDINPUTERROR) {
 		t.Error("Expected to receive error for mounting to a bad junction path '-' in name")
 	}
-	if err2 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check volume mount passes
 	response2, err2 = d.VolumeMount(volName, "/"+volName)
@@ -481,12 +409,9 @@ func TestVolumeAndSnapshot(t *testing.T) {
 
 	// check size lookup for missing volume fails
 	response3b, err3b := d.VolumeSize("badVol")
-	if response3b.Result.ResultErrnoAttr != azgo.EOBJECTNOTFOUND {
+	if !IsErrno(err3b, azgo.EOBJECTNOTFOUND) {
 		t.Error("Expected to error looking up size for non existant volume")
 	}
-	if err3b != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check size lookup for existing volume passes
 	response3b, err3b = d.VolumeSize(volName)
@@ -498,13 +423,10 @@ func TestVolumeAndSnapshot(t *testing.T) {
 	}
 
 	// check getting snapshots with missing volume fails
-	response3c, err3c := d.SnapshotGetByVolume("badVol")
-	if response3c.Result.ResultErrnoAttr != azgo.EOBJECTNOTFOUND {
+	_, err3c := d.SnapshotGetByVolume("badVol")
+	if !IsErrno(err3c, azgo.EOBJECTNOTFOUND) {
 		t.Error("Expected EOBJECTNOTFOUND getting snapshots for non-existent volume")
 	}
-	if err3c != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check that a snapshot can be created on the existing volume
 	response3d, err3d := d.SnapshotCreate("mysnap", volName)
@@ -529,12 +451,9 @@ func TestVolumeAndSnapshot(t *testing.T) {
 
 	// check volume offline fails (if still mounted)
 	response4, err4 := d.VolumeOffline(volName)
-	if response4.Result.ResultErrnoAttr != azgo.EONTAPI_EVOLOPNOTSUPP {
+	if !IsErrno(err4, azgo.EONTAPI_EVOLOPNOTSUPP) {
 		t.Error("Expected to receive error for unmounting a mounted volume")
 	}
-	if err4 != nil {
-		t.Error("Unexpected error found")
-	}
 
 	// check volume unmount succeeds
 	response5, err5 := d.VolumeUnmount(volName, true)
@@ -565,10 +484,7 @@ func TestVolumeAndSnapshot(t *testing.T) {
 
 	// check double destroy fails
 	response6, err6 = d.VolumeDestroy(volName, true)
-	if response6.Result.ResultErrnoAttr != azgo.EVOLUMEDOESNOTEXIST {
+	if !IsErrno(err6, azgo.EVOLUMEDOESNOTEXIST) {
 		t.Error("Expected to receive error for deleting a non existant volume")
 	}
-	if err6 != nil {
-		t.Error("Unexpected error found")
-	}
 }
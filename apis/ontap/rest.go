@@ -0,0 +1,1542 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/netapp/netappdvp/azgo"
+	"github.com/netapp/netappdvp/utils"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// restJobPollInterval and restJobTimeout control how long RestDriver waits for asynchronous operations
+var (
+	restJobPollInterval = 1 * time.Second
+	restJobTimeout      = 5 * time.Minute
+)
+
+// RestDriver is the object to use for interacting with the Filer over the ONTAP REST API.  It reports results
+// with the same azgo response types as Driver, so that the storage drivers can use either transport.
+type RestDriver struct {
+	config DriverConfig
+	client *http.Client
+}
+
+// NewRestDriver is a factory method for creating a new instance
+func NewRestDriver(config DriverConfig) *RestDriver {
+	d := &RestDriver{
+		config: config,
+		client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+	}
+	return d
+}
+
+// restError is an error reported by the Filer in answer to a REST request
+type restError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *restError) Error() string {
+	return fmt.Sprintf("%v (%v): %v", e.StatusCode, e.Code, e.Message)
+}
+
+// restNotFound reports that a named object does not exist
+func restNotFound(kind, name string) error {
+	return &restError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("%v %v does not exist", kind, name)}
+}
+
+// restExists reports that an object already exists, using the errno ZAPI returns for the same condition
+func restExists(errno, message string) error {
+	return &restError{StatusCode: http.StatusConflict, Code: errno, Message: message}
+}
+
+// restStatus converts the outcome of a REST call into the status, reason and errno of a ZAPI result.  Errors
+// reported by the Filer fail the result and are returned as an *APIError for the equivalent ZAPI call, with
+// notFoundErrno standing in for objects that do not exist; transport errors fail the result and are returned as is.
+func restStatus(api string, err error, notFoundErrno string) (string, string, string, error) {
+	if err == nil {
+		return "passed", "", "", nil
+	}
+	if re, ok := err.(*restError); ok {
+		errno := re.Code
+		if re.StatusCode == http.StatusNotFound {
+			errno = notFoundErrno
+		}
+		return "failed", re.Message, errno, &APIError{API: api, Status: "failed", Errno: errno, Reason: re.Message}
+	}
+	return "failed", err.Error(), "", err
+}
+
+// restQuery builds query parameters from key/value pairs
+func restQuery(keyvals ...string) url.Values {
+	query := url.Values{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		query.Set(keyvals[i], keyvals[i+1])
+	}
+	return query
+}
+
+// svmQuery builds query parameters from key/value pairs, limited to the configured SVM
+func (d RestDriver) svmQuery(keyvals ...string) url.Values {
+	query := restQuery(keyvals...)
+	if d.config.SVM != "" {
+		query.Set("svm.name", d.config.SVM)
+	}
+	return query
+}
+
+// svm returns the SVM reference for request bodies
+func (d RestDriver) svm() map[string]interface{} {
+	return map[string]interface{}{"name": d.config.SVM}
+}
+
+// call sends a request to the Filer and decodes the answer into out.  Asynchronous operations are waited for.
+func (d RestDriver) call(method, path string, query url.Values, body, out interface{}) error {
+	target := "https://" + d.config.ManagementLIF + "/api" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("Problem encoding request for %v: %v", path, err)
+		}
+	}
+	log.Debugf("sending %v %v: %s", method, target, payload)
+
+	req, err := http.NewRequest(method, target, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.SetBasicAuth(d.config.Username, d.config.Password)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Problem sending %v %v: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Problem reading response to %v %v: %v", method, path, err)
+	}
+	log.Debugf("response Status: %s body: %s", resp.Status, data)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var failure struct {
+			Error struct {
+				Message string `json:"message"`
+				Code    string `json:"code"`
+			} `json:"error"`
+		}
+		json.Unmarshal(data, &failure)
+		if failure.Error.Message == "" {
+			failure.Error.Message = http.StatusText(resp.StatusCode)
+		}
+		return &restError{StatusCode: resp.StatusCode, Code: failure.Error.Code, Message: failure.Error.Message}
+	}
+
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("Problem decoding response to %v %v: %v", method, path, err)
+		}
+	}
+
+	if resp.StatusCode == http.StatusAccepted {
+		var accepted struct {
+			Job struct {
+				UUID string `json:"uuid"`
+			} `json:"job"`
+		}
+		json.Unmarshal(data, &accepted)
+		if accepted.Job.UUID != "" {
+			return d.waitForJob(accepted.Job.UUID)
+		}
+	}
+	return nil
+}
+
+// waitForJob polls an asynchronous operation until it finishes
+func (d RestDriver) waitForJob(uuid string) error {
+	for start := time.Now(); time.Since(start) < restJobTimeout; time.Sleep(restJobPollInterval) {
+		var job struct {
+			State   string `json:"state"`
+			Message string `json:"message"`
+			Code    int    `json:"code"`
+		}
+		if err := d.call("GET", "/cluster/jobs/"+uuid, restQuery("fields", "state,message,code"), nil, &job); err != nil {
+			return err
+		}
+		log.Debugf("job %v is %v", uuid, job.State)
+
+		switch job.State {
+		case "success":
+			return nil
+		case "failure":
+			return &restError{StatusCode: http.StatusInternalServerError, Code: strconv.Itoa(job.Code), Message: job.Message}
+		}
+	}
+	return fmt.Errorf("Timed out waiting for job %v", uuid)
+}
+
+// records returns all the records of a collection, following the links to further pages
+func (d RestDriver) records(path string, query url.Values, records interface{}) error {
+	maxRecords := d.config.MaxRecords
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+	query.Set("max_records", strconv.Itoa(maxRecords))
+
+	var all []json.RawMessage
+	for {
+		var page struct {
+			Records []json.RawMessage `json:"records"`
+			Links   struct {
+				Next struct {
+					Href string `json:"href"`
+				} `json:"next"`
+			} `json:"_links"`
+		}
+		if err := d.call("GET", path, query, nil, &page); err != nil {
+			return err
+		}
+		all = append(all, page.Records...)
+
+		next := page.Links.Next.Href
+		if next == "" {
+			break
+		}
+		link, err := url.Parse(next)
+		if err != nil {
+			return fmt.Errorf("Problem following link %v: %v", next, err)
+		}
+		path, query = strings.TrimPrefix(link.Path, "/api"), link.Query()
+	}
+
+	data, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, records)
+}
+
+// uuid returns the UUID of the named object in a collection of the configured SVM
+func (d RestDriver) uuid(path, kind, name string) (string, error) {
+	var records []restRef
+	if err := d.records(path, d.svmQuery("name", name, "fields", "uuid"), &records); err != nil {
+		return "", err
+	}
+	if len(records) == 0 {
+		return "", restNotFound(kind, name)
+	}
+	return records[0].UUID, nil
+}
+
+// restRef identifies an object by name and UUID
+type restRef struct {
+	Name string `json:"name,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+}
+
+/////////////////////////////////////////////////////////////////////////////
+// IGROUP operations BEGIN
+
+// restIgroup is an initiator group as returned by /api/protocols/san/igroups
+type restIgroup struct {
+	Name       string    `json:"name"`
+	UUID       string    `json:"uuid"`
+	Initiators []restRef `json:"initiators"`
+}
+
+// igroup returns the named initiator group, with its initiators
+func (d RestDriver) igroup(name string) (*restIgroup, error) {
+	var igroups []restIgroup
+	err := d.records("/protocols/san/igroups", d.svmQuery("name", name, "fields", "name,uuid,initiators"), &igroups)
+	if err != nil {
+		return nil, err
+	}
+	if len(igroups) == 0 {
+		return nil, restNotFound("initiator group", name)
+	}
+	return &igroups[0], nil
+}
+
+// IgroupCreate creates the specified initiator group
+// equivalent to POST /api/protocols/san/igroups
+func (d RestDriver) IgroupCreate(initiatorGroupName, initiatorGroupType, osType string) (response azgo.IgroupCreateResponse, err error) {
+	if _, err = d.igroup(initiatorGroupName); err == nil {
+		err = restExists(azgo.EVDISK_ERROR_INITGROUP_EXISTS, "initiator group "+initiatorGroupName+" already exists")
+	} else if re, ok := err.(*restError); ok && re.StatusCode == http.StatusNotFound {
+		err = d.call("POST", "/protocols/san/igroups", nil, map[string]interface{}{
+			"svm":      d.svm(),
+			"name":     initiatorGroupName,
+			"protocol": initiatorGroupType,
+			"os_type":  osType,
+		}, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("igroup-create", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// IgroupAdd adds an initiator to an initiator group
+// equivalent to POST /api/protocols/san/igroups/{uuid}/initiators
+func (d RestDriver) IgroupAdd(initiatorGroupName, initiator string) (response azgo.IgroupAddResponse, err error) {
+	igroup, err := d.igroup(initiatorGroupName)
+	if err == nil {
+		for _, member := range igroup.Initiators {
+			if member.Name == initiator {
+				err = restExists(azgo.EVDISK_ERROR_INITGROUP_HAS_NODE, "initiator "+initiator+" is already in initiator group "+initiatorGroupName)
+			}
+		}
+	}
+	if err == nil {
+		err = d.call("POST", "/protocols/san/igroups/"+igroup.UUID+"/initiators", nil,
+			map[string]interface{}{"name": initiator}, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("igroup-add", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// IgroupRemove removes an initiator from an initiator group
+// equivalent to DELETE /api/protocols/san/igroups/{uuid}/initiators/{name}
+func (d RestDriver) IgroupRemove(initiatorGroupName, initiator string, force bool) (response azgo.IgroupRemoveResponse, err error) {
+	igroup, err := d.igroup(initiatorGroupName)
+	if err == nil {
+		err = d.call("DELETE", "/protocols/san/igroups/"+igroup.UUID+"/initiators/"+url.PathEscape(initiator),
+			restQuery("allow_delete_while_mapped", strconv.FormatBool(force)), nil, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("igroup-remove", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// IgroupDestroy destroys an initiator group
+// equivalent to DELETE /api/protocols/san/igroups/{uuid}
+func (d RestDriver) IgroupDestroy(initiatorGroupName string) (response azgo.IgroupDestroyResponse, err error) {
+	igroup, err := d.igroup(initiatorGroupName)
+	if err == nil {
+		err = d.call("DELETE", "/protocols/san/igroups/"+igroup.UUID, nil, nil, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("igroup-destroy", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// IGROUP operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// LUN operations BEGIN
+
+// restLun is a lun as returned by /api/storage/luns
+type restLun struct {
+	Name         string `json:"name"`
+	UUID         string `json:"uuid"`
+	SerialNumber string `json:"serial_number"`
+	Comment      string `json:"comment"`
+	Enabled      bool   `json:"enabled"`
+	Location     struct {
+		Volume restRef `json:"volume"`
+	} `json:"location"`
+	Space struct {
+		Size int `json:"size"`
+		Used int `json:"used"`
+	} `json:"space"`
+	Status struct {
+		Mapped bool `json:"mapped"`
+	} `json:"status"`
+}
+
+// restLunMap is a lun mapping as returned by /api/protocols/san/lun-maps
+type restLunMap struct {
+	Igroup            restRef `json:"igroup"`
+	Lun               restRef `json:"lun"`
+	LogicalUnitNumber int     `json:"logical_unit_number"`
+}
+
+// LunCreate creates a lun with the specified attributes
+// equivalent to POST /api/storage/luns
+func (d RestDriver) LunCreate(lunPath string, sizeInBytes int, osType string, spaceReserved bool) (response azgo.LunCreateBySizeResponse, err error) {
+	var created struct {
+		Records []restLun `json:"records"`
+	}
+	err = d.call("POST", "/storage/luns", restQuery("return_records", "true"), map[string]interface{}{
+		"svm":     d.svm(),
+		"name":    lunPath,
+		"os_type": osType,
+		"space": map[string]interface{}{
+			"size":      sizeInBytes,
+			"guarantee": map[string]interface{}{"requested": spaceReserved},
+		},
+	}, &created)
+	if err == nil && len(created.Records) > 0 {
+		response.Result.SetActualSize(created.Records[0].Space.Size)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-create-by-size", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunGetSerialNumber returns the serial# for a lun
+// equivalent to GET /api/storage/luns?name={path}&fields=serial_number
+func (d RestDriver) LunGetSerialNumber(lunPath string) (response azgo.LunGetSerialNumberResponse, err error) {
+	var luns []restLun
+	err = d.records("/storage/luns", d.svmQuery("name", lunPath, "fields", "serial_number"), &luns)
+	if err == nil {
+		if len(luns) == 0 {
+			err = restNotFound("lun", lunPath)
+		} else {
+			response.Result.SetSerialNumber(luns[0].SerialNumber)
+		}
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-get-serial-number", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunMap maps a lun to an id in an initiator group
+// equivalent to POST /api/protocols/san/lun-maps
+func (d RestDriver) LunMap(initiatorGroupName, lunPath string, lunID int) (response azgo.LunMapResponse, err error) {
+	var maps []restLunMap
+	err = d.records("/protocols/san/lun-maps",
+		d.svmQuery("igroup.name", initiatorGroupName, "fields", "logical_unit_number"), &maps)
+	if err == nil {
+		for _, m := range maps {
+			if m.LogicalUnitNumber == lunID {
+				err = restExists(azgo.EVDISK_ERROR_INITGROUP_HAS_LUN,
+					fmt.Sprintf("lun id %v is already in use in initiator group %v", lunID, initiatorGroupName))
+			}
+		}
+	}
+	if err == nil {
+		err = d.call("POST", "/protocols/san/lun-maps", nil, map[string]interface{}{
+			"svm":                 d.svm(),
+			"igroup":              map[string]interface{}{"name": initiatorGroupName},
+			"lun":                 map[string]interface{}{"name": lunPath},
+			"logical_unit_number": lunID,
+		}, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-map", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunMapListInfo returns lun mapping information for the specified lun
+// equivalent to GET /api/protocols/san/lun-maps?lun.name={path}
+func (d RestDriver) LunMapListInfo(lunPath string) (response azgo.LunMapListInfoResponse, err error) {
+	var maps []restLunMap
+	err = d.records("/protocols/san/lun-maps",
+		d.svmQuery("lun.name", lunPath, "fields", "igroup.name,logical_unit_number"), &maps)
+	if err == nil {
+		groups := make([]azgo.InitiatorGroupInfoType, 0)
+		for _, m := range maps {
+			group := azgo.NewInitiatorGroupInfoType().
+				SetInitiatorGroupName(m.Igroup.Name).
+				SetLunId(m.LogicalUnitNumber)
+			groups = append(groups, *group)
+		}
+		response.Result.SetInitiatorGroups(groups)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-map-list-info", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// modifyLun changes the attributes of the specified lun
+func (d RestDriver) modifyLun(lunPath string, body map[string]interface{}) error {
+	uuid, err := d.uuid("/storage/luns", "lun", lunPath)
+	if err != nil {
+		return err
+	}
+	return d.call("PATCH", "/storage/luns/"+uuid, nil, body, nil)
+}
+
+// LunOffline offlines a lun
+// equivalent to PATCH /api/storage/luns/{uuid} enabled=false
+func (d RestDriver) LunOffline(lunPath string) (response azgo.LunOfflineResponse, err error) {
+	err = d.modifyLun(lunPath, map[string]interface{}{"enabled": false})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-offline", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunOnline onlines a lun
+// equivalent to PATCH /api/storage/luns/{uuid} enabled=true
+func (d RestDriver) LunOnline(lunPath string) (response azgo.LunOnlineResponse, err error) {
+	err = d.modifyLun(lunPath, map[string]interface{}{"enabled": true})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-online", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunDestroy destroys a lun
+// equivalent to DELETE /api/storage/luns/{uuid}
+func (d RestDriver) LunDestroy(lunPath string) (response azgo.LunDestroyResponse, err error) {
+	uuid, err := d.uuid("/storage/luns", "lun", lunPath)
+	if err == nil {
+		err = d.call("DELETE", "/storage/luns/"+uuid, nil, nil, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-destroy", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunGet returns the luns whose path matches, which may contain wildcards
+// equivalent to GET /api/storage/luns?name={path}
+func (d RestDriver) LunGet(lunPath string) (response azgo.LunGetIterResponse, err error) {
+	var luns []restLun
+	err = d.records("/storage/luns", d.svmQuery("name", lunPath,
+		"fields", "name,uuid,serial_number,comment,enabled,location.volume.name,space.size,space.used,status.mapped"), &luns)
+	if err == nil {
+		list := make([]azgo.LunInfoType, 0)
+		for _, lun := range luns {
+			info := azgo.NewLunInfoType().
+				SetPath(lun.Name).
+				SetUuid(lun.UUID).
+				SetVolume(lun.Location.Volume.Name).
+				SetVserver(d.config.SVM).
+				SetSerialNumber(lun.SerialNumber).
+				SetComment(lun.Comment).
+				SetOnline(lun.Enabled).
+				SetMapped(lun.Status.Mapped).
+				SetSize(lun.Space.Size).
+				SetSizeUsed(lun.Space.Used)
+			list = append(list, *info)
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunSetAttribute sets a named attribute of a lun, replacing any earlier value
+// equivalent to PATCH /api/storage/luns/{uuid}/attributes/{name}, or POST if the attribute is new
+func (d RestDriver) LunSetAttribute(lunPath, name, value string) (response azgo.LunSetAttributeResponse, err error) {
+	uuid, err := d.uuid("/storage/luns", "lun", lunPath)
+	if err == nil {
+		err = d.call("PATCH", "/storage/luns/"+uuid+"/attributes/"+url.PathEscape(name), nil,
+			map[string]interface{}{"value": value}, nil)
+		if re, ok := err.(*restError); ok && re.StatusCode == http.StatusNotFound {
+			err = d.call("POST", "/storage/luns/"+uuid+"/attributes", nil,
+				map[string]interface{}{"name": name, "value": value}, nil)
+		}
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-set-attribute", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunGetAttribute returns a named attribute of a lun
+// equivalent to GET /api/storage/luns/{uuid}/attributes/{name}
+func (d RestDriver) LunGetAttribute(lunPath, name string) (response azgo.LunGetAttributeResponse, err error) {
+	var attribute struct {
+		Value string `json:"value"`
+	}
+	uuid, err := d.uuid("/storage/luns", "lun", lunPath)
+	if err == nil {
+		err = d.call("GET", "/storage/luns/"+uuid+"/attributes/"+url.PathEscape(name), nil, nil, &attribute)
+	}
+	if err == nil {
+		response.Result.SetValue(attribute.Value)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("lun-get-attribute", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LunCloneCreate creates a clone of a lun in the same volume, from the active file system or a snapshot
+// equivalent to POST /api/storage/luns clone.source.name
+func (d RestDriver) LunCloneCreate(volumeName, source, destination, snapshot string) (response azgo.CloneCreateResponse, err error) {
+	sourcePath := "/vol/" + volumeName + "/" + source
+	if snapshot != "" {
+		sourcePath = "/vol/" + volumeName + "/.snapshot/" + snapshot + "/" + source
+	}
+	err = d.call("POST", "/storage/luns", nil, map[string]interface{}{
+		"svm":   d.svm(),
+		"name":  "/vol/" + volumeName + "/" + destination,
+		"clone": map[string]interface{}{"source": map[string]interface{}{"name": sourcePath}},
+		"space": map[string]interface{}{"guarantee": map[string]interface{}{"requested": false}},
+	}, nil)
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("clone-create", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// LUN operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// VOLUME operations BEGIN
+
+// restVolume is a volume as returned by /api/storage/volumes
+type restVolume struct {
+	Name    string `json:"name"`
+	UUID    string `json:"uuid"`
+	Comment string `json:"comment"`
+	Size    int64  `json:"size"`
+	Clone   struct {
+		IsFlexclone          bool    `json:"is_flexclone"`
+		ParentVolume         restRef `json:"parent_volume"`
+		ParentSnapshot       restRef `json:"parent_snapshot"`
+		SplitCompletePercent int     `json:"split_complete_percent"`
+	} `json:"clone"`
+}
+
+// volume returns the named volume with the requested fields
+func (d RestDriver) volume(name, fields string) (*restVolume, error) {
+	var volumes []restVolume
+	if err := d.records("/storage/volumes", d.svmQuery("name", name, "fields", fields), &volumes); err != nil {
+		return nil, err
+	}
+	if len(volumes) == 0 {
+		return nil, restNotFound("volume", name)
+	}
+	return &volumes[0], nil
+}
+
+// modifyVolume changes the attributes of the specified volume
+func (d RestDriver) modifyVolume(name string, body map[string]interface{}) error {
+	uuid, err := d.uuid("/storage/volumes", "volume", name)
+	if err != nil {
+		return err
+	}
+	return d.call("PATCH", "/storage/volumes/"+uuid, nil, body, nil)
+}
+
+// restSize converts a ZAPI size such as 1g into bytes
+func restSize(size string) (int64, error) {
+	bytes, err := utils.ConvertSizeToBytes64(size)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid size %v: %v", size, err)
+	}
+	return strconv.ParseInt(bytes, 10, 64)
+}
+
+// restUnixPermissions converts ZAPI permissions, either symbolic as in ---rwxr-xr-x or octal as in 0755, into
+// the octal digits REST expects, as in 755
+func restUnixPermissions(permissions string) (int, error) {
+	if len(permissions) == 9 || len(permissions) == 12 {
+		symbolic := permissions[len(permissions)-9:]
+		value := 0
+		for i := 0; i < 9; i += 3 {
+			digit := 0
+			for j, bit := range []int{4, 2, 1} {
+				switch symbolic[i+j] {
+				case "rwx"[j]:
+					digit += bit
+				case '-':
+				default:
+					return 0, fmt.Errorf("Invalid unix permissions: %v", permissions)
+				}
+			}
+			value = value*10 + digit
+		}
+		return value, nil
+	}
+
+	octal, err := strconv.ParseUint(permissions, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid unix permissions: %v", permissions)
+	}
+	return strconv.Atoi(strconv.FormatUint(octal, 8))
+}
+
+// createVolume creates a FlexVol or FlexGroup volume, as style says, on the specified aggregates
+func (d RestDriver) createVolume(name, style string, aggregateNames []string, size, spaceReserve, snapshotPolicy,
+	unixPermissions, exportPolicy, securityStyle, tieringPolicy string, snapshotReserve int, encrypt bool) error {
+	volume := map[string]interface{}{
+		"svm":   d.svm(),
+		"name":  name,
+		"style": style,
+	}
+	nas := map[string]interface{}{}
+
+	if len(aggregateNames) > 0 {
+		aggregates := make([]map[string]interface{}, 0, len(aggregateNames))
+		for _, aggregateName := range aggregateNames {
+			aggregates = append(aggregates, map[string]interface{}{"name": aggregateName})
+		}
+		volume["aggregates"] = aggregates
+	}
+	if spaceReserve != "" {
+		volume["guarantee"] = map[string]interface{}{"type": spaceReserve}
+	}
+	if snapshotPolicy != "" {
+		volume["snapshot_policy"] = map[string]interface{}{"name": snapshotPolicy}
+	}
+	if exportPolicy != "" {
+		nas["export_policy"] = map[string]interface{}{"name": exportPolicy}
+	}
+	if securityStyle != "" {
+		nas["security_style"] = securityStyle
+	}
+	if tieringPolicy != "" {
+		volume["tiering"] = map[string]interface{}{"policy": tieringPolicy}
+	}
+	if snapshotReserve >= 0 {
+		volume["space"] = map[string]interface{}{"snapshot": map[string]interface{}{"reserve_percent": snapshotReserve}}
+	}
+	if encrypt {
+		volume["encryption"] = map[string]interface{}{"enabled": true}
+	}
+
+	sizeInBytes, err := restSize(size)
+	if err == nil && unixPermissions != "" {
+		var permissions int
+		if permissions, err = restUnixPermissions(unixPermissions); err == nil {
+			nas["unix_permissions"] = permissions
+		}
+	}
+	if err != nil {
+		// invalid arguments fail the result, as they do with ZAPI
+		return &restError{StatusCode: http.StatusBadRequest, Code: azgo.EINVALIDINPUTERROR, Message: err.Error()}
+	}
+
+	volume["size"] = sizeInBytes
+	if len(nas) > 0 {
+		volume["nas"] = nas
+	}
+	return d.call("POST", "/storage/volumes", nil, volume, nil)
+}
+
+// VolumeCreate creates a volume with the specified options
+// equivalent to POST /api/storage/volumes
+func (d RestDriver) VolumeCreate(name, aggregateName, size, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy,
+	securityStyle, tieringPolicy string, snapshotReserve int, encrypt bool) (response azgo.VolumeCreateResponse, err error) {
+	var aggregateNames []string
+	if aggregateName != "" {
+		aggregateNames = []string{aggregateName}
+	}
+	err = d.createVolume(name, "flexvol", aggregateNames, size, spaceReserve, snapshotPolicy, unixPermissions,
+		exportPolicy, securityStyle, tieringPolicy, snapshotReserve, encrypt)
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-create", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VolumeSetAutosize sets the autosize mode and maximum size of the specified volume
+// equivalent to PATCH /api/storage/volumes/{uuid} autosize
+func (d RestDriver) VolumeSetAutosize(name, mode, maximumSize string) (response azgo.VolumeAutosizeSetResponse, err error) {
+	autosize := map[string]interface{}{"mode": mode}
+	if maximumSize != "" {
+		var maximum int64
+		if maximum, err = restSize(maximumSize); err != nil {
+			err = &restError{StatusCode: http.StatusBadRequest, Code: azgo.EINVALIDINPUTERROR, Message: err.Error()}
+		}
+		autosize["maximum"] = maximum
+	}
+	if err == nil {
+		err = d.modifyVolume(name, map[string]interface{}{"autosize": autosize})
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-autosize-set", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// SisEnable enables storage efficiency (deduplication) on the specified volume
+// equivalent to PATCH /api/storage/volumes/{uuid} efficiency.dedupe=background
+func (d RestDriver) SisEnable(name string) (response azgo.SisEnableResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{
+		"efficiency": map[string]interface{}{"dedupe": "background"},
+	})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("sis-enable", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// SisSetConfig configures compression on the specified volume
+// equivalent to PATCH /api/storage/volumes/{uuid} efficiency.compression
+func (d RestDriver) SisSetConfig(name string, compression, inlineCompression bool) (response azgo.SisSetConfigResponse, err error) {
+	mode := "none"
+	switch {
+	case compression && inlineCompression:
+		mode = "both"
+	case compression:
+		mode = "background"
+	case inlineCompression:
+		mode = "inline"
+	}
+	err = d.modifyVolume(name, map[string]interface{}{
+		"efficiency": map[string]interface{}{"compression": mode},
+	})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("sis-set-config", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// cloneVolume clones a volume from a snapshot
+func (d RestDriver) cloneVolume(name, source, snapshot string) error {
+	parent, err := d.uuid("/storage/volumes", "volume", source)
+	if err != nil {
+		return err
+	}
+	var snapshots []restRef
+	err = d.records("/storage/volumes/"+parent+"/snapshots", restQuery("name", snapshot, "fields", "uuid"), &snapshots)
+	if err == nil && len(snapshots) == 0 {
+		err = restNotFound("snapshot", snapshot)
+	}
+	if err != nil {
+		return err
+	}
+	return d.call("POST", "/storage/volumes", nil, map[string]interface{}{
+		"svm":  d.svm(),
+		"name": name,
+		"clone": map[string]interface{}{
+			"is_flexclone":    true,
+			"parent_volume":   map[string]interface{}{"name": source},
+			"parent_snapshot": map[string]interface{}{"name": snapshot},
+		},
+	}, nil)
+}
+
+// VolumeCloneCreate clones a volume from a snapshot
+// equivalent to POST /api/storage/volumes with clone.parent_volume and clone.parent_snapshot
+func (d RestDriver) VolumeCloneCreate(name, source, snapshot string) (response azgo.VolumeCloneCreateResponse, err error) {
+	err = d.cloneVolume(name, source, snapshot)
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-clone-create", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VolumeCloneGet returns the clone information of the specified volume, including its parent volume and snapshot;
+// like ZAPI, this fails with EOBJECTNOTFOUND if the volume is not a clone
+// equivalent to GET /api/storage/volumes?name={name}&fields=clone
+func (d RestDriver) VolumeCloneGet(name string) (response azgo.VolumeCloneGetResponse, err error) {
+	volume, err := d.volume(name, "clone")
+	if err == nil {
+		if !volume.Clone.IsFlexclone {
+			err = restNotFound("clone", name)
+		} else {
+			info := azgo.NewVolumeCloneInfoType().
+				SetVolume(name).
+				SetParentVolume(volume.Clone.ParentVolume.Name).
+				SetParentSnapshot(volume.Clone.ParentSnapshot.Name)
+			response.Result.SetAttributes(*info)
+		}
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-clone-get", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VolumeCloneSplitStart starts splitting the specified clone from its parent volume
+// equivalent to PATCH /api/storage/volumes/{uuid} clone.split_initiated=true
+func (d RestDriver) VolumeCloneSplitStart(name string) (response azgo.VolumeCloneSplitStartResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{
+		"clone": map[string]interface{}{"split_initiated": true},
+	})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-clone-split-start", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VolumeCloneSplitStatus returns the progress of a split of the specified clone
+// equivalent to GET /api/storage/volumes?name={name}&fields=clone.split_complete_percent
+func (d RestDriver) VolumeCloneSplitStatus(name string) (response azgo.VolumeCloneSplitStatusResponse, err error) {
+	volume, err := d.volume(name, "clone.split_complete_percent")
+	if err == nil {
+		detail := azgo.NewCloneSplitDetailInfoType().
+			SetName(name).
+			SetBlockPercentageComplete(volume.Clone.SplitCompletePercent)
+		response.Result.SetCloneSplitDetails([]azgo.CloneSplitDetailInfoType{*detail})
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-clone-split-status", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// restVolumeAttributes converts volumes into the attributes ZAPI returns from volume-get-iter
+func restVolumeAttributes(volumes []restVolume) []azgo.VolumeAttributesType {
+	attributes := make([]azgo.VolumeAttributesType, 0)
+	for _, volume := range volumes {
+		idattr := azgo.NewVolumeIdAttributesType().
+			SetName(azgo.VolumeNameType(volume.Name)).
+			SetUuid(azgo.UuidType(volume.UUID)).
+			SetComment(volume.Comment)
+		attributes = append(attributes, *azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*idattr))
+	}
+	return attributes
+}
+
+// VolumeListClones returns the volumes that are clones of the specified volume
+// equivalent to GET /api/storage/volumes?clone.parent_volume.name={parent}
+func (d RestDriver) VolumeListClones(parent string) (response azgo.VolumeGetIterResponse, err error) {
+	var volumes []restVolume
+	err = d.records("/storage/volumes", d.svmQuery("clone.parent_volume.name", parent, "fields", "name,uuid,comment"), &volumes)
+	if err == nil {
+		response.Result.SetAttributesList(restVolumeAttributes(volumes))
+		response.Result.SetNumRecords(len(volumes))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VolumeDisableSnapshotDirectoryAccess disables access to the ".snapshot" directory
+// equivalent to PATCH /api/storage/volumes/{uuid} snapshot_directory_access_enabled=false
+func (d RestDriver) VolumeDisableSnapshotDirectoryAccess(name string) (response azgo.VolumeModifyIterResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{"snapshot_directory_access_enabled": false})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-modify-iter", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeSetQosPolicyGroupName assigns the specified volume to a QoS policy group
+// equivalent to PATCH /api/storage/volumes/{uuid} qos.policy.name
+func (d RestDriver) VolumeSetQosPolicyGroupName(name, policyGroup string) (response azgo.VolumeModifyIterResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{
+		"qos": map[string]interface{}{"policy": map[string]interface{}{"name": policyGroup}},
+	})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-modify-iter", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeSetComment sets the comment on the specified volume
+// equivalent to PATCH /api/storage/volumes/{uuid} comment
+func (d RestDriver) VolumeSetComment(name, comment string) (response azgo.VolumeModifyIterResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{"comment": comment})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-modify-iter", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeGet returns the attributes of the specified volume; like volume-get-iter, a volume that does not exist
+// yields an empty list
+// equivalent to GET /api/storage/volumes?name={name}
+func (d RestDriver) VolumeGet(name string) (response azgo.VolumeGetIterResponse, err error) {
+	var volumes []restVolume
+	err = d.records("/storage/volumes", d.svmQuery("name", name, "fields", "name,uuid,comment"), &volumes)
+	if err == nil {
+		response.Result.SetAttributesList(restVolumeAttributes(volumes))
+		response.Result.SetNumRecords(len(volumes))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-get-iter", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeSize retrieves the size of the specified volume, in bytes
+// equivalent to GET /api/storage/volumes?name={name}&fields=size
+func (d RestDriver) VolumeSize(name string) (response azgo.VolumeSizeResponse, err error) {
+	volume, err := d.volume(name, "size")
+	if err == nil {
+		response.Result.SetVolumeSize(strconv.FormatInt(volume.Size, 10))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-size", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeSetSize sets the size of the specified volume; a leading + or - grows or shrinks it by that much
+// equivalent to PATCH /api/storage/volumes/{uuid} size
+func (d RestDriver) VolumeSetSize(name, newSize string) (response azgo.VolumeSizeResponse, err error) {
+	relative := strings.TrimLeft(newSize, "+-")
+	size, err := restSize(relative)
+	if err == nil && relative != newSize {
+		var volume *restVolume
+		if volume, err = d.volume(name, "size"); err == nil {
+			if strings.HasPrefix(newSize, "-") {
+				size = volume.Size - size
+			} else {
+				size = volume.Size + size
+			}
+		}
+	} else if err != nil {
+		err = &restError{StatusCode: http.StatusBadRequest, Code: azgo.EINVALIDINPUTERROR, Message: err.Error()}
+	}
+	if err == nil {
+		err = d.modifyVolume(name, map[string]interface{}{"size": size})
+	}
+	if err == nil {
+		response.Result.SetVolumeSize(strconv.FormatInt(size, 10))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-size", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeMount mounts a volume at the specified junction
+// equivalent to PATCH /api/storage/volumes/{uuid} nas.path
+func (d RestDriver) VolumeMount(name, junctionPath string) (response azgo.VolumeMountResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{
+		"nas": map[string]interface{}{"path": junctionPath},
+	})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-mount", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeUnmount unmounts a volume from its junction; REST has no forced unmount, so force is ignored
+// equivalent to PATCH /api/storage/volumes/{uuid} nas.path=""
+func (d RestDriver) VolumeUnmount(name string, force bool) (response azgo.VolumeUnmountResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{
+		"nas": map[string]interface{}{"path": ""},
+	})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-unmount", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeOffline offlines a volume
+// equivalent to PATCH /api/storage/volumes/{uuid} state=offline
+func (d RestDriver) VolumeOffline(name string) (response azgo.VolumeOfflineResponse, err error) {
+	err = d.modifyVolume(name, map[string]interface{}{"state": "offline"})
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-offline", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeDestroy destroys a volume; ONTAP unmounts and offlines the volume itself, so force is implied
+// equivalent to DELETE /api/storage/volumes/{uuid}
+func (d RestDriver) VolumeDestroy(name string, force bool) (response azgo.VolumeDestroyResponse, err error) {
+	uuid, err := d.uuid("/storage/volumes", "volume", name)
+	if err == nil {
+		err = d.call("DELETE", "/storage/volumes/"+uuid, nil, nil, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-destroy", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// REST handles FlexVol and FlexGroup volumes alike and waits for their jobs, so the asynchronous calls below
+// report result-status succeeded once the operation has finished, and never a job to wait for
+
+// VolumeCreateAsync creates a FlexGroup volume across the specified aggregates
+// equivalent to POST /api/storage/volumes style=flexgroup
+func (d RestDriver) VolumeCreateAsync(name string, aggregateNames []string, size, spaceReserve, snapshotPolicy,
+	unixPermissions, exportPolicy, securityStyle, tieringPolicy string, snapshotReserve int,
+	encrypt bool) (response azgo.VolumeCreateAsyncResponse, err error) {
+	err = d.createVolume(name, "flexgroup", aggregateNames, size, spaceReserve, snapshotPolicy, unixPermissions,
+		exportPolicy, securityStyle, tieringPolicy, snapshotReserve, encrypt)
+	if err == nil {
+		response.Result.SetResultStatus(AsyncStatusSucceeded)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-create-async", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VolumeCloneCreateAsync clones a FlexGroup volume from a snapshot
+// equivalent to POST /api/storage/volumes with clone.parent_volume and clone.parent_snapshot
+func (d RestDriver) VolumeCloneCreateAsync(name, source, snapshot string) (response azgo.VolumeCloneCreateAsyncResponse, err error) {
+	err = d.cloneVolume(name, source, snapshot)
+	if err == nil {
+		response.Result.SetResultStatus(AsyncStatusSucceeded)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-clone-create-async", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// modifyVolumeAsync changes the attributes of the specified volume, reporting the outcome as volume-modify-iter-async
+func (d RestDriver) modifyVolumeAsync(name string, body map[string]interface{}) (response azgo.VolumeModifyIterAsyncResponse, err error) {
+	err = d.modifyVolume(name, body)
+	if err == nil {
+		response.Result.SetNumSucceeded(1).SetNumFailed(0)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-modify-iter-async", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VolumeDisableSnapshotDirectoryAccessAsync disables access to the ".snapshot" directory of a FlexGroup volume
+// equivalent to PATCH /api/storage/volumes/{uuid} snapshot_directory_access_enabled=false
+func (d RestDriver) VolumeDisableSnapshotDirectoryAccessAsync(name string) (azgo.VolumeModifyIterAsyncResponse, error) {
+	return d.modifyVolumeAsync(name, map[string]interface{}{"snapshot_directory_access_enabled": false})
+}
+
+// VolumeSetQosPolicyGroupNameAsync assigns the specified FlexGroup volume to a QoS policy group
+// equivalent to PATCH /api/storage/volumes/{uuid} qos.policy.name
+func (d RestDriver) VolumeSetQosPolicyGroupNameAsync(name, policyGroup string) (azgo.VolumeModifyIterAsyncResponse, error) {
+	return d.modifyVolumeAsync(name, map[string]interface{}{
+		"qos": map[string]interface{}{"policy": map[string]interface{}{"name": policyGroup}},
+	})
+}
+
+// VolumeSetCommentAsync sets the comment on the specified FlexGroup volume
+// equivalent to PATCH /api/storage/volumes/{uuid} comment
+func (d RestDriver) VolumeSetCommentAsync(name, comment string) (azgo.VolumeModifyIterAsyncResponse, error) {
+	return d.modifyVolumeAsync(name, map[string]interface{}{"comment": comment})
+}
+
+// VolumeDestroyAsync destroys a FlexGroup volume
+// equivalent to DELETE /api/storage/volumes/{uuid}
+func (d RestDriver) VolumeDestroyAsync(name string, force bool) (response azgo.VolumeDestroyAsyncResponse, err error) {
+	uuid, err := d.uuid("/storage/volumes", "volume", name)
+	if err == nil {
+		err = d.call("DELETE", "/storage/volumes/"+uuid, nil, nil, nil)
+	}
+	if err == nil {
+		response.Result.SetResultStatus(AsyncStatusSucceeded)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("volume-destroy-async", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// VOLUME operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// SNAPSHOT operations BEGIN
+
+// SnapshotCreate creates a snapshot of a volume
+// equivalent to POST /api/storage/volumes/{uuid}/snapshots
+func (d RestDriver) SnapshotCreate(name, volumeName string) (response azgo.SnapshotCreateResponse, err error) {
+	uuid, err := d.uuid("/storage/volumes", "volume", volumeName)
+	if err == nil {
+		err = d.call("POST", "/storage/volumes/"+uuid+"/snapshots", nil, map[string]interface{}{"name": name}, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("snapshot-create", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// SnapshotGetByVolume returns the list of snapshots associated with a volume
+// equivalent to GET /api/storage/volumes/{uuid}/snapshots
+func (d RestDriver) SnapshotGetByVolume(volumeName string) (response azgo.SnapshotGetIterResponse, err error) {
+	var snapshots []struct {
+		Name       string `json:"name"`
+		CreateTime string `json:"create_time"`
+	}
+	uuid, err := d.uuid("/storage/volumes", "volume", volumeName)
+	if err == nil {
+		err = d.records("/storage/volumes/"+uuid+"/snapshots", restQuery("fields", "name,create_time"), &snapshots)
+	}
+	if err == nil {
+		list := make([]azgo.SnapshotInfoType, 0)
+		for _, snapshot := range snapshots {
+			info := azgo.NewSnapshotInfoType().SetName(snapshot.Name).SetVolume(volumeName)
+			if created, err := time.Parse(time.RFC3339, snapshot.CreateTime); err == nil {
+				info.SetAccessTime(int(created.Unix()))
+			}
+			list = append(list, *info)
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("snapshot-get-iter", err, azgo.EVOLUMEDOESNOTEXIST)
+	return
+}
+
+// SnapshotDelete deletes a snapshot of a volume
+// equivalent to DELETE /api/storage/volumes/{uuid}/snapshots/{uuid}
+func (d RestDriver) SnapshotDelete(name, volumeName string) (response azgo.SnapshotDeleteResponse, err error) {
+	volumeUUID, err := d.uuid("/storage/volumes", "volume", volumeName)
+	if err == nil {
+		var snapshots []restRef
+		err = d.records("/storage/volumes/"+volumeUUID+"/snapshots", restQuery("name", name, "fields", "uuid"), &snapshots)
+		if err == nil && len(snapshots) == 0 {
+			err = restNotFound("snapshot", name)
+		}
+		if err == nil {
+			err = d.call("DELETE", "/storage/volumes/"+volumeUUID+"/snapshots/"+snapshots[0].UUID, nil, nil, nil)
+		}
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("snapshot-delete", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// SNAPSHOT operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// CIFS operations BEGIN
+
+// restCifsShare is a CIFS share as returned by /api/protocols/cifs/shares
+type restCifsShare struct {
+	Name string  `json:"name"`
+	SVM  restRef `json:"svm"`
+}
+
+// cifsShare returns the named CIFS share of the configured SVM
+func (d RestDriver) cifsShare(shareName string) (*restCifsShare, error) {
+	var shares []restCifsShare
+	if err := d.records("/protocols/cifs/shares", d.svmQuery("name", shareName, "fields", "name,svm"), &shares); err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, restNotFound("CIFS share", shareName)
+	}
+	return &shares[0], nil
+}
+
+// CifsShareCreate shares the specified path over CIFS
+// equivalent to POST /api/protocols/cifs/shares
+func (d RestDriver) CifsShareCreate(shareName, path, comment string) (response azgo.CifsShareCreateResponse, err error) {
+	if _, err = d.cifsShare(shareName); err == nil {
+		err = restExists(azgo.EDUPLICATEENTRY, "CIFS share "+shareName+" already exists")
+	} else if re, ok := err.(*restError); ok && re.StatusCode == http.StatusNotFound {
+		share := map[string]interface{}{
+			"svm":  d.svm(),
+			"name": shareName,
+			"path": path,
+		}
+		if comment != "" {
+			share["comment"] = comment
+		}
+		err = d.call("POST", "/protocols/cifs/shares", nil, share, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("cifs-share-create", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// CifsShareDelete deletes the specified CIFS share
+// equivalent to DELETE /api/protocols/cifs/shares/{svm.uuid}/{name}
+func (d RestDriver) CifsShareDelete(shareName string) (response azgo.CifsShareDeleteResponse, err error) {
+	share, err := d.cifsShare(shareName)
+	if err == nil {
+		err = d.call("DELETE", "/protocols/cifs/shares/"+share.SVM.UUID+"/"+url.PathEscape(share.Name), nil, nil, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("cifs-share-delete", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// CIFS operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// QOS operations BEGIN
+
+// restThroughputUnits are the sizes of the units a ZAPI throughput may be expressed in
+var restThroughputUnits = map[string]int64{"B": 1, "KB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30, "TB": 1 << 40}
+
+// restFixedQos converts a ZAPI throughput ceiling such as 1000iops,100MB/s into a REST fixed QoS policy
+func restFixedQos(maxThroughput string) (map[string]interface{}, error) {
+	fixed := map[string]interface{}{}
+	for _, limit := range strings.Split(maxThroughput, ",") {
+		limit = strings.TrimSpace(limit)
+		if limit == "" {
+			continue
+		}
+		if strings.HasSuffix(limit, "iops") {
+			iops, err := strconv.ParseInt(strings.TrimSuffix(limit, "iops"), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid throughput: %v", limit)
+			}
+			fixed["max_throughput_iops"] = iops
+			continue
+		}
+
+		limit = strings.TrimSuffix(limit, "/s")
+		unit := strings.TrimLeft(limit, "0123456789")
+		value, err := strconv.ParseInt(strings.TrimSuffix(limit, unit), 10, 64)
+		factor, ok := restThroughputUnits[unit]
+		if err != nil || !ok {
+			return nil, fmt.Errorf("Invalid throughput: %v", limit)
+		}
+		// REST limits throughput in whole megabytes per second, so round smaller units up
+		fixed["max_throughput_mbps"] = (value*factor + (1 << 20) - 1) >> 20
+	}
+	return fixed, nil
+}
+
+// restPolicy is a QoS policy as returned by /api/storage/qos/policies
+type restPolicy struct {
+	Name  string  `json:"name"`
+	UUID  string  `json:"uuid"`
+	Svm   restRef `json:"svm"`
+	Fixed struct {
+		MaxThroughputIops int `json:"max_throughput_iops"`
+		MaxThroughputMbps int `json:"max_throughput_mbps"`
+	} `json:"fixed"`
+}
+
+// QosPolicyGroupCreate creates a QoS policy group in the SVM with the specified throughput ceiling
+// equivalent to POST /api/storage/qos/policies
+func (d RestDriver) QosPolicyGroupCreate(name, maxThroughput string) (response azgo.QosPolicyGroupCreateResponse, err error) {
+	fixed, err := restFixedQos(maxThroughput)
+	if err != nil {
+		err = &restError{StatusCode: http.StatusBadRequest, Code: azgo.EINVALIDINPUTERROR, Message: err.Error()}
+	} else {
+		err = d.call("POST", "/storage/qos/policies", nil, map[string]interface{}{
+			"svm":   d.svm(),
+			"name":  name,
+			"fixed": fixed,
+		}, nil)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("qos-policy-group-create", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// QosPolicyGroupGet returns the specified QoS policy group
+// equivalent to GET /api/storage/qos/policies?name={name}
+func (d RestDriver) QosPolicyGroupGet(name string) (response azgo.QosPolicyGroupGetIterResponse, err error) {
+	var policies []restPolicy
+	err = d.records("/storage/qos/policies", restQuery("name", name, "fields", "name,uuid,svm.name,fixed"), &policies)
+	if err == nil {
+		list := make([]azgo.QosPolicyGroupInfoType, 0)
+		for _, policy := range policies {
+			limits := make([]string, 0)
+			if policy.Fixed.MaxThroughputIops > 0 {
+				limits = append(limits, strconv.Itoa(policy.Fixed.MaxThroughputIops)+"iops")
+			}
+			if policy.Fixed.MaxThroughputMbps > 0 {
+				limits = append(limits, strconv.Itoa(policy.Fixed.MaxThroughputMbps)+"MB/s")
+			}
+			info := azgo.NewQosPolicyGroupInfoType().
+				SetPolicyGroup(policy.Name).
+				SetUuid(policy.UUID).
+				SetVserver(policy.Svm.Name).
+				SetMaxThroughput(strings.Join(limits, ","))
+			list = append(list, *info)
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("qos-policy-group-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// QosPolicyGroupDelete deletes the specified QoS policy group; REST only deletes policies no longer in use,
+// so force is ignored
+// equivalent to DELETE /api/storage/qos/policies/{uuid}
+func (d RestDriver) QosPolicyGroupDelete(name string, force bool) (response azgo.QosPolicyGroupDeleteResponse, err error) {
+	var policies []restRef
+	err = d.records("/storage/qos/policies", restQuery("name", name, "fields", "uuid"), &policies)
+	if err == nil {
+		if len(policies) == 0 {
+			err = restNotFound("QoS policy", name)
+		} else {
+			err = d.call("DELETE", "/storage/qos/policies/"+policies[0].UUID, nil, nil, nil)
+		}
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("qos-policy-group-delete", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// QOS operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// JOB operations BEGIN
+
+// restJob is a job as reported by /api/cluster/jobs
+type restJob struct {
+	UUID        string `json:"uuid"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+	Message     string `json:"message"`
+	Code        int    `json:"code"`
+}
+
+// restJobInfo converts REST jobs into ZAPI job-info; REST jobs have no numeric id
+func restJobInfo(jobs []restJob) []azgo.JobInfoType {
+	list := make([]azgo.JobInfoType, 0)
+	for _, job := range jobs {
+		info := azgo.NewJobInfoType().
+			SetJobUuid(azgo.UUIDType(job.UUID)).
+			SetJobDescription(job.Description).
+			SetJobState(job.State).
+			SetJobCompletion(job.Message).
+			SetJobStatusCode(job.Code)
+		list = append(list, *info)
+	}
+	return list
+}
+
+// JobGet returns no jobs: REST operations wait for their own jobs, and REST jobs are identified by uuid
+func (d RestDriver) JobGet(jobID int) (response azgo.JobGetIterResponse, err error) {
+	response.Result.SetAttributesList([]azgo.JobInfoType{}).SetNumRecords(0)
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("job-get-iter", nil, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// JobGetByDescription returns the jobs whose description matches, which may contain wildcards
+// equivalent to GET /api/cluster/jobs?description=
+func (d RestDriver) JobGetByDescription(description string) (response azgo.JobGetIterResponse, err error) {
+	var jobs []restJob
+	err = d.records("/cluster/jobs", d.svmQuery("description", description, "fields", "uuid,description,state,message,code"), &jobs)
+	if err == nil {
+		list := restJobInfo(jobs)
+		response.Result.SetAttributesList(list).SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("job-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// JOB operations END
+/////////////////////////////////////////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////
+// MISC operations BEGIN
+
+// NetInterfaceGet returns the list of network interfaces with associated metadata; the data protocols of
+// each interface are derived from its data_* services
+// equivalent to GET /api/network/ip/interfaces
+func (d RestDriver) NetInterfaceGet() (response azgo.NetInterfaceGetIterResponse, err error) {
+	var interfaces []struct {
+		Name string  `json:"name"`
+		Svm  restRef `json:"svm"`
+		IP   struct {
+			Address string `json:"address"`
+		} `json:"ip"`
+		Services []string `json:"services"`
+	}
+	err = d.records("/network/ip/interfaces", d.svmQuery("fields", "name,svm.name,ip.address,services"), &interfaces)
+	if err == nil {
+		list := make([]azgo.NetInterfaceInfoType, 0)
+		for _, lif := range interfaces {
+			protocols := make([]azgo.DataProtocolType, 0)
+			for _, service := range lif.Services {
+				if strings.HasPrefix(service, "data_") && service != "data_core" {
+					protocols = append(protocols, azgo.DataProtocolType(strings.TrimPrefix(service, "data_")))
+				}
+			}
+			info := azgo.NewNetInterfaceInfoType().
+				SetInterfaceName(lif.Name).
+				SetVserver(lif.Svm.Name).
+				SetAddress(azgo.IpAddressType(lif.IP.Address)).
+				SetDataProtocols(protocols)
+			list = append(list, *info)
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("net-interface-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// SystemGetVersion returns the system version
+// equivalent to GET /api/cluster?fields=version
+func (d RestDriver) SystemGetVersion() (response azgo.SystemGetVersionResponse, err error) {
+	var cluster struct {
+		Version struct {
+			Full       string `json:"full"`
+			Generation int    `json:"generation"`
+			Major      int    `json:"major"`
+			Minor      int    `json:"minor"`
+		} `json:"version"`
+	}
+	err = d.call("GET", "/cluster", restQuery("fields", "version"), nil, &cluster)
+	if err == nil {
+		tuple := azgo.NewSystemVersionTupleType().
+			SetGeneration(cluster.Version.Generation).
+			SetMajor(cluster.Version.Major).
+			SetMinor(cluster.Version.Minor)
+		response.Result.SetVersion(cluster.Version.Full).
+			SetIsClustered(true).
+			SetVersionTuple(*tuple)
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("system-get-version", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// SystemGetOntapiVersion returns the ONTAPI version matching the ONTAP release, 1.160 for ONTAP 9.6
+// equivalent to GET /api/cluster?fields=version
+func (d RestDriver) SystemGetOntapiVersion() (response azgo.SystemGetOntapiVersionResponse, err error) {
+	versionResponse, err := d.SystemGetVersion()
+	if err == nil && versionResponse.Result.VersionTuplePtr != nil {
+		tuple := versionResponse.Result.VersionTuple()
+		response.Result.SetMajorVersion(1).SetMinorVersion(100 + 10*tuple.Major())
+	}
+	response.Result.ResultStatusAttr = versionResponse.Result.ResultStatusAttr
+	response.Result.ResultReasonAttr = versionResponse.Result.ResultReasonAttr
+	response.Result.ResultErrnoAttr = versionResponse.Result.ResultErrnoAttr
+	return
+}
+
+// NegotiateOntapiVersion returns the features of the ONTAP release; the REST API has no envelope version to set
+func (d RestDriver) NegotiateOntapiVersion() (Capabilities, error) {
+	response, err := d.SystemGetOntapiVersion()
+	if err != nil {
+		return Capabilities{}, err
+	}
+	version, err := negotiateOntapiVersion(response)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	return Capabilities{Version: version}, nil
+}
+
+// VserverGetIterRequest returns the vservers on the system
+// equivalent to GET /api/svm/svms
+func (d RestDriver) VserverGetIterRequest() (response azgo.VserverGetIterResponse, err error) {
+	var svms []restRef
+	err = d.records("/svm/svms", restQuery("fields", "name,uuid"), &svms)
+	if err == nil {
+		list := make([]azgo.VserverInfoType, 0)
+		for _, svm := range svms {
+			list = append(list, *azgo.NewVserverInfoType().SetVserverName(svm.Name).SetUuid(azgo.UuidType(svm.UUID)))
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("vserver-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// VserverShowAggrGetIter returns the aggregates assigned to the SVM, along with their available space
+// equivalent to GET /api/svm/svms?name={svm}&fields=aggregates
+func (d RestDriver) VserverShowAggrGetIter() (response azgo.VserverShowAggrGetIterResponse, err error) {
+	var svms []struct {
+		Name       string `json:"name"`
+		Aggregates []struct {
+			Name          string `json:"name"`
+			AvailableSize int    `json:"available_size"`
+		} `json:"aggregates"`
+	}
+	query := restQuery("fields", "name,aggregates.name,aggregates.available_size")
+	if d.config.SVM != "" {
+		query.Set("name", d.config.SVM)
+	}
+	err = d.records("/svm/svms", query, &svms)
+	if err == nil {
+		list := make([]azgo.ShowAggregatesType, 0)
+		for _, svm := range svms {
+			for _, aggr := range svm.Aggregates {
+				info := azgo.NewShowAggregatesType().
+					SetVserverName(svm.Name).
+					SetAggregateName(azgo.AggrNameType(aggr.Name)).
+					SetAvailableSize(azgo.SizeType(aggr.AvailableSize))
+				list = append(list, *info)
+			}
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("vserver-show-aggr-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// AggrGetIter returns the aggregates on the cluster; this requires cluster scoped credentials
+// equivalent to GET /api/storage/aggregates
+func (d RestDriver) AggrGetIter() (response azgo.AggrGetIterResponse, err error) {
+	var aggregates []restRef
+	err = d.records("/storage/aggregates", restQuery("fields", "name,uuid"), &aggregates)
+	if err == nil {
+		list := make([]azgo.AggrAttributesType, 0)
+		for _, aggr := range aggregates {
+			list = append(list, *azgo.NewAggrAttributesType().SetAggregateName(aggr.Name).SetAggregateUuid(aggr.UUID))
+		}
+		response.Result.SetAttributesList(list)
+		response.Result.SetNumRecords(len(list))
+	}
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("aggr-get-iter", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// restSeverities maps the syslog levels used by ems-autosupport-log to REST application log severities
+var restSeverities = []string{"emergency", "alert", "error", "error", "notice", "notice", "informational", "debug"}
+
+// EmsAutosupportLog generates an auto support message with the supplied parameters
+// equivalent to POST /api/support/ems/application-logs
+func (d RestDriver) EmsAutosupportLog(
+	appVersion string,
+	autoSupport bool,
+	category string,
+	computerName string,
+	eventDescription string,
+	eventID int,
+	eventSource string,
+	logLevel int) (response azgo.EmsAutosupportLogResponse, err error) {
+
+	severity := "informational"
+	if logLevel >= 0 && logLevel < len(restSeverities) {
+		severity = restSeverities[logLevel]
+	}
+	err = d.call("POST", "/support/ems/application-logs", nil, map[string]interface{}{
+		"app_version":          appVersion,
+		"autosupport_required": autoSupport,
+		"category":             category,
+		"computer_name":        computerName,
+		"event_description":    eventDescription,
+		"event_id":             eventID,
+		"event_source":         eventSource,
+		"severity":             severity,
+	}, nil)
+	response.Result.ResultStatusAttr, response.Result.ResultReasonAttr, response.Result.ResultErrnoAttr, err = restStatus("ems-autosupport-log", err, azgo.EOBJECTNOTFOUND)
+	return
+}
+
+// MISC operations END
+/////////////////////////////////////////////////////////////////////////////
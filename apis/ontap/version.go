@@ -0,0 +1,120 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+// OntapiVersion is a version of the ONTAPI interface, such as 1.21.  ONTAP 9.x speaks 1.x0 from 1.100 on,
+// so ONTAP 9.1 speaks 1.110 and ONTAP 9.6 speaks 1.160.
+type OntapiVersion struct {
+	Major int
+	Minor int
+}
+
+// Versions of ONTAPI the bindings are known to work with; requests are sent with MinimumOntapiVersion until the
+// version is negotiated, and with MaximumOntapiVersion to Filers that speak newer versions
+var (
+	MinimumOntapiVersion = OntapiVersion{1, 21}
+	MaximumOntapiVersion = OntapiVersion{1, 170}
+)
+
+// ParseOntapiVersion parses a version such as 1.21
+func ParseOntapiVersion(version string) (OntapiVersion, error) {
+	parts := strings.Split(strings.TrimSpace(version), ".")
+	if len(parts) != 2 {
+		return OntapiVersion{}, fmt.Errorf("Invalid ONTAPI version: %v, expected major.minor", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return OntapiVersion{}, fmt.Errorf("Invalid ONTAPI version: %v, expected major.minor", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return OntapiVersion{}, fmt.Errorf("Invalid ONTAPI version: %v, expected major.minor", version)
+	}
+	return OntapiVersion{major, minor}, nil
+}
+
+func (v OntapiVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// AtLeast reports whether v is the same as or newer than other
+func (v OntapiVersion) AtLeast(other OntapiVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+// ontapRelease names the ONTAP release that introduced an ONTAPI version, for messages
+func (v OntapiVersion) ontapRelease() string {
+	if v.Major == 1 && v.Minor >= 100 {
+		return fmt.Sprintf("ONTAP 9.%d", (v.Minor-100)/10)
+	}
+	return "clustered Data ONTAP 8"
+}
+
+// Feature is an ONTAP feature the storage drivers use that not every supported release has
+type Feature string
+
+// Features gated by the ONTAPI version of the Filer
+const (
+	FeatureVolumeEncryption Feature = "volume encryption"  // volume-create encrypt, NetApp Volume Encryption
+	FeatureFlexGroup        Feature = "FlexGroup volumes"  // volume-create-async with an aggregate list
+	FeatureFlexGroupClone   Feature = "FlexGroup clones"   // volume-clone-create-async
+	FeatureTieringPolicy    Feature = "FabricPool tiering" // volume-create tiering-policy
+	FeatureRestAPI          Feature = "the ONTAP REST API" // apiTransport rest
+)
+
+// featureVersions is the capability table: the oldest ONTAPI version that has each feature
+var featureVersions = map[Feature]OntapiVersion{
+	FeatureVolumeEncryption: {1, 110},
+	FeatureFlexGroup:        {1, 110},
+	FeatureFlexGroupClone:   {1, 170},
+	FeatureTieringPolicy:    {1, 140},
+	FeatureRestAPI:          {1, 160},
+}
+
+// Capabilities are the features available at the ONTAPI version negotiated with a Filer
+type Capabilities struct {
+	Version OntapiVersion
+}
+
+// Supports reports whether the negotiated version has the feature
+func (c Capabilities) Supports(feature Feature) bool {
+	minimum, ok := featureVersions[feature]
+	return ok && c.Version.AtLeast(minimum)
+}
+
+// Require returns an error naming the option and the release it needs if the negotiated version does not have
+// the feature
+func (c Capabilities) Require(feature Feature, option string) error {
+	if c.Supports(feature) {
+		return nil
+	}
+	minimum := featureVersions[feature]
+	return fmt.Errorf("%v needs %v, available from ONTAPI %v (%v); the storage system speaks ONTAPI %v",
+		option, feature, minimum, minimum.ontapRelease(), c.Version)
+}
+
+// negotiateOntapiVersion picks the newest version both the Filer and the bindings speak
+func negotiateOntapiVersion(response azgo.SystemGetOntapiVersionResponse) (OntapiVersion, error) {
+	if response.Result.MajorVersionPtr == nil || response.Result.MinorVersionPtr == nil {
+		return OntapiVersion{}, fmt.Errorf("Could not determine the ONTAPI version of the storage system")
+	}
+	version := OntapiVersion{*response.Result.MajorVersionPtr, *response.Result.MinorVersionPtr}
+	if !version.AtLeast(MinimumOntapiVersion) {
+		return version, fmt.Errorf("ONTAPI %v is not supported, %v or later is needed", version, MinimumOntapiVersion)
+	}
+	if version.AtLeast(MaximumOntapiVersion) {
+		version = MaximumOntapiVersion
+	}
+	return version, nil
+}
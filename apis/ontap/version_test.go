@@ -0,0 +1,70 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package ontap
+
+import (
+	"testing"
+
+	"github.com/netapp/netappdvp/azgo"
+)
+
+func TestParseOntapiVersion(t *testing.T) {
+	version, err := ParseOntapiVersion("1.110")
+	if err != nil || version != (OntapiVersion{1, 110}) {
+		t.Errorf("Expected 1.110, got %v %v", version, err)
+	}
+	if version.String() != "1.110" {
+		t.Errorf("Expected 1.110, got %v", version.String())
+	}
+	for _, invalid := range []string{"", "1", "1.x", "1.2.3"} {
+		if _, err := ParseOntapiVersion(invalid); err == nil {
+			t.Errorf("Expected %v to be an invalid version", invalid)
+		}
+	}
+
+	if !(OntapiVersion{1, 110}).AtLeast(OntapiVersion{1, 21}) || (OntapiVersion{1, 21}).AtLeast(OntapiVersion{1, 30}) {
+		t.Error("Expected versions to compare by minor version")
+	}
+	if !(OntapiVersion{2, 0}).AtLeast(OntapiVersion{1, 170}) {
+		t.Error("Expected versions to compare by major version first")
+	}
+}
+
+func TestNegotiateOntapiVersion(t *testing.T) {
+	response := azgo.SystemGetOntapiVersionResponse{}
+	if _, err := negotiateOntapiVersion(response); err == nil {
+		t.Error("Expected a response without a version to fail")
+	}
+
+	response.Result.SetMajorVersion(1).SetMinorVersion(20)
+	if _, err := negotiateOntapiVersion(response); err == nil {
+		t.Error("Expected ONTAPI 1.20 to be unsupported")
+	}
+
+	response.Result.SetMinorVersion(130)
+	if version, err := negotiateOntapiVersion(response); err != nil || version != (OntapiVersion{1, 130}) {
+		t.Errorf("Expected 1.130, got %v %v", version, err)
+	}
+
+	// newer Filers are spoken to at the newest version the bindings know
+	response.Result.SetMinorVersion(190)
+	if version, err := negotiateOntapiVersion(response); err != nil || version != MaximumOntapiVersion {
+		t.Errorf("Expected %v, got %v %v", MaximumOntapiVersion, version, err)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	capabilities := Capabilities{Version: OntapiVersion{1, 130}}
+	if !capabilities.Supports(FeatureVolumeEncryption) || capabilities.Supports(FeatureTieringPolicy) {
+		t.Error("Expected ONTAPI 1.130 to have encryption but not tiering")
+	}
+	if err := capabilities.Require(FeatureVolumeEncryption, "encryption"); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := capabilities.Require(FeatureTieringPolicy, "tieringPolicy"); err == nil {
+		t.Error("Expected tieringPolicy to need a newer version")
+	}
+	if capabilities.Supports(Feature("unknown")) {
+		t.Error("Expected unknown features to be unsupported")
+	}
+}
@@ -12,8 +12,12 @@ import (
 func (c *Client) AddAccount(req *AddAccountRequest) (accountID int64, err error) {
 	var result AddAccountResult
 	response, err := c.Request("AddAccount", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return 0, err
+	}
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return 0, err
 	}
 	return result.Result.AccountID, nil
@@ -28,7 +32,7 @@ func (c *Client) GetAccountByName(req *GetAccountByNameRequest) (account Account
 
 	var result GetAccountResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return Account{}, err
 	}
 	log.Debugf("Returning account: %v", result.Result.Account)
@@ -39,8 +43,12 @@ func (c *Client) GetAccountByName(req *GetAccountByNameRequest) (account Account
 func (c *Client) GetAccountByID(req *GetAccountByIDRequest) (account Account, err error) {
 	var result GetAccountResult
 	response, err := c.Request("GetAccountByID", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return account, err
+	}
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return account, err
 	}
 	return result.Result.Account, err
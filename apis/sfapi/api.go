@@ -7,10 +7,13 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
-	"math/rand"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/Sirupsen/logrus"
@@ -27,8 +30,19 @@ type Client struct {
 	DefaultTenantName string
 	VolumeTypes       *[]VolType
 	Config            *Config
+	httpClient        *http.Client
+	httpClientOnce    sync.Once // requests may be sent from several goroutines
 }
 
+// DefaultTimeout is how long a request may take when the Config does not set a Timeout
+const DefaultTimeout = 30 * time.Second
+
+// MaxRetries is how many times a request is sent again after a retryable error; the delay between attempts
+// starts at retryInterval and doubles each time
+const MaxRetries = 5
+
+var retryInterval = time.Second
+
 // Config holds the configuration data for the Client to communicate with a SolidFire storage system
 type Config struct {
 	TenantName     string
@@ -38,6 +52,9 @@ type Config struct {
 	SVIP           string
 	InitiatorIFace string //iface to use of iSCSI initiator
 	Types          *[]VolType
+	UseVAG         bool          //attach through a volume access group instead of CHAP
+	VerifyTLS      bool          //verify the cluster's certificate, which is skipped by default
+	Timeout        time.Duration //limit on each request, DefaultTimeout if zero
 }
 
 // VolType holds quality of service configuration data
@@ -57,7 +74,6 @@ var (
 
 // NewFromParameters is a factory method to createsa new sfapi.Client object using the supplied paramters
 func NewFromParameters(pendpoint string, pdefaultSizeGiB int64, psvip string, pcfg Config, pdefaultTenantName string) (c *Client, err error) {
-	rand.Seed(time.Now().UTC().UnixNano())
 	defSize := pdefaultSizeGiB * int64(units.GiB)
 	SFClient := &Client{
 		Endpoint:          pendpoint,
@@ -71,8 +87,54 @@ func NewFromParameters(pendpoint string, pdefaultSizeGiB int64, psvip string, pc
 	return SFClient, nil
 }
 
-// Request performs a json-rpc POST to the configured endpoint
+// RedactEndpoint removes the credentials from an endpoint URL so it can be logged
+func RedactEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	return u.String()
+}
+
+// client returns the http.Client shared by all requests, creating it from the Config on first use
+func (c *Client) client() *http.Client {
+	c.httpClientOnce.Do(func() {
+		var cfg Config
+		if c.Config != nil {
+			cfg = *c.Config
+		}
+		timeout := cfg.Timeout
+		if timeout == 0 {
+			timeout = DefaultTimeout
+		}
+		c.httpClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: !cfg.VerifyTLS},
+			},
+			Timeout: timeout,
+		}
+	})
+	return c.httpClient
+}
+
+// Request performs a json-rpc POST to the configured endpoint.  Errors reported by the cluster are returned as an
+// *Error, and those that are retryable are retried with a new request ID after a growing delay.
 func (c *Client) Request(method string, params interface{}, id int) (response []byte, err error) {
+	for attempt := 0; ; attempt++ {
+		response, err = c.request(method, params, id)
+		if !IsRetryable(err) || attempt >= MaxRetries {
+			return response, err
+		}
+		delay := retryInterval << uint(attempt)
+		log.Warnf("Retrying %v in %v after error: %v", method, delay, err)
+		time.Sleep(delay)
+		id = NewReqID()
+	}
+}
+
+// request performs a single json-rpc POST and checks the response belongs to it
+func (c *Client) request(method string, params interface{}, id int) (response []byte, err error) {
 	log.Debug("Issueing request to SolidFire Endpoint...")
 	if c.Endpoint == "" {
 		log.Error("Endpoint is not set, unable to issue requests")
@@ -84,14 +146,12 @@ func (c *Client) Request(method string, params interface{}, id int) (response []
 		"id":     id,
 		"params": params,
 	})
-
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	if err != nil {
+		return nil, fmt.Errorf("Problem encoding %v request: %v", method, err)
 	}
 
-	log.Debugf("POST request to: %+v", c.Endpoint)
-	http := &http.Client{Transport: tr}
-	resp, err := http.Post(c.Endpoint,
+	log.Debugf("POST request to: %v", RedactEndpoint(c.Endpoint))
+	resp, err := c.client().Post(c.Endpoint,
 		"json-rpc",
 		strings.NewReader(string(data)))
 	if err != nil {
@@ -110,15 +170,60 @@ func (c *Client) Request(method string, params interface{}, id int) (response []
 	log.WithField("", prettyJSON.String()).Debug("request:", id, " method:", method, " params:", params)
 
 	errresp := APIError{}
-	json.Unmarshal([]byte(body), &errresp)
-	if errresp.Error.Code != 0 {
-		err = errors.New("Received error response from API request")
-		return body, err
+	if err := json.Unmarshal([]byte(body), &errresp); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return body, fmt.Errorf("%v failed: HTTP status: %v", method, resp.Status)
+		}
+		return body, fmt.Errorf("Problem decoding %v response: %v", method, err)
+	}
+	if errresp.Error.Code != 0 || errresp.Error.Name != "" {
+		return body, &Error{
+			Method:  method,
+			Code:    errresp.Error.Code,
+			Name:    errresp.Error.Name,
+			Message: errresp.Error.Message,
+		}
+	}
+	if errresp.ID != id {
+		return body, fmt.Errorf("%v response has ID %v, expected %v", method, errresp.ID, id)
 	}
 	return body, nil
 }
 
-// NewReqID generates a random id for a request
+// lastReqID is the ID of the most recent request; IDs only increase so every response can be matched to its request
+var lastReqID int64
+
+// NewReqID returns the ID for the next request
 func NewReqID() int {
-	return rand.Intn(1000-1) + 1
+	return int(atomic.AddInt64(&lastReqID, 1))
+}
+
+// GetAPI returns the API versions the cluster supports
+func (c *Client) GetAPI() (api APIInfo, err error) {
+	response, err := c.Request("GetAPI", struct{}{}, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return api, err
+	}
+	var result GetAPIResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return api, err
+	}
+	return result.Result, nil
+}
+
+// GetClusterInfo returns the name and addresses of the cluster
+func (c *Client) GetClusterInfo() (info ClusterInfo, err error) {
+	response, err := c.Request("GetClusterInfo", struct{}{}, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return info, err
+	}
+	var result GetClusterInfoResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return info, err
+	}
+	return result.Result.ClusterInfo, nil
 }
@@ -0,0 +1,178 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package sfapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// newTestClient returns a client for a server that answers each request with the result of handler
+func newTestClient(handler func(method string, id int) map[string]interface{}) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			ID     int    `json:"id"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(handler(req.Method, req.ID))
+	}))
+	client, _ := NewFromParameters(server.URL, 1, "", Config{Timeout: time.Second}, "docker")
+	return client, server
+}
+
+func TestRequest(t *testing.T) {
+	var ids []int
+	client, server := newTestClient(func(method string, id int) map[string]interface{} {
+		ids = append(ids, id)
+		switch method {
+		case "GetAccountByName":
+			return map[string]interface{}{"id": id, "error": map[string]interface{}{
+				"code": 500, "name": "xUnknownAccount", "message": "Account not found"}}
+		case "ListVolumesForAccount":
+			return map[string]interface{}{"id": id + 1, "result": map[string]interface{}{}}
+		}
+		return map[string]interface{}{"id": id, "result": map[string]interface{}{}}
+	})
+	defer server.Close()
+
+	if _, err := client.Request("ListActiveVolumes", nil, NewReqID()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	_, err := client.Request("GetAccountByName", nil, NewReqID())
+	apiErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected an Error, got %v", err)
+	}
+	if apiErr.Method != "GetAccountByName" || apiErr.Code != 500 || apiErr.Name != "xUnknownAccount" ||
+		apiErr.Message != "Account not found" {
+		t.Errorf("Unexpected Error %+v", apiErr)
+	}
+
+	if _, err := client.Request("ListVolumesForAccount", nil, NewReqID()); err == nil {
+		t.Error("Expected an error for a response with a different ID")
+	}
+
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Errorf("Expected increasing request IDs, got %v", ids)
+		}
+	}
+}
+
+func TestRequestRetry(t *testing.T) {
+	defer func(interval time.Duration) { retryInterval = interval }(retryInterval)
+	retryInterval = time.Millisecond
+
+	attempts := 0
+	client, server := newTestClient(func(method string, id int) map[string]interface{} {
+		attempts++
+		if method == "CloneVolume" && attempts < 3 {
+			return map[string]interface{}{"id": id, "error": map[string]interface{}{
+				"code": 500, "name": "xNotReadyForIO", "message": "Not ready for IO"}}
+		}
+		if method == "CreateSnapshot" {
+			return map[string]interface{}{"id": id, "error": map[string]interface{}{
+				"code": 500, "name": "xMaxSnapshotsPerVolumeExceeded", "message": "Too many snapshots"}}
+		}
+		if method == "DeleteVolume" {
+			return map[string]interface{}{"id": id, "error": map[string]interface{}{
+				"code": 500, "name": "xVolumeIDDoesNotExist", "message": "No such volume"}}
+		}
+		return map[string]interface{}{"id": id, "result": map[string]interface{}{}}
+	})
+	defer server.Close()
+
+	if _, err := client.Request("CloneVolume", nil, NewReqID()); err != nil || attempts != 3 {
+		t.Errorf("Expected the request to pass on the third attempt, got %v after %v", err, attempts)
+	}
+
+	attempts = 0
+	if _, err := client.Request("DeleteVolume", nil, NewReqID()); !IsNotFound(err) || attempts != 1 {
+		t.Errorf("Expected a single attempt failing with not found, got %v after %v", err, attempts)
+	}
+
+	// a limit is returned on the first try rather than after waiting out the retries
+	attempts = 0
+	if _, err := client.Request("CreateSnapshot", nil, NewReqID()); !IsExceededLimit(err) || attempts != 1 {
+		t.Errorf("Expected a single attempt failing with a limit, got %v after %v", err, attempts)
+	}
+}
+
+func TestRequestConcurrent(t *testing.T) {
+	client, server := newTestClient(func(method string, id int) map[string]interface{} {
+		return map[string]interface{}{"id": id, "result": map[string]interface{}{}}
+	})
+	defer server.Close()
+
+	// the first requests share the http.Client they create
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Request("ListActiveVolumes", nil, NewReqID()); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRequestLogsNoCredentials(t *testing.T) {
+	client, server := newTestClient(func(method string, id int) map[string]interface{} {
+		return map[string]interface{}{"id": id, "result": map[string]interface{}{}}
+	})
+	defer server.Close()
+	client.Endpoint = strings.Replace(server.URL, "http://", "http://admin:secret@", 1)
+
+	var output bytes.Buffer
+	defer func(level log.Level) {
+		log.SetLevel(level)
+		log.SetOutput(os.Stderr)
+	}(log.GetLevel())
+	log.SetLevel(log.DebugLevel)
+	log.SetOutput(&output)
+
+	if _, err := client.Request("ListActiveVolumes", nil, NewReqID()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if strings.Contains(output.String(), "secret") {
+		t.Errorf("Expected the password not to be logged, got %v", output.String())
+	}
+	if redacted := RedactEndpoint(client.Endpoint); redacted != server.URL {
+		t.Errorf("Expected %v, got %v", server.URL, redacted)
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	notFound := &Error{Method: "DeleteVolume", Name: "xVolumeIDDoesNotExist"}
+	unknownAccount := &Error{Method: "GetAccountByName", Name: "xUnknownAccount"}
+	limit := &Error{Method: "CreateVolume", Name: "xExceededLimit"}
+	snapshotLimit := &Error{Method: "CreateSnapshot", Name: "xMaxSnapshotsPerVolumeExceeded"}
+	notReady := &Error{Method: "CloneVolume", Name: "xNotReadyForIO"}
+	other := fmt.Errorf("connection refused")
+
+	if !IsNotFound(notFound) || !IsNotFound(unknownAccount) || IsNotFound(limit) || IsNotFound(other) ||
+		IsNotFound(nil) {
+		t.Error("IsNotFound did not match only the not found errors")
+	}
+	if !IsExceededLimit(limit) || !IsExceededLimit(snapshotLimit) || IsExceededLimit(notFound) ||
+		IsExceededLimit(other) {
+		t.Error("IsExceededLimit did not match only the limit errors")
+	}
+	if !IsRetryable(notReady) || IsRetryable(snapshotLimit) || IsRetryable(limit) || IsRetryable(other) {
+		t.Error("IsRetryable did not match only the retryable errors")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package sfapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error is returned when the cluster answers a request with an error
+type Error struct {
+	Method  string // the API method, such as CreateVolume
+	Code    int
+	Name    string // such as xVolumeIDDoesNotExist
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v failed: code: %v name: %v message: %v", e.Method, e.Code, e.Name, e.Message)
+}
+
+// retryableErrors are the error names SolidFire reports for transient conditions that clear up on their own, such
+// as a volume that is not yet ready for IO.  Limits such as xMaxClonesPerVolumeExceeded are not among them, as
+// they fail the same way however often the request is sent.
+var retryableErrors = []string{
+	"xDBVersionMismatch",
+	"xSliceNotRegistered",
+	"xNotReadyForIO",
+}
+
+// IsErrorName reports whether err is an *Error with one of the specified names
+func IsErrorName(err error, names ...string) bool {
+	apiErr, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	for _, name := range names {
+		if apiErr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err says that the volume, account, snapshot or other object does not exist
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	return strings.HasSuffix(apiErr.Name, "DoesNotExist") || IsErrorName(err, "xNotFound", "xUnknownAccount")
+}
+
+// IsExceededLimit reports whether err says that a cluster limit, such as the number of volumes or snapshots,
+// has been reached
+func IsExceededLimit(err error) bool {
+	apiErr, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	return apiErr.Name == "xExceededLimit" || strings.HasSuffix(apiErr.Name, "Exceeded")
+}
+
+// IsRetryable reports whether err is one that may pass if the request is sent again
+func IsRetryable(err error) bool {
+	return IsErrorName(err, retryableErrors...)
+}
@@ -0,0 +1,62 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package sfapi
+
+import (
+	"encoding/json"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// StartVolumePairing returns the key to pass to CompleteVolumePairing on the remote cluster
+func (c *Client) StartVolumePairing(req *StartVolumePairingRequest) (key string, err error) {
+	response, err := c.Request("StartVolumePairing", req, NewReqID())
+	if err != nil {
+		log.Errorf("Failed to start pairing volume ID %d: %v", req.VolumeID, err)
+		return "", err
+	}
+	var result StartVolumePairingResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return "", err
+	}
+	return result.Result.VolumePairingKey, nil
+}
+
+// CompleteVolumePairing tbd
+func (c *Client) CompleteVolumePairing(req *CompleteVolumePairingRequest) (err error) {
+	_, err = c.Request("CompleteVolumePairing", req, NewReqID())
+	if err != nil {
+		log.Errorf("Failed to complete pairing volume ID %d: %v", req.VolumeID, err)
+		return err
+	}
+	return
+}
+
+// ListActivePairedVolumes tbd
+func (c *Client) ListActivePairedVolumes(req *ListActivePairedVolumesRequest) (volumes []Volume, err error) {
+	response, err := c.Request("ListActivePairedVolumes", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	var result ListVolumesResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return result.Result.Volumes, nil
+}
+
+// RemoveVolumePair tbd
+func (c *Client) RemoveVolumePair(volumeID int64) (err error) {
+	req := &RemoveVolumePairRequest{
+		VolumeID: volumeID,
+	}
+	_, err = c.Request("RemoveVolumePair", req, NewReqID())
+	if err != nil {
+		log.Errorf("Failed to remove pair of volume ID %d: %v", volumeID, err)
+		return err
+	}
+	return
+}
@@ -10,12 +10,16 @@ import (
 
 func (c *Client) CreateSnapshot(req *CreateSnapshotRequest) (snapshot Snapshot, err error) {
 	response, err := c.Request("CreateSnapshot", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return Snapshot{}, err
+	}
 	var result CreateSnapshotResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
 		log.Error(err)
 		return Snapshot{}, err
 	}
-	return (c.GetSnapshot(req.VolumeID, result.Result.SnapshotID, ""))
+	return (c.GetSnapshot(result.Result.SnapshotID, req.VolumeID, ""))
 }
 
 func (c *Client) GetSnapshot(snapID, volID int64, sfName string) (s Snapshot, err error) {
@@ -81,3 +85,63 @@ func (c *Client) DeleteSnapshot(snapshotID int64) (err error) {
 	}
 	return
 }
+
+// CreateGroupSnapshot takes a crash-consistent snapshot of all the listed volumes at once
+func (c *Client) CreateGroupSnapshot(req *CreateGroupSnapshotRequest) (groupSnapshot GroupSnapshot, err error) {
+	response, err := c.Request("CreateGroupSnapshot", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return GroupSnapshot{}, err
+	}
+	var result CreateGroupSnapshotResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return GroupSnapshot{}, err
+	}
+	groupSnapshot = GroupSnapshot{
+		GroupSnapshotID: result.Result.GroupSnapshotID,
+		Name:            req.Name,
+		Members:         result.Result.Members,
+	}
+	return
+}
+
+// ListGroupSnapshots returns the group snapshots, limited to those including the requested volumes if any
+func (c *Client) ListGroupSnapshots(req *ListGroupSnapshotsRequest) (groupSnapshots []GroupSnapshot, err error) {
+	response, err := c.Request("ListGroupSnapshots", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	var result ListGroupSnapshotsResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	groupSnapshots = result.Result.GroupSnapshots
+	return
+}
+
+// DeleteGroupSnapshot tbd
+func (c *Client) DeleteGroupSnapshot(groupSnapshotID int64, saveMembers bool) (err error) {
+	req := &DeleteGroupSnapshotRequest{
+		GroupSnapshotID: groupSnapshotID,
+		SaveMembers:     saveMembers,
+	}
+	_, err = c.Request("DeleteGroupSnapshot", req, NewReqID())
+	if err != nil {
+		log.Error("Failed to delete group snapshot ID: ", groupSnapshotID)
+		return err
+	}
+	return
+}
+
+// RollbackToGroupSnapshot rolls every member volume back to its snapshot in the group
+func (c *Client) RollbackToGroupSnapshot(req *RollbackToGroupSnapshotRequest) (err error) {
+	_, err = c.Request("RollbackToGroupSnapshot", req, NewReqID())
+	if err != nil {
+		log.Error("Failed to roll back to group snapshot ID: ", req.GroupSnapshotID)
+		return err
+	}
+	return
+}
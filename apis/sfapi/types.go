@@ -22,11 +22,50 @@ type QoS struct {
 
 // VolumePair settings
 type VolumePair struct {
-	ClusterPairID    int64  `json:"clusterPairID"`
-	RemoteVolumeID   int64  `json:"remoteVolumeID"`
-	RemoteSliceID    int64  `json:"remoteSliceID"`
-	RemoteVolumeName string `json:"remoteVolumeName"`
-	VolumePairUUID   string `json:"volumePairUUID"`
+	ClusterPairID     int64             `json:"clusterPairID"`
+	RemoteVolumeID    int64             `json:"remoteVolumeID"`
+	RemoteSliceID     int64             `json:"remoteSliceID"`
+	RemoteVolumeName  string            `json:"remoteVolumeName"`
+	VolumePairUUID    string            `json:"volumePairUUID"`
+	RemoteReplication RemoteReplication `json:"remoteReplication"`
+}
+
+// RemoteReplication reports the replication state of a VolumePair
+type RemoteReplication struct {
+	Mode         string `json:"mode"`
+	State        string `json:"state"`
+	StateDetails string `json:"stateDetails"`
+}
+
+// StartVolumePairingRequest tbd
+type StartVolumePairingRequest struct {
+	VolumeID int64  `json:"volumeID"`
+	Mode     string `json:"mode,omitempty"`
+}
+
+// StartVolumePairingResult tbd
+type StartVolumePairingResult struct {
+	ID     int `json:"id"`
+	Result struct {
+		VolumePairingKey string `json:"volumePairingKey"`
+	} `json:"result"`
+}
+
+// CompleteVolumePairingRequest tbd
+type CompleteVolumePairingRequest struct {
+	VolumeID         int64  `json:"volumeID"`
+	VolumePairingKey string `json:"volumePairingKey"`
+}
+
+// ListActivePairedVolumesRequest tbd
+type ListActivePairedVolumesRequest struct {
+	StartVolumeID int64 `json:"startVolumeID,omitempty"`
+	Limit         int64 `json:"limit,omitempty"`
+}
+
+// RemoveVolumePairRequest tbd
+type RemoveVolumePairRequest struct {
+	VolumeID int64 `json:"volumeID"`
 }
 
 // Volume settings
@@ -94,6 +133,16 @@ type CreateVolumeRequest struct {
 	Attributes interface{} `json:"attributes"`
 }
 
+// ModifyVolumeRequest tbd
+type ModifyVolumeRequest struct {
+	VolumeID   int64       `json:"volumeID"`
+	AccountID  int64       `json:"accountID,omitempty"`
+	Access     string      `json:"access,omitempty"`
+	Attributes interface{} `json:"attributes,omitempty"`
+	Qos        *QoS        `json:"qos,omitempty"`
+	TotalSize  int64       `json:"totalSize,omitempty"`
+}
+
 // CreateVolumeResult tbd
 type CreateVolumeResult struct {
 	ID     int `json:"id"`
@@ -174,12 +223,72 @@ type DeleteSnapshotRequest struct {
 	SnapshotID int64 `json:"snapshotID"`
 }
 
+// GroupSnapshot tbd
+type GroupSnapshot struct {
+	GroupSnapshotID   int64       `json:"groupSnapshotID"`
+	GroupSnapshotUUID string      `json:"groupSnapshotUUID"`
+	Name              string      `json:"name"`
+	Status            string      `json:"status"`
+	CreateTime        string      `json:"createTime"`
+	Members           []Snapshot  `json:"members"`
+	Attributes        interface{} `json:"attributes"`
+}
+
+// CreateGroupSnapshotRequest tbd
+type CreateGroupSnapshotRequest struct {
+	Volumes    []int64     `json:"volumes"`
+	Name       string      `json:"name,omitempty"`
+	Attributes interface{} `json:"attributes,omitempty"`
+}
+
+// CreateGroupSnapshotResult tbd
+type CreateGroupSnapshotResult struct {
+	ID     int `json:"id"`
+	Result struct {
+		GroupSnapshotID int64      `json:"groupSnapshotID"`
+		Members         []Snapshot `json:"members"`
+	} `json:"result"`
+}
+
+// ListGroupSnapshotsRequest tbd
+type ListGroupSnapshotsRequest struct {
+	Volumes []int64 `json:"volumes,omitempty"`
+}
+
+// ListGroupSnapshotsResult tbd
+type ListGroupSnapshotsResult struct {
+	ID     int `json:"id"`
+	Result struct {
+		GroupSnapshots []GroupSnapshot `json:"groupSnapshots"`
+	} `json:"result"`
+}
+
+// DeleteGroupSnapshotRequest tbd
+type DeleteGroupSnapshotRequest struct {
+	GroupSnapshotID int64 `json:"groupSnapshotID"`
+	SaveMembers     bool  `json:"saveMembers"`
+}
+
+// RollbackToGroupSnapshotRequest tbd
+type RollbackToGroupSnapshotRequest struct {
+	GroupSnapshotID  int64       `json:"groupSnapshotID"`
+	SaveCurrentState bool        `json:"saveCurrentState"`
+	Name             string      `json:"name,omitempty"`
+	Attributes       interface{} `json:"attributes,omitempty"`
+}
+
 // AddVolumesToVolumeAccessGroupRequest tbd
 type AddVolumesToVolumeAccessGroupRequest struct {
 	VolumeAccessGroupID int64   `json:"volumeAccessGroupID"`
 	Volumes             []int64 `json:"volumes"`
 }
 
+// RemoveVolumesFromVolumeAccessGroupRequest tbd
+type RemoveVolumesFromVolumeAccessGroupRequest struct {
+	VolumeAccessGroupID int64   `json:"volumeAccessGroupID"`
+	Volumes             []int64 `json:"volumes"`
+}
+
 // CreateVolumeAccessGroupRequest tbd
 type CreateVolumeAccessGroupRequest struct {
 	Name       string   `json:"name"`
@@ -215,6 +324,23 @@ type ListVolumesAccessGroupsResult struct {
 	} `json:"result"`
 }
 
+// ISCSISession is an iSCSI session of an initiator with a volume, from ListISCSISessions
+type ISCSISession struct {
+	SessionID     int64  `json:"sessionID"`
+	VolumeID      int64  `json:"volumeID"`
+	AccountID     int64  `json:"accountID"`
+	InitiatorName string `json:"initiatorName"`
+	TargetName    string `json:"targetName"`
+}
+
+// ListISCSISessionsResult tbd
+type ListISCSISessionsResult struct {
+	ID     int `json:"id"`
+	Result struct {
+		Sessions []ISCSISession `json:"sessions"`
+	} `json:"result"`
+}
+
 // EmptyResponse tbd
 type EmptyResponse struct {
 	ID     int `json:"id"`
@@ -276,3 +402,34 @@ type AddAccountResult struct {
 		AccountID int64 `json:"accountID"`
 	} `json:"result"`
 }
+
+// APIInfo tbd
+type APIInfo struct {
+	CurrentVersion    string   `json:"currentVersion"`
+	SupportedVersions []string `json:"supportedVersions"`
+}
+
+// GetAPIResult tbd
+type GetAPIResult struct {
+	ID     int     `json:"id"`
+	Result APIInfo `json:"result"`
+}
+
+// ClusterInfo tbd
+type ClusterInfo struct {
+	Name       string      `json:"name"`
+	MVIP       string      `json:"mvip"`
+	SVIP       string      `json:"svip"`
+	UniqueID   string      `json:"uniqueID"`
+	UUID       string      `json:"uuid"`
+	RepCount   int64       `json:"repCount"`
+	Attributes interface{} `json:"attributes"`
+}
+
+// GetClusterInfoResult tbd
+type GetClusterInfoResult struct {
+	ID     int `json:"id"`
+	Result struct {
+		ClusterInfo ClusterInfo `json:"clusterInfo"`
+	} `json:"result"`
+}
@@ -12,8 +12,12 @@ import (
 func (c *Client) CreateVolumeAccessGroup(r *CreateVolumeAccessGroupRequest) (vagID int64, err error) {
 	var result CreateVolumeAccessGroupResult
 	response, err := c.Request("CreateVolumeAccessGroup", r, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return 0, err
+	}
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return 0, err
 	}
 	vagID = result.Result.VagID
@@ -30,7 +34,7 @@ func (c *Client) ListVolumeAccessGroups(r *ListVolumeAccessGroupsRequest) (vags
 	}
 	var result ListVolumesAccessGroupsResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return nil, err
 	}
 	vags = result.Result.Vags
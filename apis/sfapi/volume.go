@@ -23,7 +23,7 @@ func (c *Client) ListVolumesForAccount(listReq *ListVolumesForAccountRequest) (v
 	}
 	var result ListVolumesResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return nil, err
 	}
 	volumes = result.Result.Volumes
@@ -39,7 +39,8 @@ func (c *Client) GetVolumeByID(volID int64) (v Volume, err error) {
 	if err != nil {
 		return v, err
 	}
-	if len(volumes) < 1 {
+	// the listing starts at volID, so it holds the next active volume if volID is not active
+	if len(volumes) < 1 || volumes[0].VolumeID != volID {
 		return Volume{}, fmt.Errorf("Failed to find volume with ID: %d", volID)
 	}
 	return volumes[0], nil
@@ -93,7 +94,7 @@ func (c *Client) ListActiveVolumes(listVolReq *ListActiveVolumesRequest) (volume
 	}
 	var result ListVolumesResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return nil, err
 	}
 	volumes = result.Result.Volumes
@@ -102,24 +103,24 @@ func (c *Client) ListActiveVolumes(listVolReq *ListActiveVolumesRequest) (volume
 
 func (c *Client) CloneVolume(req *CloneVolumeRequest) (vol Volume, err error) {
 	response, err := c.Request("CloneVolume", req, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return Volume{}, err
+	}
 	var result CloneVolumeResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return Volume{}, err
 	}
 
-	wait := 0
-	multiplier := 1
-	for wait < 10 {
-		wait += wait
+	// the clone is listed once the cluster has started copying, wait up to about a minute for it
+	for delay := time.Second; ; delay *= 2 {
 		vol, err = c.GetVolumeByID(result.Result.VolumeID)
-		if err == nil {
-			break
+		if err == nil || delay > 32*time.Second {
+			return
 		}
-		time.Sleep(time.Second * time.Duration(multiplier))
-		multiplier *= wait
+		time.Sleep(delay)
 	}
-	return
 }
 
 // CreateVolume tbd
@@ -130,7 +131,7 @@ func (c *Client) CreateVolume(createReq *CreateVolumeRequest) (vol Volume, err e
 	}
 	var result CreateVolumeResult
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		log.Fatal(err)
+		log.Error(err)
 		return Volume{}, err
 	}
 
@@ -138,6 +139,16 @@ func (c *Client) CreateVolume(createReq *CreateVolumeRequest) (vol Volume, err e
 	return
 }
 
+// ModifyVolume tbd
+func (c *Client) ModifyVolume(req *ModifyVolumeRequest) (err error) {
+	_, err = c.Request("ModifyVolume", req, NewReqID())
+	if err != nil {
+		log.Errorf("Failed to modify volume ID %d: %v", req.VolumeID, err)
+		return err
+	}
+	return
+}
+
 // AddVolumeToAccessGroup tbd
 func (c *Client) AddVolumeToAccessGroup(groupID int64, volIDs []int64) (err error) {
 	req := &AddVolumesToVolumeAccessGroupRequest{
@@ -152,6 +163,35 @@ func (c *Client) AddVolumeToAccessGroup(groupID int64, volIDs []int64) (err erro
 	return err
 }
 
+// RemoveVolumesFromAccessGroup tbd
+func (c *Client) RemoveVolumesFromAccessGroup(groupID int64, volIDs []int64) (err error) {
+	req := &RemoveVolumesFromVolumeAccessGroupRequest{
+		VolumeAccessGroupID: groupID,
+		Volumes:             volIDs,
+	}
+	_, err = c.Request("RemoveVolumesFromVolumeAccessGroup", req, NewReqID())
+	if err != nil {
+		log.Errorf("Failed to remove volume(s) from VAG %d: ", groupID)
+		return err
+	}
+	return err
+}
+
+// ListISCSISessions returns the iSCSI sessions of the cluster, which hosts log in to volumes with
+func (c *Client) ListISCSISessions() (sessions []ISCSISession, err error) {
+	response, err := c.Request("ListISCSISessions", struct{}{}, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	var result ListISCSISessionsResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return result.Result.Sessions, nil
+}
+
 // DeleteRange tbd
 func (c *Client) DeleteRange(startID, endID int64) {
 	idx := startID
@@ -163,7 +203,7 @@ func (c *Client) DeleteRange(startID, endID int64) {
 
 // DeleteVolume tbd
 func (c *Client) DeleteVolume(volumeID int64) (err error) {
-	// TODO(jdg): Add options like purge=True|False, range, ALL etc
+	// TODO(jdg): Add options like range, ALL etc; deleted volumes are kept until PurgeDeletedVolume
 	var req DeleteVolumeRequest
 	req.VolumeID = volumeID
 	_, err = c.Request("DeleteVolume", req, NewReqID())
@@ -174,6 +214,45 @@ func (c *Client) DeleteVolume(volumeID int64) (err error) {
 	return
 }
 
+// ListDeletedVolumes returns the volumes that have been deleted but not yet purged
+func (c *Client) ListDeletedVolumes() (volumes []Volume, err error) {
+	response, err := c.Request("ListDeletedVolumes", struct{}{}, NewReqID())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	var result ListVolumesResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return result.Result.Volumes, nil
+}
+
+// RestoreDeletedVolume tbd
+func (c *Client) RestoreDeletedVolume(volumeID int64) (err error) {
+	var req DeleteVolumeRequest
+	req.VolumeID = volumeID
+	_, err = c.Request("RestoreDeletedVolume", req, NewReqID())
+	if err != nil {
+		log.Error("Failed to restore volume ID: ", volumeID)
+		return err
+	}
+	return
+}
+
+// PurgeDeletedVolume tbd
+func (c *Client) PurgeDeletedVolume(volumeID int64) (err error) {
+	var req DeleteVolumeRequest
+	req.VolumeID = volumeID
+	_, err = c.Request("PurgeDeletedVolume", req, NewReqID())
+	if err != nil {
+		log.Error("Failed to purge volume ID: ", volumeID)
+		return err
+	}
+	return
+}
+
 // DetachVolume tbd
 func (c *Client) DetachVolume(v Volume) (err error) {
 	if c.SVIP == "" {
@@ -206,13 +285,6 @@ func (c *Client) AttachVolume(v *Volume, iface string) (path, device string, err
 		return path, device, err
 	}
 
-	req.AccountID = v.AccountID
-	a, err := c.GetAccountByID(&req)
-	if err != nil {
-		log.Error("Failed to get account ", v.AccountID, ": ", err)
-		return path, device, err
-	}
-
 	// Make sure it's not already attached
 	if utils.WaitForPathToExist(path, 1) {
 		log.Debug("Get device file from path: ", path)
@@ -220,7 +292,18 @@ func (c *Client) AttachVolume(v *Volume, iface string) (path, device string, err
 		return path, device, nil
 	}
 
-	err = utils.LoginWithChap(v.Iqn, c.SVIP, a.Username, a.InitiatorSecret, iface)
+	if c.Config != nil && c.Config.UseVAG {
+		// access is granted by the volume access group holding this host's IQNs
+		err = utils.LoginWithoutChap(v.Iqn, c.SVIP, iface)
+	} else {
+		req.AccountID = v.AccountID
+		a, acctErr := c.GetAccountByID(&req)
+		if acctErr != nil {
+			log.Error("Failed to get account ", v.AccountID, ": ", acctErr)
+			return path, device, acctErr
+		}
+		err = utils.LoginWithChap(v.Iqn, c.SVIP, a.Username, a.InitiatorSecret, iface)
+	}
 	if err != nil {
 		log.Error(err)
 		return path, device, err
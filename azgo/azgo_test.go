@@ -3,6 +3,7 @@
 package azgo
 
 import (
+	"fmt"
 	"testing"
 
 	log "github.com/Sirupsen/logrus"
@@ -16,3 +17,27 @@ func TestConstants(t *testing.T) {
 		t.Error("Unexpected constant value found for EONTAPI_EEXIST")
 	}
 }
+
+func TestIterateGetIter(t *testing.T) {
+	// pages are requested with the default size and stop when the tag repeats
+	pages := 0
+	err := IterateGetIter(0, func(tag string, maxRecords int) (*string, error) {
+		pages++
+		if maxRecords != DefaultMaxRecords {
+			t.Errorf("Expected %v records per page, got %v", DefaultMaxRecords, maxRecords)
+		}
+		next := "same"
+		return &next, nil
+	})
+	if err != nil || pages != 2 {
+		t.Errorf("Expected 2 pages, got %v %v", pages, err)
+	}
+
+	// errors end the iteration
+	err = IterateGetIter(10, func(tag string, maxRecords int) (*string, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	if err == nil {
+		t.Error("Expected the error of the page")
+	}
+}
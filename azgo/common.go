@@ -15,12 +15,16 @@ type ZAPIRequest interface {
 	ToXML() (string, error)
 }
 
+// DefaultOntapiVersion is the version of the ONTAPI envelope used until a version is negotiated
+const DefaultOntapiVersion = "1.21"
+
 type ZapiRunner struct {
 	ManagementLIF string
 	SVM           string
 	Username      string
 	Password      string
 	Secure        bool
+	OntapiVersion string // version of the ONTAPI envelope, DefaultOntapiVersion if not set
 }
 
 // SendZapi sends the provided ZAPIRequest to the Ontap system
@@ -30,17 +34,22 @@ func (o *ZapiRunner) SendZapi(r ZAPIRequest) (*http.Response, error) {
 		panic(err1)
 	}
 
+	version := o.OntapiVersion
+	if version == "" {
+		version = DefaultOntapiVersion
+	}
+
 	var s = ""
 	if o.SVM == "" {
 		s = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
-        <netapp xmlns="http://www.netapp.com/filer/admin" version="1.21">
+        <netapp xmlns="http://www.netapp.com/filer/admin" version="%s">
             %s
-        </netapp>`, zapiCommand)
+        </netapp>`, version, zapiCommand)
 	} else {
 		s = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
-        <netapp xmlns="http://www.netapp.com/filer/admin" version="1.21" %s>
+        <netapp xmlns="http://www.netapp.com/filer/admin" version="%s" %s>
             %s
-        </netapp>`, "vfiler=\""+o.SVM+"\"", zapiCommand)
+        </netapp>`, version, "vfiler=\""+o.SVM+"\"", zapiCommand)
 	}
 	log.Debugf("sending to '%s' xml: \n%s", o.ManagementLIF, s)
 
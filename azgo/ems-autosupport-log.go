@@ -1,13 +1,16 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
 	"bytes"
 	"encoding/xml"
 	"fmt"
-	log "github.com/Sirupsen/logrus"
 	"io/ioutil"
+
+	log "github.com/Sirupsen/logrus"
 )
 
 // EmsAutosupportLogRequest is a structure to represent a ems-autosupport-log ZAPI request object
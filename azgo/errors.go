@@ -19,5 +19,6 @@ const EVDISK_ERROR_SIZE_TOO_SMALL = "9041"
 const EAPIERROR = "13001"
 const EVOLUMEDOESNOTEXIST = "13040"
 const EINVALIDINPUTERROR = "13115"
+const EDUPLICATEENTRY = "13130"
 const EAGGRDOESNOTEXIST = "14420"
 const EOBJECTNOTFOUND = "15661"
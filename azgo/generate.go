@@ -0,0 +1,8 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+// Package azgo holds the Go bindings for the ZAPI calls used by the ONTAP drivers.  The bindings are generated
+// from the JSON definitions in the definitions directory; to add a ZAPI call, add its definition, along with
+// any new types to definitions/types.json, and run "go generate" in this directory.
+package azgo
+
+//go:generate go run ./generator -definitions definitions -output .
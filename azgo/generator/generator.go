@@ -0,0 +1,408 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Field describes a single element of a ZAPI request, response or type
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	XML  string `json:"xml,omitempty"`
+}
+
+// GoName returns the exported Go identifier derived from the ZAPI element name
+func (f Field) GoName() string {
+	return camelCase(f.Name)
+}
+
+// Tag returns the xml struct tag value for this field
+func (f Field) Tag() string {
+	if f.XML != "" {
+		return f.XML
+	}
+	return f.Name
+}
+
+// IsSlice returns true if this field holds a list of values
+func (f Field) IsSlice() bool {
+	return strings.HasPrefix(f.Type, "[]")
+}
+
+// ElemType returns the element type of a list field
+func (f Field) ElemType() string {
+	return strings.TrimPrefix(f.Type, "[]")
+}
+
+// FieldType returns the Go type used for the struct field
+func (f Field) FieldType() string {
+	if f.IsSlice() {
+		return f.Type
+	}
+	return "*" + f.Type
+}
+
+// API describes a ZAPI call with its request and response elements
+type API struct {
+	Name     string  `json:"name"`
+	Request  []Field `json:"request"`
+	Response []Field `json:"response"`
+}
+
+// GoName returns the Go identifier prefix used for this API's request and response types
+func (a API) GoName() string {
+	return camelCase(a.Name)
+}
+
+// GetIterRecords returns the type of the records of a get-iter call, which pages its attributes-list with tag and
+// next-tag; it is empty for other calls
+func (a API) GetIterRecords() string {
+	fields := make(map[string]Field)
+	for _, f := range append(a.Request, a.Response...) {
+		fields[f.Name] = f
+	}
+	for _, name := range []string{"tag", "max-records", "next-tag", "num-records"} {
+		if _, ok := fields[name]; !ok {
+			return ""
+		}
+	}
+	if records, ok := fields["attributes-list"]; ok && records.IsSlice() {
+		return records.Type
+	}
+	return ""
+}
+
+// Type describes a ZAPI typedef; either a struct with fields or a simple alias of a base type
+type Type struct {
+	Name   string  `json:"name"`
+	Base   string  `json:"base,omitempty"`
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// GoName returns the Go identifier for this type
+func (t Type) GoName() string {
+	if t.Base != "" {
+		return t.Name
+	}
+	return camelCase(t.Name) + "Type"
+}
+
+// camelCase converts a ZAPI element name such as "volume-id-attributes" to "VolumeIdAttributes"
+func camelCase(s string) string {
+	parts := strings.Split(s, "-")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// LoadAPI reads a single ZAPI call definition
+func LoadAPI(path string) (*API, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	api := &API{}
+	if err := json.Unmarshal(b, api); err != nil {
+		return nil, fmt.Errorf("Cannot decode %v: %v", path, err)
+	}
+	if api.Name == "" {
+		return nil, fmt.Errorf("Missing API name in %v", path)
+	}
+	return api, nil
+}
+
+// LoadTypes reads the list of ZAPI type definitions
+func LoadTypes(path string) ([]Type, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var types []Type
+	if err := json.Unmarshal(b, &types); err != nil {
+		return nil, fmt.Errorf("Cannot decode %v: %v", path, err)
+	}
+	return types, nil
+}
+
+// LoadAPIs reads every ZAPI call definition in the supplied directory, sorted by name
+func LoadAPIs(dir string) ([]*API, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var apis []*API
+	for _, path := range paths {
+		if filepath.Base(path) == typesFile {
+			continue
+		}
+		api, err := LoadAPI(path)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, api)
+	}
+	return apis, nil
+}
+
+// GenerateAPI renders the Go source for a ZAPI call
+func GenerateAPI(api *API) ([]byte, error) {
+	return render(apiTemplate, api)
+}
+
+// GenerateTypes renders the Go source for all ZAPI types
+func GenerateTypes(types []Type) ([]byte, error) {
+	return render(typesTemplate, types)
+}
+
+func render(t *template.Template, data interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := t.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+	output, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Cannot format generated source: %v\n%s", err, buffer.String())
+	}
+	return output, nil
+}
+
+const typesFile = "types.json"
+
+const header = `// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+// Code generated by azgo/generator; DO NOT EDIT.
+
+package azgo
+`
+
+const fieldsTemplate = `
+{{define "stringFields"}}{{range .}}	if o.{{.GoName}}Ptr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "{{.Name}}", {{if not .IsSlice}}*{{end}}o.{{.GoName}}Ptr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("{{.Name}}: nil\n"))
+	}
+{{end}}{{end}}
+
+{{define "accessors"}}{{$owner := .Owner}}{{range .Fields}}
+// {{.GoName}} is a fluent style 'getter' method that can be chained
+func (o *{{$owner}}) {{.GoName}}() {{.Type}} {
+	r := {{if not .IsSlice}}*{{end}}o.{{.GoName}}Ptr
+	return r
+}
+
+// Set{{.GoName}} is a fluent style 'setter' method that can be chained
+func (o *{{$owner}}) Set{{.GoName}}(newValue {{.Type}}) *{{$owner}} {
+{{- if .IsSlice}}
+	newSlice := make({{.Type}}, len(newValue))
+	copy(newSlice, newValue)
+	o.{{.GoName}}Ptr = newSlice
+{{- else}}
+	o.{{.GoName}}Ptr = &newValue
+{{- end}}
+	return o
+}
+{{end}}{{end}}
+`
+
+const apiText = header + `
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+
+	log "github.com/Sirupsen/logrus"
+)
+{{$name := .GoName}}
+// {{$name}}Request is a structure to represent a {{.Name}} ZAPI request object
+type {{$name}}Request struct {
+	XMLName xml.Name ` + "`xml:\"{{.Name}}\"`" + `
+{{if .Request}}
+{{range .Request}}	{{.GoName}}Ptr {{.FieldType}} ` + "`xml:\"{{.Tag}}\"`" + `
+{{end}}{{end}}}
+
+// ToXML converts this object into an xml string representation
+func (o *{{$name}}Request) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v\n", err)
+	}
+	return string(output), err
+}
+
+// New{{$name}}Request is a factory method for creating new instances of {{$name}}Request objects
+func New{{$name}}Request() *{{$name}}Request { return &{{$name}}Request{} }
+
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *{{$name}}Request) ExecuteUsing(zr *ZapiRunner) ({{$name}}Response, error) {
+	resp, err := zr.SendZapi(o)
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	log.Debugf("response Body:\n%s", string(body))
+
+	var n {{$name}}Response
+	xml.Unmarshal(body, &n)
+	if err != nil {
+		log.Errorf("err: %v", err.Error())
+	}
+	log.Debugf("{{.Name}} result:\n%s", n.Result)
+
+	return n, err
+}
+{{with .GetIterRecords}}
+// ExecuteAllUsing sends this request page by page using the supplied ZapiRunner, following next-tag, and returns a
+// response holding the records of every page; maxRecords is the page size, or DefaultMaxRecords if not positive
+func (o *{{$name}}Request) ExecuteAllUsing(zr *ZapiRunner, maxRecords int) ({{$name}}Response, error) {
+	var response {{$name}}Response
+	request := *o
+	records := make({{.}}, 0)
+	err := IterateGetIter(maxRecords, func(tag string, maxRecords int) (*string, error) {
+		request.SetMaxRecords(maxRecords)
+		if tag != "" {
+			request.SetTag(tag)
+		}
+
+		var err error
+		response, err = request.ExecuteUsing(zr)
+		if err != nil || response.Result.ResultStatusAttr != "passed" {
+			return nil, err
+		}
+		records = append(records, response.Result.AttributesList()...)
+		return response.Result.NextTagPtr, nil
+	})
+	if err == nil && response.Result.ResultStatusAttr == "passed" {
+		response.Result.SetAttributesList(records).SetNumRecords(len(records))
+		response.Result.NextTagPtr = nil
+	}
+	return response, err
+}
+{{end}}
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o {{$name}}Request) String() string {
+	var buffer bytes.Buffer
+{{template "stringFields" .Request}}	return buffer.String()
+}
+{{template "accessors" (accessors (print $name "Request") .Request)}}
+// {{$name}}Response is a structure to represent a {{.Name}} ZAPI response object
+type {{$name}}Response struct {
+	XMLName xml.Name ` + "`xml:\"netapp\"`" + `
+
+	ResponseVersion string ` + "`xml:\"version,attr\"`" + `
+	ResponseXmlns   string ` + "`xml:\"xmlns,attr\"`" + `
+
+	Result {{$name}}ResponseResult ` + "`xml:\"results\"`" + `
+}
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o {{$name}}Response) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
+	buffer.WriteString(fmt.Sprintf("%s: %s\n", "xmlns", o.ResponseXmlns))
+	buffer.WriteString(fmt.Sprintf("%s: %s\n", "results", o.Result))
+	return buffer.String()
+}
+
+// {{$name}}ResponseResult is a structure to represent a {{.Name}} ZAPI object's result
+type {{$name}}ResponseResult struct {
+	XMLName xml.Name ` + "`xml:\"results\"`" + `
+
+	ResultStatusAttr string ` + "`xml:\"status,attr\"`" + `
+	ResultReasonAttr string ` + "`xml:\"reason,attr\"`" + `
+	ResultErrnoAttr  string ` + "`xml:\"errno,attr\"`" + `
+{{range .Response}}	{{.GoName}}Ptr {{.FieldType}} ` + "`xml:\"{{.Tag}}\"`" + `
+{{end}}}
+
+// ToXML converts this object into an xml string representation
+func (o *{{$name}}Response) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Debugf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// New{{$name}}Response is a factory method for creating new instances of {{$name}}Response objects
+func New{{$name}}Response() *{{$name}}Response { return &{{$name}}Response{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o {{$name}}ResponseResult) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
+	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultReasonAttr", o.ResultReasonAttr))
+	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultErrnoAttr", o.ResultErrnoAttr))
+{{template "stringFields" .Response}}	return buffer.String()
+}
+{{template "accessors" (accessors (print $name "ResponseResult") .Response)}}`
+
+const typesText = header + `
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+)
+{{range .}}{{$name := .GoName}}{{if .Base}}
+// {{$name}} is a ZAPI type represented as a {{.Base}}
+type {{$name}} {{.Base}}
+{{else}}
+// {{$name}} is a structure to represent a {{.Name}} ZAPI object
+type {{$name}} struct {
+	XMLName xml.Name ` + "`xml:\"{{.Name}}\"`" + `
+{{if .Fields}}
+{{range .Fields}}	{{.GoName}}Ptr {{.FieldType}} ` + "`xml:\"{{.Tag}}\"`" + `
+{{end}}{{end}}}
+
+// ToXML converts this object into an xml string representation
+func (o *{{$name}}) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// New{{$name}} is a factory method for creating new instances of {{$name}} objects
+func New{{$name}}() *{{$name}} { return &{{$name}}{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o {{$name}}) String() string {
+	var buffer bytes.Buffer
+{{template "stringFields" .Fields}}	return buffer.String()
+}
+{{template "accessors" (accessors $name .Fields)}}{{end}}{{end}}`
+
+type accessorData struct {
+	Owner  string
+	Fields []Field
+}
+
+var (
+	apiTemplate   = newTemplate("api", apiText)
+	typesTemplate = newTemplate("types", typesText)
+)
+
+func newTemplate(name, text string) *template.Template {
+	funcs := template.FuncMap{
+		"accessors": func(owner string, fields []Field) accessorData {
+			return accessorData{owner, fields}
+		},
+	}
+	t := template.Must(template.New(name).Funcs(funcs).Parse(fieldsTemplate))
+	return template.Must(t.Parse(text))
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestBindingsAreCurrent regenerates every binding and compares it with the checked-in file, so that the
+// definitions and the bindings cannot drift apart
+func TestBindingsAreCurrent(t *testing.T) {
+	apis, err := LoadAPIs(filepath.Join("..", "definitions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apis) == 0 {
+		t.Fatal("No ZAPI definitions found")
+	}
+
+	for _, api := range apis {
+		source, err := GenerateAPI(api)
+		if err != nil {
+			t.Errorf("Cannot generate %v: %v", api.Name, err)
+			continue
+		}
+		compare(t, source, filepath.Join("..", api.Name+".go"))
+	}
+
+	types, err := LoadTypes(filepath.Join("..", "definitions", typesFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := GenerateTypes(types)
+	if err != nil {
+		t.Fatalf("Cannot generate types: %v", err)
+	}
+	compare(t, source, filepath.Join("..", "types.go"))
+}
+
+func compare(t *testing.T, generated []byte, path string) {
+	existing, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Errorf("Cannot read %v: %v", path, err)
+		return
+	}
+	if !bytes.Equal(generated, existing) {
+		t.Errorf("%v is out of date with its definition; run go generate in azgo", path)
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	tests := map[string]string{
+		"volume-id-attributes": "VolumeIdAttributes",
+		"lun-map":              "LunMap",
+		"is-7-mode-snapshot":   "Is7ModeSnapshot",
+	}
+	for name, expected := range tests {
+		if actual := camelCase(name); actual != expected {
+			t.Errorf("camelCase(%v) = %v; expected %v", name, actual, expected)
+		}
+	}
+}
+
+func TestGenerateAPI(t *testing.T) {
+	api := &API{
+		Name:     "lun-unmap",
+		Request:  []Field{{Name: "initiator-group", Type: "string"}, {Name: "path", Type: "string"}},
+		Response: []Field{{Name: "lun-ids", Type: "[]int", XML: "lun-ids>lun-id"}},
+	}
+	source, err := GenerateAPI(api)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// gofmt aligns struct fields, so compare with the whitespace collapsed
+	collapsed := strings.Join(strings.Fields(string(source)), " ")
+	for _, expected := range []string{
+		"type LunUnmapRequest struct",
+		"XMLName xml.Name `xml:\"lun-unmap\"`",
+		"InitiatorGroupPtr *string `xml:\"initiator-group\"`",
+		"func (o *LunUnmapRequest) SetPath(newValue string) *LunUnmapRequest",
+		"func (o *LunUnmapRequest) ExecuteUsing(zr *ZapiRunner) (LunUnmapResponse, error)",
+		"LunIdsPtr []int `xml:\"lun-ids>lun-id\"`",
+		"func (o *LunUnmapResponseResult) LunIds() []int",
+	} {
+		if !strings.Contains(collapsed, expected) {
+			t.Errorf("Generated source is missing %q", expected)
+		}
+	}
+	if strings.Contains(collapsed, "ExecuteAllUsing") {
+		t.Error("Generated source pages a call that is not a get-iter")
+	}
+}
+
+func TestGenerateGetIterAPI(t *testing.T) {
+	api := &API{
+		Name: "igroup-get-iter",
+		Request: []Field{
+			{Name: "max-records", Type: "int"},
+			{Name: "query", Type: "InitiatorGroupInfoType", XML: "query>initiator-group-info"},
+			{Name: "tag", Type: "string"},
+		},
+		Response: []Field{
+			{Name: "attributes-list", Type: "[]InitiatorGroupInfoType", XML: "attributes-list>initiator-group-info"},
+			{Name: "next-tag", Type: "string"},
+			{Name: "num-records", Type: "int"},
+		},
+	}
+	if records := api.GetIterRecords(); records != "[]InitiatorGroupInfoType" {
+		t.Errorf("Expected []InitiatorGroupInfoType records, got %q", records)
+	}
+	source, err := GenerateAPI(api)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collapsed := strings.Join(strings.Fields(string(source)), " ")
+	for _, expected := range []string{
+		"func (o *IgroupGetIterRequest) ExecuteAllUsing(zr *ZapiRunner, maxRecords int) (IgroupGetIterResponse, error)",
+		"records := make([]InitiatorGroupInfoType, 0)",
+		"err := IterateGetIter(maxRecords,",
+	} {
+		if !strings.Contains(collapsed, expected) {
+			t.Errorf("Generated source is missing %q", expected)
+		}
+	}
+}
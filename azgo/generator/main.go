@@ -0,0 +1,48 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+// Command generator produces the azgo ZAPI bindings from the JSON definitions in azgo/definitions.  Each ZAPI
+// call is described by <api-name>.json, listing its request and response elements, and the types they use are
+// described in types.json.  It is normally invoked via "go generate" in the azgo package.
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+)
+
+var (
+	definitions = flag.String("definitions", "definitions", "Directory containing the ZAPI definitions")
+	output      = flag.String("output", ".", "Directory in which to write the generated bindings")
+)
+
+func main() {
+	flag.Parse()
+
+	apis, err := LoadAPIs(*definitions)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, api := range apis {
+		source, err := GenerateAPI(api)
+		if err != nil {
+			log.Fatalf("Cannot generate %v: %v", api.Name, err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*output, api.Name+".go"), source, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	types, err := LoadTypes(filepath.Join(*definitions, typesFile))
+	if err != nil {
+		log.Fatal(err)
+	}
+	source, err := GenerateTypes(types)
+	if err != nil {
+		log.Fatalf("Cannot generate types: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*output, "types.go"), source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
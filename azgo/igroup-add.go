@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// IgroupAddRequest is a structure to represent a igroup-add ZAPI request object
 type IgroupAddRequest struct {
 	XMLName xml.Name `xml:"igroup-add"`
 
@@ -19,6 +22,7 @@ type IgroupAddRequest struct {
 	InitiatorGroupNamePtr *string `xml:"initiator-group-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupAddRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -27,10 +31,12 @@ func (o *IgroupAddRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupAddRequest is a factory method for creating new instances of IgroupAddRequest objects
 func NewIgroupAddRequest() *IgroupAddRequest { return &IgroupAddRequest{} }
 
-func (r *IgroupAddRequest) ExecuteUsing(zr *ZapiRunner) (IgroupAddResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *IgroupAddRequest) ExecuteUsing(zr *ZapiRunner) (IgroupAddResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -45,6 +51,7 @@ func (r *IgroupAddRequest) ExecuteUsing(zr *ZapiRunner) (IgroupAddResponse, erro
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupAddRequest) String() string {
 	var buffer bytes.Buffer
 	if o.ForcePtr != nil {
@@ -65,36 +72,43 @@ func (o IgroupAddRequest) String() string {
 	return buffer.String()
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *IgroupAddRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *IgroupAddRequest) SetForce(newValue bool) *IgroupAddRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// Initiator is a fluent style 'getter' method that can be chained
 func (o *IgroupAddRequest) Initiator() string {
 	r := *o.InitiatorPtr
 	return r
 }
 
+// SetInitiator is a fluent style 'setter' method that can be chained
 func (o *IgroupAddRequest) SetInitiator(newValue string) *IgroupAddRequest {
 	o.InitiatorPtr = &newValue
 	return o
 }
 
+// InitiatorGroupName is a fluent style 'getter' method that can be chained
 func (o *IgroupAddRequest) InitiatorGroupName() string {
 	r := *o.InitiatorGroupNamePtr
 	return r
 }
 
+// SetInitiatorGroupName is a fluent style 'setter' method that can be chained
 func (o *IgroupAddRequest) SetInitiatorGroupName(newValue string) *IgroupAddRequest {
 	o.InitiatorGroupNamePtr = &newValue
 	return o
 }
 
+// IgroupAddResponse is a structure to represent a igroup-add ZAPI response object
 type IgroupAddResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -104,6 +118,7 @@ type IgroupAddResponse struct {
 	Result IgroupAddResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupAddResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -112,6 +127,7 @@ func (o IgroupAddResponse) String() string {
 	return buffer.String()
 }
 
+// IgroupAddResponseResult is a structure to represent a igroup-add ZAPI object's result
 type IgroupAddResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -120,6 +136,7 @@ type IgroupAddResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupAddResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -128,8 +145,10 @@ func (o *IgroupAddResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupAddResponse is a factory method for creating new instances of IgroupAddResponse objects
 func NewIgroupAddResponse() *IgroupAddResponse { return &IgroupAddResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupAddResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// IgroupCreateRequest is a structure to represent a igroup-create ZAPI request object
 type IgroupCreateRequest struct {
 	XMLName xml.Name `xml:"igroup-create"`
 
@@ -21,6 +24,7 @@ type IgroupCreateRequest struct {
 	OstypePtr             *string `xml:"ostype"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupCreateRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -29,10 +33,12 @@ func (o *IgroupCreateRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupCreateRequest is a factory method for creating new instances of IgroupCreateRequest objects
 func NewIgroupCreateRequest() *IgroupCreateRequest { return &IgroupCreateRequest{} }
 
-func (r *IgroupCreateRequest) ExecuteUsing(zr *ZapiRunner) (IgroupCreateResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *IgroupCreateRequest) ExecuteUsing(zr *ZapiRunner) (IgroupCreateResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -47,6 +53,7 @@ func (r *IgroupCreateRequest) ExecuteUsing(zr *ZapiRunner) (IgroupCreateResponse
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupCreateRequest) String() string {
 	var buffer bytes.Buffer
 	if o.BindPortsetPtr != nil {
@@ -77,56 +84,67 @@ func (o IgroupCreateRequest) String() string {
 	return buffer.String()
 }
 
+// BindPortset is a fluent style 'getter' method that can be chained
 func (o *IgroupCreateRequest) BindPortset() string {
 	r := *o.BindPortsetPtr
 	return r
 }
 
+// SetBindPortset is a fluent style 'setter' method that can be chained
 func (o *IgroupCreateRequest) SetBindPortset(newValue string) *IgroupCreateRequest {
 	o.BindPortsetPtr = &newValue
 	return o
 }
 
+// InitiatorGroupName is a fluent style 'getter' method that can be chained
 func (o *IgroupCreateRequest) InitiatorGroupName() string {
 	r := *o.InitiatorGroupNamePtr
 	return r
 }
 
+// SetInitiatorGroupName is a fluent style 'setter' method that can be chained
 func (o *IgroupCreateRequest) SetInitiatorGroupName(newValue string) *IgroupCreateRequest {
 	o.InitiatorGroupNamePtr = &newValue
 	return o
 }
 
+// InitiatorGroupType is a fluent style 'getter' method that can be chained
 func (o *IgroupCreateRequest) InitiatorGroupType() string {
 	r := *o.InitiatorGroupTypePtr
 	return r
 }
 
+// SetInitiatorGroupType is a fluent style 'setter' method that can be chained
 func (o *IgroupCreateRequest) SetInitiatorGroupType(newValue string) *IgroupCreateRequest {
 	o.InitiatorGroupTypePtr = &newValue
 	return o
 }
 
+// OsType is a fluent style 'getter' method that can be chained
 func (o *IgroupCreateRequest) OsType() string {
 	r := *o.OsTypePtr
 	return r
 }
 
+// SetOsType is a fluent style 'setter' method that can be chained
 func (o *IgroupCreateRequest) SetOsType(newValue string) *IgroupCreateRequest {
 	o.OsTypePtr = &newValue
 	return o
 }
 
+// Ostype is a fluent style 'getter' method that can be chained
 func (o *IgroupCreateRequest) Ostype() string {
 	r := *o.OstypePtr
 	return r
 }
 
+// SetOstype is a fluent style 'setter' method that can be chained
 func (o *IgroupCreateRequest) SetOstype(newValue string) *IgroupCreateRequest {
 	o.OstypePtr = &newValue
 	return o
 }
 
+// IgroupCreateResponse is a structure to represent a igroup-create ZAPI response object
 type IgroupCreateResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -136,6 +154,7 @@ type IgroupCreateResponse struct {
 	Result IgroupCreateResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupCreateResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -144,6 +163,7 @@ func (o IgroupCreateResponse) String() string {
 	return buffer.String()
 }
 
+// IgroupCreateResponseResult is a structure to represent a igroup-create ZAPI object's result
 type IgroupCreateResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -152,6 +172,7 @@ type IgroupCreateResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupCreateResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -160,8 +181,10 @@ func (o *IgroupCreateResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupCreateResponse is a factory method for creating new instances of IgroupCreateResponse objects
 func NewIgroupCreateResponse() *IgroupCreateResponse { return &IgroupCreateResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupCreateResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// IgroupDestroyRequest is a structure to represent a igroup-destroy ZAPI request object
 type IgroupDestroyRequest struct {
 	XMLName xml.Name `xml:"igroup-destroy"`
 
@@ -18,6 +21,7 @@ type IgroupDestroyRequest struct {
 	InitiatorGroupNamePtr *string `xml:"initiator-group-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupDestroyRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -26,10 +30,12 @@ func (o *IgroupDestroyRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupDestroyRequest is a factory method for creating new instances of IgroupDestroyRequest objects
 func NewIgroupDestroyRequest() *IgroupDestroyRequest { return &IgroupDestroyRequest{} }
 
-func (r *IgroupDestroyRequest) ExecuteUsing(zr *ZapiRunner) (IgroupDestroyResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *IgroupDestroyRequest) ExecuteUsing(zr *ZapiRunner) (IgroupDestroyResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -44,6 +50,7 @@ func (r *IgroupDestroyRequest) ExecuteUsing(zr *ZapiRunner) (IgroupDestroyRespon
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupDestroyRequest) String() string {
 	var buffer bytes.Buffer
 	if o.ForcePtr != nil {
@@ -59,26 +66,31 @@ func (o IgroupDestroyRequest) String() string {
 	return buffer.String()
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *IgroupDestroyRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *IgroupDestroyRequest) SetForce(newValue bool) *IgroupDestroyRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// InitiatorGroupName is a fluent style 'getter' method that can be chained
 func (o *IgroupDestroyRequest) InitiatorGroupName() string {
 	r := *o.InitiatorGroupNamePtr
 	return r
 }
 
+// SetInitiatorGroupName is a fluent style 'setter' method that can be chained
 func (o *IgroupDestroyRequest) SetInitiatorGroupName(newValue string) *IgroupDestroyRequest {
 	o.InitiatorGroupNamePtr = &newValue
 	return o
 }
 
+// IgroupDestroyResponse is a structure to represent a igroup-destroy ZAPI response object
 type IgroupDestroyResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -88,6 +100,7 @@ type IgroupDestroyResponse struct {
 	Result IgroupDestroyResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupDestroyResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -96,6 +109,7 @@ func (o IgroupDestroyResponse) String() string {
 	return buffer.String()
 }
 
+// IgroupDestroyResponseResult is a structure to represent a igroup-destroy ZAPI object's result
 type IgroupDestroyResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -104,6 +118,7 @@ type IgroupDestroyResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupDestroyResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -112,8 +127,10 @@ func (o *IgroupDestroyResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupDestroyResponse is a factory method for creating new instances of IgroupDestroyResponse objects
 func NewIgroupDestroyResponse() *IgroupDestroyResponse { return &IgroupDestroyResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupDestroyResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// IgroupRemoveRequest is a structure to represent a igroup-remove ZAPI request object
 type IgroupRemoveRequest struct {
 	XMLName xml.Name `xml:"igroup-remove"`
 
@@ -19,6 +22,7 @@ type IgroupRemoveRequest struct {
 	InitiatorGroupNamePtr *string `xml:"initiator-group-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupRemoveRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -27,10 +31,12 @@ func (o *IgroupRemoveRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupRemoveRequest is a factory method for creating new instances of IgroupRemoveRequest objects
 func NewIgroupRemoveRequest() *IgroupRemoveRequest { return &IgroupRemoveRequest{} }
 
-func (r *IgroupRemoveRequest) ExecuteUsing(zr *ZapiRunner) (IgroupRemoveResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *IgroupRemoveRequest) ExecuteUsing(zr *ZapiRunner) (IgroupRemoveResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -45,6 +51,7 @@ func (r *IgroupRemoveRequest) ExecuteUsing(zr *ZapiRunner) (IgroupRemoveResponse
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupRemoveRequest) String() string {
 	var buffer bytes.Buffer
 	if o.ForcePtr != nil {
@@ -65,36 +72,43 @@ func (o IgroupRemoveRequest) String() string {
 	return buffer.String()
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *IgroupRemoveRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *IgroupRemoveRequest) SetForce(newValue bool) *IgroupRemoveRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// Initiator is a fluent style 'getter' method that can be chained
 func (o *IgroupRemoveRequest) Initiator() string {
 	r := *o.InitiatorPtr
 	return r
 }
 
+// SetInitiator is a fluent style 'setter' method that can be chained
 func (o *IgroupRemoveRequest) SetInitiator(newValue string) *IgroupRemoveRequest {
 	o.InitiatorPtr = &newValue
 	return o
 }
 
+// InitiatorGroupName is a fluent style 'getter' method that can be chained
 func (o *IgroupRemoveRequest) InitiatorGroupName() string {
 	r := *o.InitiatorGroupNamePtr
 	return r
 }
 
+// SetInitiatorGroupName is a fluent style 'setter' method that can be chained
 func (o *IgroupRemoveRequest) SetInitiatorGroupName(newValue string) *IgroupRemoveRequest {
 	o.InitiatorGroupNamePtr = &newValue
 	return o
 }
 
+// IgroupRemoveResponse is a structure to represent a igroup-remove ZAPI response object
 type IgroupRemoveResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -104,6 +118,7 @@ type IgroupRemoveResponse struct {
 	Result IgroupRemoveResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupRemoveResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -112,6 +127,7 @@ func (o IgroupRemoveResponse) String() string {
 	return buffer.String()
 }
 
+// IgroupRemoveResponseResult is a structure to represent a igroup-remove ZAPI object's result
 type IgroupRemoveResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -120,6 +136,7 @@ type IgroupRemoveResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *IgroupRemoveResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -128,8 +145,10 @@ func (o *IgroupRemoveResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewIgroupRemoveResponse is a factory method for creating new instances of IgroupRemoveResponse objects
 func NewIgroupRemoveResponse() *IgroupRemoveResponse { return &IgroupRemoveResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o IgroupRemoveResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
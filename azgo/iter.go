@@ -0,0 +1,32 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package azgo
+
+// DefaultMaxRecords is the page size of get-iter calls when none is given
+const DefaultMaxRecords = 100
+
+// GetIterPage issues one page of a get-iter call, starting at tag (empty for the first page) and returning at
+// most maxRecords records.  It returns the next-tag of the response, or nil to stop, which it should do when the
+// page did not pass.
+type GetIterPage func(tag string, maxRecords int) (nextTag *string, err error)
+
+// IterateGetIter runs a get-iter call page by page, following next-tag until the Filer reports no more records.
+// The page function sees each page as it arrives, so it can either accumulate the records or stream them.
+func IterateGetIter(maxRecords int, page GetIterPage) error {
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+
+	tag := ""
+	for {
+		nextTag, err := page(tag, maxRecords)
+		if err != nil {
+			return err
+		}
+		// a Filer handing back the same tag would never finish
+		if nextTag == nil || *nextTag == "" || *nextTag == tag {
+			return nil
+		}
+		tag = *nextTag
+	}
+}
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunCreateBySizeRequest is a structure to represent a lun-create-by-size ZAPI request object
 type LunCreateBySizeRequest struct {
 	XMLName xml.Name `xml:"lun-create-by-size"`
 
@@ -28,6 +31,7 @@ type LunCreateBySizeRequest struct {
 	TypePtr                    *string `xml:"type"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunCreateBySizeRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -36,10 +40,12 @@ func (o *LunCreateBySizeRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunCreateBySizeRequest is a factory method for creating new instances of LunCreateBySizeRequest objects
 func NewLunCreateBySizeRequest() *LunCreateBySizeRequest { return &LunCreateBySizeRequest{} }
 
-func (r *LunCreateBySizeRequest) ExecuteUsing(zr *ZapiRunner) (LunCreateBySizeResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunCreateBySizeRequest) ExecuteUsing(zr *ZapiRunner) (LunCreateBySizeResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -54,6 +60,7 @@ func (r *LunCreateBySizeRequest) ExecuteUsing(zr *ZapiRunner) (LunCreateBySizeRe
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunCreateBySizeRequest) String() string {
 	var buffer bytes.Buffer
 	if o.CachingPolicyPtr != nil {
@@ -119,126 +126,151 @@ func (o LunCreateBySizeRequest) String() string {
 	return buffer.String()
 }
 
+// CachingPolicy is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) CachingPolicy() string {
 	r := *o.CachingPolicyPtr
 	return r
 }
 
+// SetCachingPolicy is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetCachingPolicy(newValue string) *LunCreateBySizeRequest {
 	o.CachingPolicyPtr = &newValue
 	return o
 }
 
+// Class is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) Class() string {
 	r := *o.ClassPtr
 	return r
 }
 
+// SetClass is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetClass(newValue string) *LunCreateBySizeRequest {
 	o.ClassPtr = &newValue
 	return o
 }
 
+// Comment is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) Comment() string {
 	r := *o.CommentPtr
 	return r
 }
 
+// SetComment is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetComment(newValue string) *LunCreateBySizeRequest {
 	o.CommentPtr = &newValue
 	return o
 }
 
+// ForeignDisk is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) ForeignDisk() string {
 	r := *o.ForeignDiskPtr
 	return r
 }
 
+// SetForeignDisk is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetForeignDisk(newValue string) *LunCreateBySizeRequest {
 	o.ForeignDiskPtr = &newValue
 	return o
 }
 
+// Ostype is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) Ostype() string {
 	r := *o.OstypePtr
 	return r
 }
 
+// SetOstype is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetOstype(newValue string) *LunCreateBySizeRequest {
 	o.OstypePtr = &newValue
 	return o
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetPath(newValue string) *LunCreateBySizeRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// PrefixSize is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) PrefixSize() int {
 	r := *o.PrefixSizePtr
 	return r
 }
 
+// SetPrefixSize is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetPrefixSize(newValue int) *LunCreateBySizeRequest {
 	o.PrefixSizePtr = &newValue
 	return o
 }
 
+// QosPolicyGroup is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) QosPolicyGroup() string {
 	r := *o.QosPolicyGroupPtr
 	return r
 }
 
+// SetQosPolicyGroup is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetQosPolicyGroup(newValue string) *LunCreateBySizeRequest {
 	o.QosPolicyGroupPtr = &newValue
 	return o
 }
 
+// Size is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) Size() int {
 	r := *o.SizePtr
 	return r
 }
 
+// SetSize is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetSize(newValue int) *LunCreateBySizeRequest {
 	o.SizePtr = &newValue
 	return o
 }
 
+// SpaceAllocationEnabled is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) SpaceAllocationEnabled() bool {
 	r := *o.SpaceAllocationEnabledPtr
 	return r
 }
 
+// SetSpaceAllocationEnabled is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetSpaceAllocationEnabled(newValue bool) *LunCreateBySizeRequest {
 	o.SpaceAllocationEnabledPtr = &newValue
 	return o
 }
 
+// SpaceReservationEnabled is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) SpaceReservationEnabled() bool {
 	r := *o.SpaceReservationEnabledPtr
 	return r
 }
 
+// SetSpaceReservationEnabled is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetSpaceReservationEnabled(newValue bool) *LunCreateBySizeRequest {
 	o.SpaceReservationEnabledPtr = &newValue
 	return o
 }
 
+// Type is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeRequest) Type() string {
 	r := *o.TypePtr
 	return r
 }
 
+// SetType is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeRequest) SetType(newValue string) *LunCreateBySizeRequest {
 	o.TypePtr = &newValue
 	return o
 }
 
+// LunCreateBySizeResponse is a structure to represent a lun-create-by-size ZAPI response object
 type LunCreateBySizeResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -248,6 +280,7 @@ type LunCreateBySizeResponse struct {
 	Result LunCreateBySizeResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunCreateBySizeResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -256,6 +289,7 @@ func (o LunCreateBySizeResponse) String() string {
 	return buffer.String()
 }
 
+// LunCreateBySizeResponseResult is a structure to represent a lun-create-by-size ZAPI object's result
 type LunCreateBySizeResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -265,6 +299,7 @@ type LunCreateBySizeResponseResult struct {
 	ActualSizePtr    *int   `xml:"actual-size"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunCreateBySizeResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -273,8 +308,10 @@ func (o *LunCreateBySizeResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunCreateBySizeResponse is a factory method for creating new instances of LunCreateBySizeResponse objects
 func NewLunCreateBySizeResponse() *LunCreateBySizeResponse { return &LunCreateBySizeResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunCreateBySizeResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -288,11 +325,13 @@ func (o LunCreateBySizeResponseResult) String() string {
 	return buffer.String()
 }
 
+// ActualSize is a fluent style 'getter' method that can be chained
 func (o *LunCreateBySizeResponseResult) ActualSize() int {
 	r := *o.ActualSizePtr
 	return r
 }
 
+// SetActualSize is a fluent style 'setter' method that can be chained
 func (o *LunCreateBySizeResponseResult) SetActualSize(newValue int) *LunCreateBySizeResponseResult {
 	o.ActualSizePtr = &newValue
 	return o
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunDestroyRequest is a structure to represent a lun-destroy ZAPI request object
 type LunDestroyRequest struct {
 	XMLName xml.Name `xml:"lun-destroy"`
 
@@ -19,6 +22,7 @@ type LunDestroyRequest struct {
 	PathPtr             *string `xml:"path"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunDestroyRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -27,10 +31,12 @@ func (o *LunDestroyRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunDestroyRequest is a factory method for creating new instances of LunDestroyRequest objects
 func NewLunDestroyRequest() *LunDestroyRequest { return &LunDestroyRequest{} }
 
-func (r *LunDestroyRequest) ExecuteUsing(zr *ZapiRunner) (LunDestroyResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunDestroyRequest) ExecuteUsing(zr *ZapiRunner) (LunDestroyResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -45,6 +51,7 @@ func (r *LunDestroyRequest) ExecuteUsing(zr *ZapiRunner) (LunDestroyResponse, er
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunDestroyRequest) String() string {
 	var buffer bytes.Buffer
 	if o.DestroyFencedLunPtr != nil {
@@ -65,36 +72,43 @@ func (o LunDestroyRequest) String() string {
 	return buffer.String()
 }
 
+// DestroyFencedLun is a fluent style 'getter' method that can be chained
 func (o *LunDestroyRequest) DestroyFencedLun() bool {
 	r := *o.DestroyFencedLunPtr
 	return r
 }
 
+// SetDestroyFencedLun is a fluent style 'setter' method that can be chained
 func (o *LunDestroyRequest) SetDestroyFencedLun(newValue bool) *LunDestroyRequest {
 	o.DestroyFencedLunPtr = &newValue
 	return o
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *LunDestroyRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *LunDestroyRequest) SetForce(newValue bool) *LunDestroyRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunDestroyRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunDestroyRequest) SetPath(newValue string) *LunDestroyRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// LunDestroyResponse is a structure to represent a lun-destroy ZAPI response object
 type LunDestroyResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -104,6 +118,7 @@ type LunDestroyResponse struct {
 	Result LunDestroyResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunDestroyResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -112,6 +127,7 @@ func (o LunDestroyResponse) String() string {
 	return buffer.String()
 }
 
+// LunDestroyResponseResult is a structure to represent a lun-destroy ZAPI object's result
 type LunDestroyResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -120,6 +136,7 @@ type LunDestroyResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunDestroyResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -128,8 +145,10 @@ func (o *LunDestroyResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunDestroyResponse is a factory method for creating new instances of LunDestroyResponse objects
 func NewLunDestroyResponse() *LunDestroyResponse { return &LunDestroyResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunDestroyResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,12 +13,14 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunGetSerialNumberRequest is a structure to represent a lun-get-serial-number ZAPI request object
 type LunGetSerialNumberRequest struct {
 	XMLName xml.Name `xml:"lun-get-serial-number"`
 
 	PathPtr *string `xml:"path"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunGetSerialNumberRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -25,10 +29,12 @@ func (o *LunGetSerialNumberRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunGetSerialNumberRequest is a factory method for creating new instances of LunGetSerialNumberRequest objects
 func NewLunGetSerialNumberRequest() *LunGetSerialNumberRequest { return &LunGetSerialNumberRequest{} }
 
-func (r *LunGetSerialNumberRequest) ExecuteUsing(zr *ZapiRunner) (LunGetSerialNumberResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunGetSerialNumberRequest) ExecuteUsing(zr *ZapiRunner) (LunGetSerialNumberResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -43,6 +49,7 @@ func (r *LunGetSerialNumberRequest) ExecuteUsing(zr *ZapiRunner) (LunGetSerialNu
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunGetSerialNumberRequest) String() string {
 	var buffer bytes.Buffer
 	if o.PathPtr != nil {
@@ -53,16 +60,19 @@ func (o LunGetSerialNumberRequest) String() string {
 	return buffer.String()
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunGetSerialNumberRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunGetSerialNumberRequest) SetPath(newValue string) *LunGetSerialNumberRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// LunGetSerialNumberResponse is a structure to represent a lun-get-serial-number ZAPI response object
 type LunGetSerialNumberResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -72,6 +82,7 @@ type LunGetSerialNumberResponse struct {
 	Result LunGetSerialNumberResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunGetSerialNumberResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -80,6 +91,7 @@ func (o LunGetSerialNumberResponse) String() string {
 	return buffer.String()
 }
 
+// LunGetSerialNumberResponseResult is a structure to represent a lun-get-serial-number ZAPI object's result
 type LunGetSerialNumberResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -89,6 +101,7 @@ type LunGetSerialNumberResponseResult struct {
 	SerialNumberPtr  *string `xml:"serial-number"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunGetSerialNumberResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -97,8 +110,12 @@ func (o *LunGetSerialNumberResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
-func NewLunGetSerialNumberResponse() *LunGetSerialNumberResponse { return &LunGetSerialNumberResponse{} }
+// NewLunGetSerialNumberResponse is a factory method for creating new instances of LunGetSerialNumberResponse objects
+func NewLunGetSerialNumberResponse() *LunGetSerialNumberResponse {
+	return &LunGetSerialNumberResponse{}
+}
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunGetSerialNumberResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -112,11 +129,13 @@ func (o LunGetSerialNumberResponseResult) String() string {
 	return buffer.String()
 }
 
+// SerialNumber is a fluent style 'getter' method that can be chained
 func (o *LunGetSerialNumberResponseResult) SerialNumber() string {
 	r := *o.SerialNumberPtr
 	return r
 }
 
+// SetSerialNumber is a fluent style 'setter' method that can be chained
 func (o *LunGetSerialNumberResponseResult) SetSerialNumber(newValue string) *LunGetSerialNumberResponseResult {
 	o.SerialNumberPtr = &newValue
 	return o
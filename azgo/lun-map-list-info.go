@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,12 +13,14 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunMapListInfoRequest is a structure to represent a lun-map-list-info ZAPI request object
 type LunMapListInfoRequest struct {
 	XMLName xml.Name `xml:"lun-map-list-info"`
 
 	PathPtr *string `xml:"path"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunMapListInfoRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -25,10 +29,12 @@ func (o *LunMapListInfoRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunMapListInfoRequest is a factory method for creating new instances of LunMapListInfoRequest objects
 func NewLunMapListInfoRequest() *LunMapListInfoRequest { return &LunMapListInfoRequest{} }
 
-func (r *LunMapListInfoRequest) ExecuteUsing(zr *ZapiRunner) (LunMapListInfoResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunMapListInfoRequest) ExecuteUsing(zr *ZapiRunner) (LunMapListInfoResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -43,6 +49,7 @@ func (r *LunMapListInfoRequest) ExecuteUsing(zr *ZapiRunner) (LunMapListInfoResp
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunMapListInfoRequest) String() string {
 	var buffer bytes.Buffer
 	if o.PathPtr != nil {
@@ -53,16 +60,19 @@ func (o LunMapListInfoRequest) String() string {
 	return buffer.String()
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunMapListInfoRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunMapListInfoRequest) SetPath(newValue string) *LunMapListInfoRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// LunMapListInfoResponse is a structure to represent a lun-map-list-info ZAPI response object
 type LunMapListInfoResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -72,6 +82,7 @@ type LunMapListInfoResponse struct {
 	Result LunMapListInfoResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunMapListInfoResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -80,6 +91,7 @@ func (o LunMapListInfoResponse) String() string {
 	return buffer.String()
 }
 
+// LunMapListInfoResponseResult is a structure to represent a lun-map-list-info ZAPI object's result
 type LunMapListInfoResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -89,6 +101,7 @@ type LunMapListInfoResponseResult struct {
 	InitiatorGroupsPtr []InitiatorGroupInfoType `xml:"initiator-groups>initiator-group-info"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunMapListInfoResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -97,8 +110,10 @@ func (o *LunMapListInfoResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunMapListInfoResponse is a factory method for creating new instances of LunMapListInfoResponse objects
 func NewLunMapListInfoResponse() *LunMapListInfoResponse { return &LunMapListInfoResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunMapListInfoResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -112,11 +127,13 @@ func (o LunMapListInfoResponseResult) String() string {
 	return buffer.String()
 }
 
+// InitiatorGroups is a fluent style 'getter' method that can be chained
 func (o *LunMapListInfoResponseResult) InitiatorGroups() []InitiatorGroupInfoType {
 	r := o.InitiatorGroupsPtr
 	return r
 }
 
+// SetInitiatorGroups is a fluent style 'setter' method that can be chained
 func (o *LunMapListInfoResponseResult) SetInitiatorGroups(newValue []InitiatorGroupInfoType) *LunMapListInfoResponseResult {
 	newSlice := make([]InitiatorGroupInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunMapRequest is a structure to represent a lun-map ZAPI request object
 type LunMapRequest struct {
 	XMLName xml.Name `xml:"lun-map"`
 
@@ -21,6 +24,7 @@ type LunMapRequest struct {
 	PathPtr                    *string       `xml:"path"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunMapRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -29,10 +33,12 @@ func (o *LunMapRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunMapRequest is a factory method for creating new instances of LunMapRequest objects
 func NewLunMapRequest() *LunMapRequest { return &LunMapRequest{} }
 
-func (r *LunMapRequest) ExecuteUsing(zr *ZapiRunner) (LunMapResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunMapRequest) ExecuteUsing(zr *ZapiRunner) (LunMapResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -47,6 +53,7 @@ func (r *LunMapRequest) ExecuteUsing(zr *ZapiRunner) (LunMapResponse, error) {
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunMapRequest) String() string {
 	var buffer bytes.Buffer
 	if o.AdditionalReportingNodePtr != nil {
@@ -77,56 +84,67 @@ func (o LunMapRequest) String() string {
 	return buffer.String()
 }
 
+// AdditionalReportingNode is a fluent style 'getter' method that can be chained
 func (o *LunMapRequest) AdditionalReportingNode() NodeNameType {
 	r := *o.AdditionalReportingNodePtr
 	return r
 }
 
+// SetAdditionalReportingNode is a fluent style 'setter' method that can be chained
 func (o *LunMapRequest) SetAdditionalReportingNode(newValue NodeNameType) *LunMapRequest {
 	o.AdditionalReportingNodePtr = &newValue
 	return o
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *LunMapRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *LunMapRequest) SetForce(newValue bool) *LunMapRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// InitiatorGroup is a fluent style 'getter' method that can be chained
 func (o *LunMapRequest) InitiatorGroup() string {
 	r := *o.InitiatorGroupPtr
 	return r
 }
 
+// SetInitiatorGroup is a fluent style 'setter' method that can be chained
 func (o *LunMapRequest) SetInitiatorGroup(newValue string) *LunMapRequest {
 	o.InitiatorGroupPtr = &newValue
 	return o
 }
 
+// LunId is a fluent style 'getter' method that can be chained
 func (o *LunMapRequest) LunId() int {
 	r := *o.LunIdPtr
 	return r
 }
 
+// SetLunId is a fluent style 'setter' method that can be chained
 func (o *LunMapRequest) SetLunId(newValue int) *LunMapRequest {
 	o.LunIdPtr = &newValue
 	return o
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunMapRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunMapRequest) SetPath(newValue string) *LunMapRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// LunMapResponse is a structure to represent a lun-map ZAPI response object
 type LunMapResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -136,6 +154,7 @@ type LunMapResponse struct {
 	Result LunMapResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunMapResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -144,6 +163,7 @@ func (o LunMapResponse) String() string {
 	return buffer.String()
 }
 
+// LunMapResponseResult is a structure to represent a lun-map ZAPI object's result
 type LunMapResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -153,6 +173,7 @@ type LunMapResponseResult struct {
 	LunIdAssignedPtr *int   `xml:"lun-id-assigned"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunMapResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -161,8 +182,10 @@ func (o *LunMapResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunMapResponse is a factory method for creating new instances of LunMapResponse objects
 func NewLunMapResponse() *LunMapResponse { return &LunMapResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunMapResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -176,11 +199,13 @@ func (o LunMapResponseResult) String() string {
 	return buffer.String()
 }
 
+// LunIdAssigned is a fluent style 'getter' method that can be chained
 func (o *LunMapResponseResult) LunIdAssigned() int {
 	r := *o.LunIdAssignedPtr
 	return r
 }
 
+// SetLunIdAssigned is a fluent style 'setter' method that can be chained
 func (o *LunMapResponseResult) SetLunIdAssigned(newValue int) *LunMapResponseResult {
 	o.LunIdAssignedPtr = &newValue
 	return o
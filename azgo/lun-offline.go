@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,12 +13,14 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunOfflineRequest is a structure to represent a lun-offline ZAPI request object
 type LunOfflineRequest struct {
 	XMLName xml.Name `xml:"lun-offline"`
 
 	PathPtr *string `xml:"path"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunOfflineRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -25,10 +29,12 @@ func (o *LunOfflineRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunOfflineRequest is a factory method for creating new instances of LunOfflineRequest objects
 func NewLunOfflineRequest() *LunOfflineRequest { return &LunOfflineRequest{} }
 
-func (r *LunOfflineRequest) ExecuteUsing(zr *ZapiRunner) (LunOfflineResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunOfflineRequest) ExecuteUsing(zr *ZapiRunner) (LunOfflineResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -43,6 +49,7 @@ func (r *LunOfflineRequest) ExecuteUsing(zr *ZapiRunner) (LunOfflineResponse, er
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunOfflineRequest) String() string {
 	var buffer bytes.Buffer
 	if o.PathPtr != nil {
@@ -53,16 +60,19 @@ func (o LunOfflineRequest) String() string {
 	return buffer.String()
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunOfflineRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunOfflineRequest) SetPath(newValue string) *LunOfflineRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// LunOfflineResponse is a structure to represent a lun-offline ZAPI response object
 type LunOfflineResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -72,6 +82,7 @@ type LunOfflineResponse struct {
 	Result LunOfflineResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunOfflineResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -80,6 +91,7 @@ func (o LunOfflineResponse) String() string {
 	return buffer.String()
 }
 
+// LunOfflineResponseResult is a structure to represent a lun-offline ZAPI object's result
 type LunOfflineResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -88,6 +100,7 @@ type LunOfflineResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunOfflineResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -96,8 +109,10 @@ func (o *LunOfflineResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunOfflineResponse is a factory method for creating new instances of LunOfflineResponse objects
 func NewLunOfflineResponse() *LunOfflineResponse { return &LunOfflineResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunOfflineResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
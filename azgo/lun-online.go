@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// LunOnlineRequest is a structure to represent a lun-online ZAPI request object
 type LunOnlineRequest struct {
 	XMLName xml.Name `xml:"lun-online"`
 
@@ -18,6 +21,7 @@ type LunOnlineRequest struct {
 	PathPtr  *string `xml:"path"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunOnlineRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -26,10 +30,12 @@ func (o *LunOnlineRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunOnlineRequest is a factory method for creating new instances of LunOnlineRequest objects
 func NewLunOnlineRequest() *LunOnlineRequest { return &LunOnlineRequest{} }
 
-func (r *LunOnlineRequest) ExecuteUsing(zr *ZapiRunner) (LunOnlineResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *LunOnlineRequest) ExecuteUsing(zr *ZapiRunner) (LunOnlineResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -44,6 +50,7 @@ func (r *LunOnlineRequest) ExecuteUsing(zr *ZapiRunner) (LunOnlineResponse, erro
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunOnlineRequest) String() string {
 	var buffer bytes.Buffer
 	if o.ForcePtr != nil {
@@ -59,26 +66,31 @@ func (o LunOnlineRequest) String() string {
 	return buffer.String()
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *LunOnlineRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *LunOnlineRequest) SetForce(newValue bool) *LunOnlineRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// Path is a fluent style 'getter' method that can be chained
 func (o *LunOnlineRequest) Path() string {
 	r := *o.PathPtr
 	return r
 }
 
+// SetPath is a fluent style 'setter' method that can be chained
 func (o *LunOnlineRequest) SetPath(newValue string) *LunOnlineRequest {
 	o.PathPtr = &newValue
 	return o
 }
 
+// LunOnlineResponse is a structure to represent a lun-online ZAPI response object
 type LunOnlineResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -88,6 +100,7 @@ type LunOnlineResponse struct {
 	Result LunOnlineResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunOnlineResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -96,6 +109,7 @@ func (o LunOnlineResponse) String() string {
 	return buffer.String()
 }
 
+// LunOnlineResponseResult is a structure to represent a lun-online ZAPI object's result
 type LunOnlineResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -104,6 +118,7 @@ type LunOnlineResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *LunOnlineResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -112,8 +127,10 @@ func (o *LunOnlineResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewLunOnlineResponse is a factory method for creating new instances of LunOnlineResponse objects
 func NewLunOnlineResponse() *LunOnlineResponse { return &LunOnlineResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o LunOnlineResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
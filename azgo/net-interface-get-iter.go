@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// NetInterfaceGetIterRequest is a structure to represent a net-interface-get-iter ZAPI request object
 type NetInterfaceGetIterRequest struct {
 	XMLName xml.Name `xml:"net-interface-get-iter"`
 
@@ -20,6 +23,7 @@ type NetInterfaceGetIterRequest struct {
 	TagPtr               *string               `xml:"tag"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *NetInterfaceGetIterRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -28,10 +32,14 @@ func (o *NetInterfaceGetIterRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
-func NewNetInterfaceGetIterRequest() *NetInterfaceGetIterRequest { return &NetInterfaceGetIterRequest{} }
+// NewNetInterfaceGetIterRequest is a factory method for creating new instances of NetInterfaceGetIterRequest objects
+func NewNetInterfaceGetIterRequest() *NetInterfaceGetIterRequest {
+	return &NetInterfaceGetIterRequest{}
+}
 
-func (r *NetInterfaceGetIterRequest) ExecuteUsing(zr *ZapiRunner) (NetInterfaceGetIterResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *NetInterfaceGetIterRequest) ExecuteUsing(zr *ZapiRunner) (NetInterfaceGetIterResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -46,6 +54,34 @@ func (r *NetInterfaceGetIterRequest) ExecuteUsing(zr *ZapiRunner) (NetInterfaceG
 	return n, err
 }
 
+// ExecuteAllUsing sends this request page by page using the supplied ZapiRunner, following next-tag, and returns a
+// response holding the records of every page; maxRecords is the page size, or DefaultMaxRecords if not positive
+func (o *NetInterfaceGetIterRequest) ExecuteAllUsing(zr *ZapiRunner, maxRecords int) (NetInterfaceGetIterResponse, error) {
+	var response NetInterfaceGetIterResponse
+	request := *o
+	records := make([]NetInterfaceInfoType, 0)
+	err := IterateGetIter(maxRecords, func(tag string, maxRecords int) (*string, error) {
+		request.SetMaxRecords(maxRecords)
+		if tag != "" {
+			request.SetTag(tag)
+		}
+
+		var err error
+		response, err = request.ExecuteUsing(zr)
+		if err != nil || response.Result.ResultStatusAttr != "passed" {
+			return nil, err
+		}
+		records = append(records, response.Result.AttributesList()...)
+		return response.Result.NextTagPtr, nil
+	})
+	if err == nil && response.Result.ResultStatusAttr == "passed" {
+		response.Result.SetAttributesList(records).SetNumRecords(len(records))
+		response.Result.NextTagPtr = nil
+	}
+	return response, err
+}
+
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o NetInterfaceGetIterRequest) String() string {
 	var buffer bytes.Buffer
 	if o.DesiredAttributesPtr != nil {
@@ -71,46 +107,55 @@ func (o NetInterfaceGetIterRequest) String() string {
 	return buffer.String()
 }
 
+// DesiredAttributes is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterRequest) DesiredAttributes() NetInterfaceInfoType {
 	r := *o.DesiredAttributesPtr
 	return r
 }
 
+// SetDesiredAttributes is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterRequest) SetDesiredAttributes(newValue NetInterfaceInfoType) *NetInterfaceGetIterRequest {
 	o.DesiredAttributesPtr = &newValue
 	return o
 }
 
+// MaxRecords is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterRequest) MaxRecords() int {
 	r := *o.MaxRecordsPtr
 	return r
 }
 
+// SetMaxRecords is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterRequest) SetMaxRecords(newValue int) *NetInterfaceGetIterRequest {
 	o.MaxRecordsPtr = &newValue
 	return o
 }
 
+// Query is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterRequest) Query() NetInterfaceInfoType {
 	r := *o.QueryPtr
 	return r
 }
 
+// SetQuery is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterRequest) SetQuery(newValue NetInterfaceInfoType) *NetInterfaceGetIterRequest {
 	o.QueryPtr = &newValue
 	return o
 }
 
+// Tag is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterRequest) Tag() string {
 	r := *o.TagPtr
 	return r
 }
 
+// SetTag is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterRequest) SetTag(newValue string) *NetInterfaceGetIterRequest {
 	o.TagPtr = &newValue
 	return o
 }
 
+// NetInterfaceGetIterResponse is a structure to represent a net-interface-get-iter ZAPI response object
 type NetInterfaceGetIterResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -120,6 +165,7 @@ type NetInterfaceGetIterResponse struct {
 	Result NetInterfaceGetIterResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o NetInterfaceGetIterResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -128,6 +174,7 @@ func (o NetInterfaceGetIterResponse) String() string {
 	return buffer.String()
 }
 
+// NetInterfaceGetIterResponseResult is a structure to represent a net-interface-get-iter ZAPI object's result
 type NetInterfaceGetIterResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -139,6 +186,7 @@ type NetInterfaceGetIterResponseResult struct {
 	NumRecordsPtr     *int                   `xml:"num-records"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *NetInterfaceGetIterResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -147,10 +195,12 @@ func (o *NetInterfaceGetIterResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewNetInterfaceGetIterResponse is a factory method for creating new instances of NetInterfaceGetIterResponse objects
 func NewNetInterfaceGetIterResponse() *NetInterfaceGetIterResponse {
 	return &NetInterfaceGetIterResponse{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o NetInterfaceGetIterResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -174,11 +224,13 @@ func (o NetInterfaceGetIterResponseResult) String() string {
 	return buffer.String()
 }
 
+// AttributesList is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterResponseResult) AttributesList() []NetInterfaceInfoType {
 	r := o.AttributesListPtr
 	return r
 }
 
+// SetAttributesList is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterResponseResult) SetAttributesList(newValue []NetInterfaceInfoType) *NetInterfaceGetIterResponseResult {
 	newSlice := make([]NetInterfaceInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -186,21 +238,25 @@ func (o *NetInterfaceGetIterResponseResult) SetAttributesList(newValue []NetInte
 	return o
 }
 
+// NextTag is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterResponseResult) NextTag() string {
 	r := *o.NextTagPtr
 	return r
 }
 
+// SetNextTag is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterResponseResult) SetNextTag(newValue string) *NetInterfaceGetIterResponseResult {
 	o.NextTagPtr = &newValue
 	return o
 }
 
+// NumRecords is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceGetIterResponseResult) NumRecords() int {
 	r := *o.NumRecordsPtr
 	return r
 }
 
+// SetNumRecords is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceGetIterResponseResult) SetNumRecords(newValue int) *NetInterfaceGetIterResponseResult {
 	o.NumRecordsPtr = &newValue
 	return o
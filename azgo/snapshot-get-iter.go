@@ -1,13 +1,16 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
 	"bytes"
 	"encoding/xml"
 	"fmt"
-	log "github.com/Sirupsen/logrus"
 	"io/ioutil"
+
+	log "github.com/Sirupsen/logrus"
 )
 
 // SnapshotGetIterRequest is a structure to represent a snapshot-get-iter ZAPI request object
@@ -49,6 +52,33 @@ func (o *SnapshotGetIterRequest) ExecuteUsing(zr *ZapiRunner) (SnapshotGetIterRe
 	return n, err
 }
 
+// ExecuteAllUsing sends this request page by page using the supplied ZapiRunner, following next-tag, and returns a
+// response holding the records of every page; maxRecords is the page size, or DefaultMaxRecords if not positive
+func (o *SnapshotGetIterRequest) ExecuteAllUsing(zr *ZapiRunner, maxRecords int) (SnapshotGetIterResponse, error) {
+	var response SnapshotGetIterResponse
+	request := *o
+	records := make([]SnapshotInfoType, 0)
+	err := IterateGetIter(maxRecords, func(tag string, maxRecords int) (*string, error) {
+		request.SetMaxRecords(maxRecords)
+		if tag != "" {
+			request.SetTag(tag)
+		}
+
+		var err error
+		response, err = request.ExecuteUsing(zr)
+		if err != nil || response.Result.ResultStatusAttr != "passed" {
+			return nil, err
+		}
+		records = append(records, response.Result.AttributesList()...)
+		return response.Result.NextTagPtr, nil
+	})
+	if err == nil && response.Result.ResultStatusAttr == "passed" {
+		response.Result.SetAttributesList(records).SetNumRecords(len(records))
+		response.Result.NextTagPtr = nil
+	}
+	return response, err
+}
+
 // String returns a string representation of this object's fields and implements the Stringer interface
 func (o SnapshotGetIterRequest) String() string {
 	var buffer bytes.Buffer
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,10 +13,12 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// SystemGetOntapiVersionRequest is a structure to represent a system-get-ontapi-version ZAPI request object
 type SystemGetOntapiVersionRequest struct {
 	XMLName xml.Name `xml:"system-get-ontapi-version"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *SystemGetOntapiVersionRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -23,12 +27,14 @@ func (o *SystemGetOntapiVersionRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewSystemGetOntapiVersionRequest is a factory method for creating new instances of SystemGetOntapiVersionRequest objects
 func NewSystemGetOntapiVersionRequest() *SystemGetOntapiVersionRequest {
 	return &SystemGetOntapiVersionRequest{}
 }
 
-func (r *SystemGetOntapiVersionRequest) ExecuteUsing(zr *ZapiRunner) (SystemGetOntapiVersionResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *SystemGetOntapiVersionRequest) ExecuteUsing(zr *ZapiRunner) (SystemGetOntapiVersionResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -43,11 +49,13 @@ func (r *SystemGetOntapiVersionRequest) ExecuteUsing(zr *ZapiRunner) (SystemGetO
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemGetOntapiVersionRequest) String() string {
 	var buffer bytes.Buffer
 	return buffer.String()
 }
 
+// SystemGetOntapiVersionResponse is a structure to represent a system-get-ontapi-version ZAPI response object
 type SystemGetOntapiVersionResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -57,6 +65,7 @@ type SystemGetOntapiVersionResponse struct {
 	Result SystemGetOntapiVersionResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemGetOntapiVersionResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -65,6 +74,7 @@ func (o SystemGetOntapiVersionResponse) String() string {
 	return buffer.String()
 }
 
+// SystemGetOntapiVersionResponseResult is a structure to represent a system-get-ontapi-version ZAPI object's result
 type SystemGetOntapiVersionResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -75,6 +85,7 @@ type SystemGetOntapiVersionResponseResult struct {
 	MinorVersionPtr  *int   `xml:"minor-version"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *SystemGetOntapiVersionResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -83,10 +94,12 @@ func (o *SystemGetOntapiVersionResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewSystemGetOntapiVersionResponse is a factory method for creating new instances of SystemGetOntapiVersionResponse objects
 func NewSystemGetOntapiVersionResponse() *SystemGetOntapiVersionResponse {
 	return &SystemGetOntapiVersionResponse{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemGetOntapiVersionResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -105,21 +118,25 @@ func (o SystemGetOntapiVersionResponseResult) String() string {
 	return buffer.String()
 }
 
+// MajorVersion is a fluent style 'getter' method that can be chained
 func (o *SystemGetOntapiVersionResponseResult) MajorVersion() int {
 	r := *o.MajorVersionPtr
 	return r
 }
 
+// SetMajorVersion is a fluent style 'setter' method that can be chained
 func (o *SystemGetOntapiVersionResponseResult) SetMajorVersion(newValue int) *SystemGetOntapiVersionResponseResult {
 	o.MajorVersionPtr = &newValue
 	return o
 }
 
+// MinorVersion is a fluent style 'getter' method that can be chained
 func (o *SystemGetOntapiVersionResponseResult) MinorVersion() int {
 	r := *o.MinorVersionPtr
 	return r
 }
 
+// SetMinorVersion is a fluent style 'setter' method that can be chained
 func (o *SystemGetOntapiVersionResponseResult) SetMinorVersion(newValue int) *SystemGetOntapiVersionResponseResult {
 	o.MinorVersionPtr = &newValue
 	return o
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,10 +13,12 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// SystemGetVersionRequest is a structure to represent a system-get-version ZAPI request object
 type SystemGetVersionRequest struct {
 	XMLName xml.Name `xml:"system-get-version"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *SystemGetVersionRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -23,10 +27,12 @@ func (o *SystemGetVersionRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewSystemGetVersionRequest is a factory method for creating new instances of SystemGetVersionRequest objects
 func NewSystemGetVersionRequest() *SystemGetVersionRequest { return &SystemGetVersionRequest{} }
 
-func (r *SystemGetVersionRequest) ExecuteUsing(zr *ZapiRunner) (SystemGetVersionResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *SystemGetVersionRequest) ExecuteUsing(zr *ZapiRunner) (SystemGetVersionResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -41,11 +47,13 @@ func (r *SystemGetVersionRequest) ExecuteUsing(zr *ZapiRunner) (SystemGetVersion
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemGetVersionRequest) String() string {
 	var buffer bytes.Buffer
 	return buffer.String()
 }
 
+// SystemGetVersionResponse is a structure to represent a system-get-version ZAPI response object
 type SystemGetVersionResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -55,6 +63,7 @@ type SystemGetVersionResponse struct {
 	Result SystemGetVersionResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemGetVersionResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -63,6 +72,7 @@ func (o SystemGetVersionResponse) String() string {
 	return buffer.String()
 }
 
+// SystemGetVersionResponseResult is a structure to represent a system-get-version ZAPI object's result
 type SystemGetVersionResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -75,6 +85,7 @@ type SystemGetVersionResponseResult struct {
 	VersionTuplePtr   *SystemVersionTupleType `xml:"version-tuple>system-version-tuple"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *SystemGetVersionResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -83,8 +94,10 @@ func (o *SystemGetVersionResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewSystemGetVersionResponse is a factory method for creating new instances of SystemGetVersionResponse objects
 func NewSystemGetVersionResponse() *SystemGetVersionResponse { return &SystemGetVersionResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemGetVersionResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -113,41 +126,49 @@ func (o SystemGetVersionResponseResult) String() string {
 	return buffer.String()
 }
 
+// BuildTimestamp is a fluent style 'getter' method that can be chained
 func (o *SystemGetVersionResponseResult) BuildTimestamp() int {
 	r := *o.BuildTimestampPtr
 	return r
 }
 
+// SetBuildTimestamp is a fluent style 'setter' method that can be chained
 func (o *SystemGetVersionResponseResult) SetBuildTimestamp(newValue int) *SystemGetVersionResponseResult {
 	o.BuildTimestampPtr = &newValue
 	return o
 }
 
+// IsClustered is a fluent style 'getter' method that can be chained
 func (o *SystemGetVersionResponseResult) IsClustered() bool {
 	r := *o.IsClusteredPtr
 	return r
 }
 
+// SetIsClustered is a fluent style 'setter' method that can be chained
 func (o *SystemGetVersionResponseResult) SetIsClustered(newValue bool) *SystemGetVersionResponseResult {
 	o.IsClusteredPtr = &newValue
 	return o
 }
 
+// Version is a fluent style 'getter' method that can be chained
 func (o *SystemGetVersionResponseResult) Version() string {
 	r := *o.VersionPtr
 	return r
 }
 
+// SetVersion is a fluent style 'setter' method that can be chained
 func (o *SystemGetVersionResponseResult) SetVersion(newValue string) *SystemGetVersionResponseResult {
 	o.VersionPtr = &newValue
 	return o
 }
 
+// VersionTuple is a fluent style 'getter' method that can be chained
 func (o *SystemGetVersionResponseResult) VersionTuple() SystemVersionTupleType {
 	r := *o.VersionTuplePtr
 	return r
 }
 
+// SetVersionTuple is a fluent style 'setter' method that can be chained
 func (o *SystemGetVersionResponseResult) SetVersionTuple(newValue SystemVersionTupleType) *SystemGetVersionResponseResult {
 	o.VersionTuplePtr = &newValue
 	return o
@@ -0,0 +1,73 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package azgo
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// checkGolden compares the ToXML output of a binding with testdata/<name>.xml
+func checkGolden(t *testing.T, name string, r ZAPIRequest) {
+	output, err := r.ToXML()
+	if err != nil {
+		t.Fatalf("%v: ToXML failed: %v", name, err)
+	}
+
+	golden := filepath.Join("testdata", name+".xml")
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(output+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Cannot read %v: %v", golden, err)
+	}
+	if output+"\n" != string(expected) {
+		t.Errorf("%v: ToXML output differs from %v\ngot:\n%v\nexpected:\n%s", name, golden, output, expected)
+	}
+}
+
+func TestToXMLGolden(t *testing.T) {
+	checkGolden(t, "igroup-create", NewIgroupCreateRequest().
+		SetInitiatorGroupName("docker").
+		SetInitiatorGroupType("iscsi").
+		SetOsType("linux"))
+
+	checkGolden(t, "lun-map", NewLunMapRequest().
+		SetInitiatorGroup("docker").
+		SetPath("/vol/v/lun0").
+		SetLunId(0))
+
+	checkGolden(t, "volume-create", NewVolumeCreateRequest().
+		SetVolume("v").
+		SetContainingAggrName("aggr1").
+		SetSize("1g").
+		SetSpaceReserve("none").
+		SetUnixPermissions("---rwxr-xr-x").
+		SetPercentageSnapshotReserve(0).
+		SetEncrypt(true))
+
+	volidattr := NewVolumeIdAttributesType().SetName(VolumeNameType("v"))
+	queryattr := NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+	commentattr := NewVolumeIdAttributesType().SetComment("hello")
+	volattr := NewVolumeAttributesType().SetVolumeIdAttributes(*commentattr)
+	checkGolden(t, "volume-modify-iter", NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr))
+
+	checkGolden(t, "snapshot-get-iter", NewSnapshotGetIterRequest().
+		SetQuery(*NewSnapshotInfoType().SetVolume("v")).
+		SetMaxRecords(100).
+		SetTag("tag-1"))
+
+	checkGolden(t, "net-interface-info", NewNetInterfaceInfoType().
+		SetAddress(IpAddressType("10.0.0.2")).
+		SetDataProtocols([]DataProtocolType{"nfs", "cifs"}))
+}
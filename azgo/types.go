@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -10,6 +12,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VserverAggrInfoType is a structure to represent a vserver-aggr-info ZAPI object
 type VserverAggrInfoType struct {
 	XMLName xml.Name `xml:"vserver-aggr-info"`
 
@@ -17,16 +20,19 @@ type VserverAggrInfoType struct {
 	AggrNamePtr      *AggrNameType `xml:"aggr-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VserverAggrInfoType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
 		log.Errorf("error: %v", err)
-	} // TODO: handle better
+	}
 	return string(output), err
 }
 
+// NewVserverAggrInfoType is a factory method for creating new instances of VserverAggrInfoType objects
 func NewVserverAggrInfoType() *VserverAggrInfoType { return &VserverAggrInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VserverAggrInfoType) String() string {
 	var buffer bytes.Buffer
 	if o.AggrAvailsizePtr != nil {
@@ -42,46 +48,61 @@ func (o VserverAggrInfoType) String() string {
 	return buffer.String()
 }
 
+// AggrAvailsize is a fluent style 'getter' method that can be chained
 func (o *VserverAggrInfoType) AggrAvailsize() SizeType {
 	r := *o.AggrAvailsizePtr
 	return r
 }
 
+// SetAggrAvailsize is a fluent style 'setter' method that can be chained
 func (o *VserverAggrInfoType) SetAggrAvailsize(newValue SizeType) *VserverAggrInfoType {
 	o.AggrAvailsizePtr = &newValue
 	return o
 }
 
+// AggrName is a fluent style 'getter' method that can be chained
 func (o *VserverAggrInfoType) AggrName() AggrNameType {
 	r := *o.AggrNamePtr
 	return r
 }
 
+// SetAggrName is a fluent style 'setter' method that can be chained
 func (o *VserverAggrInfoType) SetAggrName(newValue AggrNameType) *VserverAggrInfoType {
 	o.AggrNamePtr = &newValue
 	return o
 }
 
+// ProtocolType is a ZAPI type represented as a string
 type ProtocolType string
 
+// AntivirusPolicyType is a ZAPI type represented as a string
 type AntivirusPolicyType string
 
+// NmswitchType is a ZAPI type represented as a string
 type NmswitchType string
 
+// NsswitchType is a ZAPI type represented as a string
 type NsswitchType string
 
+// NisDomainType is a ZAPI type represented as a string
 type NisDomainType string
 
+// VsoperstateType is a ZAPI type represented as a string
 type VsoperstateType string
 
+// VsopstopreasonType is a ZAPI type represented as a string
 type VsopstopreasonType string
 
+// SecurityStyleEnumType is a ZAPI type represented as a string
 type SecurityStyleEnumType string
 
+// SnapshotPolicyType is a ZAPI type represented as a string
 type SnapshotPolicyType string
 
+// VsadminstateType is a ZAPI type represented as a string
 type VsadminstateType string
 
+// VserverInfoType is a structure to represent a vserver-info ZAPI object
 type VserverInfoType struct {
 	XMLName xml.Name `xml:"vserver-info"`
 
@@ -116,16 +137,19 @@ type VserverInfoType struct {
 	VserverTypePtr                   *string                `xml:"vserver-type"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VserverInfoType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
 		log.Errorf("error: %v", err)
-	} // TODO: handle better
+	}
 	return string(output), err
 }
 
+// NewVserverInfoType is a factory method for creating new instances of VserverInfoType objects
 func NewVserverInfoType() *VserverInfoType { return &VserverInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VserverInfoType) String() string {
 	var buffer bytes.Buffer
 	if o.AggrListPtr != nil {
@@ -276,11 +300,13 @@ func (o VserverInfoType) String() string {
 	return buffer.String()
 }
 
+// AggrList is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) AggrList() []AggrNameType {
 	r := o.AggrListPtr
 	return r
 }
 
+// SetAggrList is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetAggrList(newValue []AggrNameType) *VserverInfoType {
 	newSlice := make([]AggrNameType, len(newValue))
 	copy(newSlice, newValue)
@@ -288,11 +314,13 @@ func (o *VserverInfoType) SetAggrList(newValue []AggrNameType) *VserverInfoType
 	return o
 }
 
+// AllowedProtocols is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) AllowedProtocols() []ProtocolType {
 	r := o.AllowedProtocolsPtr
 	return r
 }
 
+// SetAllowedProtocols is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetAllowedProtocols(newValue []ProtocolType) *VserverInfoType {
 	newSlice := make([]ProtocolType, len(newValue))
 	copy(newSlice, newValue)
@@ -300,31 +328,37 @@ func (o *VserverInfoType) SetAllowedProtocols(newValue []ProtocolType) *VserverI
 	return o
 }
 
+// AntivirusOnAccessPolicy is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) AntivirusOnAccessPolicy() AntivirusPolicyType {
 	r := *o.AntivirusOnAccessPolicyPtr
 	return r
 }
 
+// SetAntivirusOnAccessPolicy is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetAntivirusOnAccessPolicy(newValue AntivirusPolicyType) *VserverInfoType {
 	o.AntivirusOnAccessPolicyPtr = &newValue
 	return o
 }
 
+// Comment is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) Comment() string {
 	r := *o.CommentPtr
 	return r
 }
 
+// SetComment is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetComment(newValue string) *VserverInfoType {
 	o.CommentPtr = &newValue
 	return o
 }
 
+// DisallowedProtocols is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) DisallowedProtocols() []ProtocolType {
 	r := o.DisallowedProtocolsPtr
 	return r
 }
 
+// SetDisallowedProtocols is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetDisallowedProtocols(newValue []ProtocolType) *VserverInfoType {
 	newSlice := make([]ProtocolType, len(newValue))
 	copy(newSlice, newValue)
@@ -332,71 +366,85 @@ func (o *VserverInfoType) SetDisallowedProtocols(newValue []ProtocolType) *Vserv
 	return o
 }
 
+// Ipspace is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) Ipspace() string {
 	r := *o.IpspacePtr
 	return r
 }
 
+// SetIpspace is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetIpspace(newValue string) *VserverInfoType {
 	o.IpspacePtr = &newValue
 	return o
 }
 
+// IsConfigLockedForChanges is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) IsConfigLockedForChanges() bool {
 	r := *o.IsConfigLockedForChangesPtr
 	return r
 }
 
+// SetIsConfigLockedForChanges is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetIsConfigLockedForChanges(newValue bool) *VserverInfoType {
 	o.IsConfigLockedForChangesPtr = &newValue
 	return o
 }
 
+// IsRepositoryVserver is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) IsRepositoryVserver() bool {
 	r := *o.IsRepositoryVserverPtr
 	return r
 }
 
+// SetIsRepositoryVserver is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetIsRepositoryVserver(newValue bool) *VserverInfoType {
 	o.IsRepositoryVserverPtr = &newValue
 	return o
 }
 
+// Language is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) Language() LanguageCodeType {
 	r := *o.LanguagePtr
 	return r
 }
 
+// SetLanguage is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetLanguage(newValue LanguageCodeType) *VserverInfoType {
 	o.LanguagePtr = &newValue
 	return o
 }
 
+// LdapDomain is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) LdapDomain() string {
 	r := *o.LdapDomainPtr
 	return r
 }
 
+// SetLdapDomain is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetLdapDomain(newValue string) *VserverInfoType {
 	o.LdapDomainPtr = &newValue
 	return o
 }
 
+// MaxVolumes is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) MaxVolumes() string {
 	r := *o.MaxVolumesPtr
 	return r
 }
 
+// SetMaxVolumes is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetMaxVolumes(newValue string) *VserverInfoType {
 	o.MaxVolumesPtr = &newValue
 	return o
 }
 
+// NameMappingSwitch is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) NameMappingSwitch() []NmswitchType {
 	r := o.NameMappingSwitchPtr
 	return r
 }
 
+// SetNameMappingSwitch is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetNameMappingSwitch(newValue []NmswitchType) *VserverInfoType {
 	newSlice := make([]NmswitchType, len(newValue))
 	copy(newSlice, newValue)
@@ -404,11 +452,13 @@ func (o *VserverInfoType) SetNameMappingSwitch(newValue []NmswitchType) *Vserver
 	return o
 }
 
+// NameServerSwitch is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) NameServerSwitch() []NsswitchType {
 	r := o.NameServerSwitchPtr
 	return r
 }
 
+// SetNameServerSwitch is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetNameServerSwitch(newValue []NsswitchType) *VserverInfoType {
 	newSlice := make([]NsswitchType, len(newValue))
 	copy(newSlice, newValue)
@@ -416,131 +466,157 @@ func (o *VserverInfoType) SetNameServerSwitch(newValue []NsswitchType) *VserverI
 	return o
 }
 
+// NisDomain is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) NisDomain() NisDomainType {
 	r := *o.NisDomainPtr
 	return r
 }
 
+// SetNisDomain is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetNisDomain(newValue NisDomainType) *VserverInfoType {
 	o.NisDomainPtr = &newValue
 	return o
 }
 
+// OperationalState is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) OperationalState() VsoperstateType {
 	r := *o.OperationalStatePtr
 	return r
 }
 
+// SetOperationalState is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetOperationalState(newValue VsoperstateType) *VserverInfoType {
 	o.OperationalStatePtr = &newValue
 	return o
 }
 
+// OperationalStateStoppedReason is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) OperationalStateStoppedReason() VsopstopreasonType {
 	r := *o.OperationalStateStoppedReasonPtr
 	return r
 }
 
+// SetOperationalStateStoppedReason is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetOperationalStateStoppedReason(newValue VsopstopreasonType) *VserverInfoType {
 	o.OperationalStateStoppedReasonPtr = &newValue
 	return o
 }
 
+// QosPolicyGroup is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) QosPolicyGroup() string {
 	r := *o.QosPolicyGroupPtr
 	return r
 }
 
+// SetQosPolicyGroup is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetQosPolicyGroup(newValue string) *VserverInfoType {
 	o.QosPolicyGroupPtr = &newValue
 	return o
 }
 
+// QuotaPolicy is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) QuotaPolicy() string {
 	r := *o.QuotaPolicyPtr
 	return r
 }
 
+// SetQuotaPolicy is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetQuotaPolicy(newValue string) *VserverInfoType {
 	o.QuotaPolicyPtr = &newValue
 	return o
 }
 
+// RootVolume is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) RootVolume() VolumeNameType {
 	r := *o.RootVolumePtr
 	return r
 }
 
+// SetRootVolume is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetRootVolume(newValue VolumeNameType) *VserverInfoType {
 	o.RootVolumePtr = &newValue
 	return o
 }
 
+// RootVolumeAggregate is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) RootVolumeAggregate() AggrNameType {
 	r := *o.RootVolumeAggregatePtr
 	return r
 }
 
+// SetRootVolumeAggregate is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetRootVolumeAggregate(newValue AggrNameType) *VserverInfoType {
 	o.RootVolumeAggregatePtr = &newValue
 	return o
 }
 
+// RootVolumeSecurityStyle is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) RootVolumeSecurityStyle() SecurityStyleEnumType {
 	r := *o.RootVolumeSecurityStylePtr
 	return r
 }
 
+// SetRootVolumeSecurityStyle is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetRootVolumeSecurityStyle(newValue SecurityStyleEnumType) *VserverInfoType {
 	o.RootVolumeSecurityStylePtr = &newValue
 	return o
 }
 
+// SnapshotPolicy is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) SnapshotPolicy() SnapshotPolicyType {
 	r := *o.SnapshotPolicyPtr
 	return r
 }
 
+// SetSnapshotPolicy is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetSnapshotPolicy(newValue SnapshotPolicyType) *VserverInfoType {
 	o.SnapshotPolicyPtr = &newValue
 	return o
 }
 
+// State is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) State() VsadminstateType {
 	r := *o.StatePtr
 	return r
 }
 
+// SetState is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetState(newValue VsadminstateType) *VserverInfoType {
 	o.StatePtr = &newValue
 	return o
 }
 
+// Uuid is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) Uuid() UuidType {
 	r := *o.UuidPtr
 	return r
 }
 
+// SetUuid is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetUuid(newValue UuidType) *VserverInfoType {
 	o.UuidPtr = &newValue
 	return o
 }
 
+// VolumeDeleteRetentionHours is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) VolumeDeleteRetentionHours() int {
 	r := *o.VolumeDeleteRetentionHoursPtr
 	return r
 }
 
+// SetVolumeDeleteRetentionHours is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetVolumeDeleteRetentionHours(newValue int) *VserverInfoType {
 	o.VolumeDeleteRetentionHoursPtr = &newValue
 	return o
 }
 
+// VserverAggrInfoList is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) VserverAggrInfoList() []VserverAggrInfoType {
 	r := o.VserverAggrInfoListPtr
 	return r
 }
 
+// SetVserverAggrInfoList is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetVserverAggrInfoList(newValue []VserverAggrInfoType) *VserverInfoType {
 	newSlice := make([]VserverAggrInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -548,36 +624,43 @@ func (o *VserverInfoType) SetVserverAggrInfoList(newValue []VserverAggrInfoType)
 	return o
 }
 
+// VserverName is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) VserverName() string {
 	r := *o.VserverNamePtr
 	return r
 }
 
+// SetVserverName is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetVserverName(newValue string) *VserverInfoType {
 	o.VserverNamePtr = &newValue
 	return o
 }
 
+// VserverSubtype is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) VserverSubtype() string {
 	r := *o.VserverSubtypePtr
 	return r
 }
 
+// SetVserverSubtype is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetVserverSubtype(newValue string) *VserverInfoType {
 	o.VserverSubtypePtr = &newValue
 	return o
 }
 
+// VserverType is a fluent style 'getter' method that can be chained
 func (o *VserverInfoType) VserverType() string {
 	r := *o.VserverTypePtr
 	return r
 }
 
+// SetVserverType is a fluent style 'setter' method that can be chained
 func (o *VserverInfoType) SetVserverType(newValue string) *VserverInfoType {
 	o.VserverTypePtr = &newValue
 	return o
 }
 
+// VolumeModifyIterInfoType is a structure to represent a volume-modify-iter-info ZAPI object
 type VolumeModifyIterInfoType struct {
 	XMLName xml.Name `xml:"volume-modify-iter-info"`
 
@@ -586,6 +669,7 @@ type VolumeModifyIterInfoType struct {
 	VolumeKeyPtr    *VolumeAttributesType `xml:"volume-key"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeModifyIterInfoType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -594,8 +678,10 @@ func (o *VolumeModifyIterInfoType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeModifyIterInfoType is a factory method for creating new instances of VolumeModifyIterInfoType objects
 func NewVolumeModifyIterInfoType() *VolumeModifyIterInfoType { return &VolumeModifyIterInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeModifyIterInfoType) String() string {
 	var buffer bytes.Buffer
 	if o.ErrorCodePtr != nil {
@@ -616,36 +702,43 @@ func (o VolumeModifyIterInfoType) String() string {
 	return buffer.String()
 }
 
+// ErrorCode is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterInfoType) ErrorCode() int {
 	r := *o.ErrorCodePtr
 	return r
 }
 
+// SetErrorCode is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterInfoType) SetErrorCode(newValue int) *VolumeModifyIterInfoType {
 	o.ErrorCodePtr = &newValue
 	return o
 }
 
+// ErrorMessage is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterInfoType) ErrorMessage() string {
 	r := *o.ErrorMessagePtr
 	return r
 }
 
+// SetErrorMessage is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterInfoType) SetErrorMessage(newValue string) *VolumeModifyIterInfoType {
 	o.ErrorMessagePtr = &newValue
 	return o
 }
 
+// VolumeKey is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterInfoType) VolumeKey() VolumeAttributesType {
 	r := *o.VolumeKeyPtr
 	return r
 }
 
+// SetVolumeKey is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterInfoType) SetVolumeKey(newValue VolumeAttributesType) *VolumeModifyIterInfoType {
 	o.VolumeKeyPtr = &newValue
 	return o
 }
 
+// VolumeVmAlignAttributesType is a structure to represent a volume-vm-align-attributes ZAPI object
 type VolumeVmAlignAttributesType struct {
 	XMLName xml.Name `xml:"volume-vm-align-attributes"`
 
@@ -653,6 +746,7 @@ type VolumeVmAlignAttributesType struct {
 	VmAlignSuffixPtr *string `xml:"vm-align-suffix"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeVmAlignAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -661,10 +755,12 @@ func (o *VolumeVmAlignAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeVmAlignAttributesType is a factory method for creating new instances of VolumeVmAlignAttributesType objects
 func NewVolumeVmAlignAttributesType() *VolumeVmAlignAttributesType {
 	return &VolumeVmAlignAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeVmAlignAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.VmAlignSectorPtr != nil {
@@ -680,26 +776,31 @@ func (o VolumeVmAlignAttributesType) String() string {
 	return buffer.String()
 }
 
+// VmAlignSector is a fluent style 'getter' method that can be chained
 func (o *VolumeVmAlignAttributesType) VmAlignSector() int {
 	r := *o.VmAlignSectorPtr
 	return r
 }
 
+// SetVmAlignSector is a fluent style 'setter' method that can be chained
 func (o *VolumeVmAlignAttributesType) SetVmAlignSector(newValue int) *VolumeVmAlignAttributesType {
 	o.VmAlignSectorPtr = &newValue
 	return o
 }
 
+// VmAlignSuffix is a fluent style 'getter' method that can be chained
 func (o *VolumeVmAlignAttributesType) VmAlignSuffix() string {
 	r := *o.VmAlignSuffixPtr
 	return r
 }
 
+// SetVmAlignSuffix is a fluent style 'setter' method that can be chained
 func (o *VolumeVmAlignAttributesType) SetVmAlignSuffix(newValue string) *VolumeVmAlignAttributesType {
 	o.VmAlignSuffixPtr = &newValue
 	return o
 }
 
+// VolumeTransitionAttributesType is a structure to represent a volume-transition-attributes ZAPI object
 type VolumeTransitionAttributesType struct {
 	XMLName xml.Name `xml:"volume-transition-attributes"`
 
@@ -708,6 +809,7 @@ type VolumeTransitionAttributesType struct {
 	TransitionBehaviorPtr    *string `xml:"transition-behavior"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeTransitionAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -716,10 +818,12 @@ func (o *VolumeTransitionAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeTransitionAttributesType is a factory method for creating new instances of VolumeTransitionAttributesType objects
 func NewVolumeTransitionAttributesType() *VolumeTransitionAttributesType {
 	return &VolumeTransitionAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeTransitionAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.IsCopiedForTransitionPtr != nil {
@@ -740,36 +844,43 @@ func (o VolumeTransitionAttributesType) String() string {
 	return buffer.String()
 }
 
+// IsCopiedForTransition is a fluent style 'getter' method that can be chained
 func (o *VolumeTransitionAttributesType) IsCopiedForTransition() bool {
 	r := *o.IsCopiedForTransitionPtr
 	return r
 }
 
+// SetIsCopiedForTransition is a fluent style 'setter' method that can be chained
 func (o *VolumeTransitionAttributesType) SetIsCopiedForTransition(newValue bool) *VolumeTransitionAttributesType {
 	o.IsCopiedForTransitionPtr = &newValue
 	return o
 }
 
+// IsTransitioned is a fluent style 'getter' method that can be chained
 func (o *VolumeTransitionAttributesType) IsTransitioned() bool {
 	r := *o.IsTransitionedPtr
 	return r
 }
 
+// SetIsTransitioned is a fluent style 'setter' method that can be chained
 func (o *VolumeTransitionAttributesType) SetIsTransitioned(newValue bool) *VolumeTransitionAttributesType {
 	o.IsTransitionedPtr = &newValue
 	return o
 }
 
+// TransitionBehavior is a fluent style 'getter' method that can be chained
 func (o *VolumeTransitionAttributesType) TransitionBehavior() string {
 	r := *o.TransitionBehaviorPtr
 	return r
 }
 
+// SetTransitionBehavior is a fluent style 'setter' method that can be chained
 func (o *VolumeTransitionAttributesType) SetTransitionBehavior(newValue string) *VolumeTransitionAttributesType {
 	o.TransitionBehaviorPtr = &newValue
 	return o
 }
 
+// VolumeStateAttributesType is a structure to represent a volume-state-attributes ZAPI object
 type VolumeStateAttributesType struct {
 	XMLName xml.Name `xml:"volume-state-attributes"`
 
@@ -793,6 +904,7 @@ type VolumeStateAttributesType struct {
 	StatePtr                     *string `xml:"state"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeStateAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -801,8 +913,10 @@ func (o *VolumeStateAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeStateAttributesType is a factory method for creating new instances of VolumeStateAttributesType objects
 func NewVolumeStateAttributesType() *VolumeStateAttributesType { return &VolumeStateAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeStateAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.BecomeNodeRootAfterRebootPtr != nil {
@@ -898,186 +1012,223 @@ func (o VolumeStateAttributesType) String() string {
 	return buffer.String()
 }
 
+// BecomeNodeRootAfterReboot is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) BecomeNodeRootAfterReboot() bool {
 	r := *o.BecomeNodeRootAfterRebootPtr
 	return r
 }
 
+// SetBecomeNodeRootAfterReboot is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetBecomeNodeRootAfterReboot(newValue bool) *VolumeStateAttributesType {
 	o.BecomeNodeRootAfterRebootPtr = &newValue
 	return o
 }
 
+// ForceNvfailOnDr is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) ForceNvfailOnDr() bool {
 	r := *o.ForceNvfailOnDrPtr
 	return r
 }
 
+// SetForceNvfailOnDr is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetForceNvfailOnDr(newValue bool) *VolumeStateAttributesType {
 	o.ForceNvfailOnDrPtr = &newValue
 	return o
 }
 
+// IgnoreInconsistent is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IgnoreInconsistent() bool {
 	r := *o.IgnoreInconsistentPtr
 	return r
 }
 
+// SetIgnoreInconsistent is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIgnoreInconsistent(newValue bool) *VolumeStateAttributesType {
 	o.IgnoreInconsistentPtr = &newValue
 	return o
 }
 
+// InNvfailedState is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) InNvfailedState() bool {
 	r := *o.InNvfailedStatePtr
 	return r
 }
 
+// SetInNvfailedState is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetInNvfailedState(newValue bool) *VolumeStateAttributesType {
 	o.InNvfailedStatePtr = &newValue
 	return o
 }
 
+// IsClusterVolume is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsClusterVolume() bool {
 	r := *o.IsClusterVolumePtr
 	return r
 }
 
+// SetIsClusterVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsClusterVolume(newValue bool) *VolumeStateAttributesType {
 	o.IsClusterVolumePtr = &newValue
 	return o
 }
 
+// IsConstituent is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsConstituent() bool {
 	r := *o.IsConstituentPtr
 	return r
 }
 
+// SetIsConstituent is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsConstituent(newValue bool) *VolumeStateAttributesType {
 	o.IsConstituentPtr = &newValue
 	return o
 }
 
+// IsInconsistent is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsInconsistent() bool {
 	r := *o.IsInconsistentPtr
 	return r
 }
 
+// SetIsInconsistent is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsInconsistent(newValue bool) *VolumeStateAttributesType {
 	o.IsInconsistentPtr = &newValue
 	return o
 }
 
+// IsInvalid is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsInvalid() bool {
 	r := *o.IsInvalidPtr
 	return r
 }
 
+// SetIsInvalid is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsInvalid(newValue bool) *VolumeStateAttributesType {
 	o.IsInvalidPtr = &newValue
 	return o
 }
 
+// IsJunctionActive is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsJunctionActive() bool {
 	r := *o.IsJunctionActivePtr
 	return r
 }
 
+// SetIsJunctionActive is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsJunctionActive(newValue bool) *VolumeStateAttributesType {
 	o.IsJunctionActivePtr = &newValue
 	return o
 }
 
+// IsMoving is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsMoving() bool {
 	r := *o.IsMovingPtr
 	return r
 }
 
+// SetIsMoving is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsMoving(newValue bool) *VolumeStateAttributesType {
 	o.IsMovingPtr = &newValue
 	return o
 }
 
+// IsNodeRoot is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsNodeRoot() bool {
 	r := *o.IsNodeRootPtr
 	return r
 }
 
+// SetIsNodeRoot is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsNodeRoot(newValue bool) *VolumeStateAttributesType {
 	o.IsNodeRootPtr = &newValue
 	return o
 }
 
+// IsNvfailEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsNvfailEnabled() bool {
 	r := *o.IsNvfailEnabledPtr
 	return r
 }
 
+// SetIsNvfailEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsNvfailEnabled(newValue bool) *VolumeStateAttributesType {
 	o.IsNvfailEnabledPtr = &newValue
 	return o
 }
 
+// IsQuiescedInMemory is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsQuiescedInMemory() bool {
 	r := *o.IsQuiescedInMemoryPtr
 	return r
 }
 
+// SetIsQuiescedInMemory is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsQuiescedInMemory(newValue bool) *VolumeStateAttributesType {
 	o.IsQuiescedInMemoryPtr = &newValue
 	return o
 }
 
+// IsQuiescedOnDisk is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsQuiescedOnDisk() bool {
 	r := *o.IsQuiescedOnDiskPtr
 	return r
 }
 
+// SetIsQuiescedOnDisk is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsQuiescedOnDisk(newValue bool) *VolumeStateAttributesType {
 	o.IsQuiescedOnDiskPtr = &newValue
 	return o
 }
 
+// IsUnrecoverable is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsUnrecoverable() bool {
 	r := *o.IsUnrecoverablePtr
 	return r
 }
 
+// SetIsUnrecoverable is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsUnrecoverable(newValue bool) *VolumeStateAttributesType {
 	o.IsUnrecoverablePtr = &newValue
 	return o
 }
 
+// IsVolumeInCutover is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsVolumeInCutover() bool {
 	r := *o.IsVolumeInCutoverPtr
 	return r
 }
 
+// SetIsVolumeInCutover is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsVolumeInCutover(newValue bool) *VolumeStateAttributesType {
 	o.IsVolumeInCutoverPtr = &newValue
 	return o
 }
 
+// IsVserverRoot is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) IsVserverRoot() bool {
 	r := *o.IsVserverRootPtr
 	return r
 }
 
+// SetIsVserverRoot is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetIsVserverRoot(newValue bool) *VolumeStateAttributesType {
 	o.IsVserverRootPtr = &newValue
 	return o
 }
 
+// State is a fluent style 'getter' method that can be chained
 func (o *VolumeStateAttributesType) State() string {
 	r := *o.StatePtr
 	return r
 }
 
+// SetState is a fluent style 'setter' method that can be chained
 func (o *VolumeStateAttributesType) SetState(newValue string) *VolumeStateAttributesType {
 	o.StatePtr = &newValue
 	return o
 }
 
+// VolumeSpaceAttributesType is a structure to represent a volume-space-attributes ZAPI object
 type VolumeSpaceAttributesType struct {
 	XMLName xml.Name `xml:"volume-space-attributes"`
 
@@ -1107,6 +1258,7 @@ type VolumeSpaceAttributesType struct {
 	SpaceNearlyFullThresholdPercentPtr *int    `xml:"space-nearly-full-threshold-percent"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSpaceAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -1115,8 +1267,10 @@ func (o *VolumeSpaceAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSpaceAttributesType is a factory method for creating new instances of VolumeSpaceAttributesType objects
 func NewVolumeSpaceAttributesType() *VolumeSpaceAttributesType { return &VolumeSpaceAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSpaceAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.FilesystemSizePtr != nil {
@@ -1242,246 +1396,295 @@ func (o VolumeSpaceAttributesType) String() string {
 	return buffer.String()
 }
 
+// FilesystemSize is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) FilesystemSize() int {
 	r := *o.FilesystemSizePtr
 	return r
 }
 
+// SetFilesystemSize is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetFilesystemSize(newValue int) *VolumeSpaceAttributesType {
 	o.FilesystemSizePtr = &newValue
 	return o
 }
 
+// IsFilesysSizeFixed is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) IsFilesysSizeFixed() bool {
 	r := *o.IsFilesysSizeFixedPtr
 	return r
 }
 
+// SetIsFilesysSizeFixed is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetIsFilesysSizeFixed(newValue bool) *VolumeSpaceAttributesType {
 	o.IsFilesysSizeFixedPtr = &newValue
 	return o
 }
 
+// IsSpaceGuaranteeEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) IsSpaceGuaranteeEnabled() bool {
 	r := *o.IsSpaceGuaranteeEnabledPtr
 	return r
 }
 
+// SetIsSpaceGuaranteeEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetIsSpaceGuaranteeEnabled(newValue bool) *VolumeSpaceAttributesType {
 	o.IsSpaceGuaranteeEnabledPtr = &newValue
 	return o
 }
 
+// OverwriteReserve is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) OverwriteReserve() int {
 	r := *o.OverwriteReservePtr
 	return r
 }
 
+// SetOverwriteReserve is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetOverwriteReserve(newValue int) *VolumeSpaceAttributesType {
 	o.OverwriteReservePtr = &newValue
 	return o
 }
 
+// OverwriteReserveRequired is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) OverwriteReserveRequired() int {
 	r := *o.OverwriteReserveRequiredPtr
 	return r
 }
 
+// SetOverwriteReserveRequired is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetOverwriteReserveRequired(newValue int) *VolumeSpaceAttributesType {
 	o.OverwriteReserveRequiredPtr = &newValue
 	return o
 }
 
+// OverwriteReserveUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) OverwriteReserveUsed() int {
 	r := *o.OverwriteReserveUsedPtr
 	return r
 }
 
+// SetOverwriteReserveUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetOverwriteReserveUsed(newValue int) *VolumeSpaceAttributesType {
 	o.OverwriteReserveUsedPtr = &newValue
 	return o
 }
 
+// OverwriteReserveUsedActual is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) OverwriteReserveUsedActual() int {
 	r := *o.OverwriteReserveUsedActualPtr
 	return r
 }
 
+// SetOverwriteReserveUsedActual is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetOverwriteReserveUsedActual(newValue int) *VolumeSpaceAttributesType {
 	o.OverwriteReserveUsedActualPtr = &newValue
 	return o
 }
 
+// PercentageFractionalReserve is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) PercentageFractionalReserve() int {
 	r := *o.PercentageFractionalReservePtr
 	return r
 }
 
+// SetPercentageFractionalReserve is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetPercentageFractionalReserve(newValue int) *VolumeSpaceAttributesType {
 	o.PercentageFractionalReservePtr = &newValue
 	return o
 }
 
+// PercentageSizeUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) PercentageSizeUsed() int {
 	r := *o.PercentageSizeUsedPtr
 	return r
 }
 
+// SetPercentageSizeUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetPercentageSizeUsed(newValue int) *VolumeSpaceAttributesType {
 	o.PercentageSizeUsedPtr = &newValue
 	return o
 }
 
+// PercentageSnapshotReserve is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) PercentageSnapshotReserve() int {
 	r := *o.PercentageSnapshotReservePtr
 	return r
 }
 
+// SetPercentageSnapshotReserve is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetPercentageSnapshotReserve(newValue int) *VolumeSpaceAttributesType {
 	o.PercentageSnapshotReservePtr = &newValue
 	return o
 }
 
+// PercentageSnapshotReserveUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) PercentageSnapshotReserveUsed() int {
 	r := *o.PercentageSnapshotReserveUsedPtr
 	return r
 }
 
+// SetPercentageSnapshotReserveUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetPercentageSnapshotReserveUsed(newValue int) *VolumeSpaceAttributesType {
 	o.PercentageSnapshotReserveUsedPtr = &newValue
 	return o
 }
 
+// PhysicalUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) PhysicalUsed() int {
 	r := *o.PhysicalUsedPtr
 	return r
 }
 
+// SetPhysicalUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetPhysicalUsed(newValue int) *VolumeSpaceAttributesType {
 	o.PhysicalUsedPtr = &newValue
 	return o
 }
 
+// PhysicalUsedPercent is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) PhysicalUsedPercent() int {
 	r := *o.PhysicalUsedPercentPtr
 	return r
 }
 
+// SetPhysicalUsedPercent is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetPhysicalUsedPercent(newValue int) *VolumeSpaceAttributesType {
 	o.PhysicalUsedPercentPtr = &newValue
 	return o
 }
 
+// Size is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) Size() int {
 	r := *o.SizePtr
 	return r
 }
 
+// SetSize is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSize(newValue int) *VolumeSpaceAttributesType {
 	o.SizePtr = &newValue
 	return o
 }
 
+// SizeAvailable is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SizeAvailable() int {
 	r := *o.SizeAvailablePtr
 	return r
 }
 
+// SetSizeAvailable is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSizeAvailable(newValue int) *VolumeSpaceAttributesType {
 	o.SizeAvailablePtr = &newValue
 	return o
 }
 
+// SizeAvailableForSnapshots is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SizeAvailableForSnapshots() int {
 	r := *o.SizeAvailableForSnapshotsPtr
 	return r
 }
 
+// SetSizeAvailableForSnapshots is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSizeAvailableForSnapshots(newValue int) *VolumeSpaceAttributesType {
 	o.SizeAvailableForSnapshotsPtr = &newValue
 	return o
 }
 
+// SizeTotal is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SizeTotal() int {
 	r := *o.SizeTotalPtr
 	return r
 }
 
+// SetSizeTotal is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSizeTotal(newValue int) *VolumeSpaceAttributesType {
 	o.SizeTotalPtr = &newValue
 	return o
 }
 
+// SizeUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SizeUsed() int {
 	r := *o.SizeUsedPtr
 	return r
 }
 
+// SetSizeUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSizeUsed(newValue int) *VolumeSpaceAttributesType {
 	o.SizeUsedPtr = &newValue
 	return o
 }
 
+// SizeUsedBySnapshots is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SizeUsedBySnapshots() int {
 	r := *o.SizeUsedBySnapshotsPtr
 	return r
 }
 
+// SetSizeUsedBySnapshots is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSizeUsedBySnapshots(newValue int) *VolumeSpaceAttributesType {
 	o.SizeUsedBySnapshotsPtr = &newValue
 	return o
 }
 
+// SnapshotReserveSize is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SnapshotReserveSize() int {
 	r := *o.SnapshotReserveSizePtr
 	return r
 }
 
+// SetSnapshotReserveSize is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSnapshotReserveSize(newValue int) *VolumeSpaceAttributesType {
 	o.SnapshotReserveSizePtr = &newValue
 	return o
 }
 
+// SpaceFullThresholdPercent is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SpaceFullThresholdPercent() int {
 	r := *o.SpaceFullThresholdPercentPtr
 	return r
 }
 
+// SetSpaceFullThresholdPercent is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSpaceFullThresholdPercent(newValue int) *VolumeSpaceAttributesType {
 	o.SpaceFullThresholdPercentPtr = &newValue
 	return o
 }
 
+// SpaceGuarantee is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SpaceGuarantee() string {
 	r := *o.SpaceGuaranteePtr
 	return r
 }
 
+// SetSpaceGuarantee is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSpaceGuarantee(newValue string) *VolumeSpaceAttributesType {
 	o.SpaceGuaranteePtr = &newValue
 	return o
 }
 
+// SpaceMgmtOptionTryFirst is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SpaceMgmtOptionTryFirst() string {
 	r := *o.SpaceMgmtOptionTryFirstPtr
 	return r
 }
 
+// SetSpaceMgmtOptionTryFirst is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSpaceMgmtOptionTryFirst(newValue string) *VolumeSpaceAttributesType {
 	o.SpaceMgmtOptionTryFirstPtr = &newValue
 	return o
 }
 
+// SpaceNearlyFullThresholdPercent is a fluent style 'getter' method that can be chained
 func (o *VolumeSpaceAttributesType) SpaceNearlyFullThresholdPercent() int {
 	r := *o.SpaceNearlyFullThresholdPercentPtr
 	return r
 }
 
+// SetSpaceNearlyFullThresholdPercent is a fluent style 'setter' method that can be chained
 func (o *VolumeSpaceAttributesType) SetSpaceNearlyFullThresholdPercent(newValue int) *VolumeSpaceAttributesType {
 	o.SpaceNearlyFullThresholdPercentPtr = &newValue
 	return o
 }
 
+// VolumeSnapshotAutodeleteAttributesType is a structure to represent a volume-snapshot-autodelete-attributes ZAPI object
 type VolumeSnapshotAutodeleteAttributesType struct {
 	XMLName xml.Name `xml:"volume-snapshot-autodelete-attributes"`
 
@@ -1495,6 +1698,7 @@ type VolumeSnapshotAutodeleteAttributesType struct {
 	TriggerPtr             *string `xml:"trigger"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSnapshotAutodeleteAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -1503,10 +1707,12 @@ func (o *VolumeSnapshotAutodeleteAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSnapshotAutodeleteAttributesType is a factory method for creating new instances of VolumeSnapshotAutodeleteAttributesType objects
 func NewVolumeSnapshotAutodeleteAttributesType() *VolumeSnapshotAutodeleteAttributesType {
 	return &VolumeSnapshotAutodeleteAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSnapshotAutodeleteAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.CommitmentPtr != nil {
@@ -1552,86 +1758,103 @@ func (o VolumeSnapshotAutodeleteAttributesType) String() string {
 	return buffer.String()
 }
 
+// Commitment is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) Commitment() string {
 	r := *o.CommitmentPtr
 	return r
 }
 
+// SetCommitment is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetCommitment(newValue string) *VolumeSnapshotAutodeleteAttributesType {
 	o.CommitmentPtr = &newValue
 	return o
 }
 
+// DeferDelete is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) DeferDelete() string {
 	r := *o.DeferDeletePtr
 	return r
 }
 
+// SetDeferDelete is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetDeferDelete(newValue string) *VolumeSnapshotAutodeleteAttributesType {
 	o.DeferDeletePtr = &newValue
 	return o
 }
 
+// DeleteOrder is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) DeleteOrder() string {
 	r := *o.DeleteOrderPtr
 	return r
 }
 
+// SetDeleteOrder is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetDeleteOrder(newValue string) *VolumeSnapshotAutodeleteAttributesType {
 	o.DeleteOrderPtr = &newValue
 	return o
 }
 
+// DestroyList is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) DestroyList() string {
 	r := *o.DestroyListPtr
 	return r
 }
 
+// SetDestroyList is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetDestroyList(newValue string) *VolumeSnapshotAutodeleteAttributesType {
 	o.DestroyListPtr = &newValue
 	return o
 }
 
+// IsAutodeleteEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) IsAutodeleteEnabled() bool {
 	r := *o.IsAutodeleteEnabledPtr
 	return r
 }
 
+// SetIsAutodeleteEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetIsAutodeleteEnabled(newValue bool) *VolumeSnapshotAutodeleteAttributesType {
 	o.IsAutodeleteEnabledPtr = &newValue
 	return o
 }
 
+// Prefix is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) Prefix() string {
 	r := *o.PrefixPtr
 	return r
 }
 
+// SetPrefix is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetPrefix(newValue string) *VolumeSnapshotAutodeleteAttributesType {
 	o.PrefixPtr = &newValue
 	return o
 }
 
+// TargetFreeSpace is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) TargetFreeSpace() int {
 	r := *o.TargetFreeSpacePtr
 	return r
 }
 
+// SetTargetFreeSpace is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetTargetFreeSpace(newValue int) *VolumeSnapshotAutodeleteAttributesType {
 	o.TargetFreeSpacePtr = &newValue
 	return o
 }
 
+// Trigger is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) Trigger() string {
 	r := *o.TriggerPtr
 	return r
 }
 
+// SetTrigger is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAutodeleteAttributesType) SetTrigger(newValue string) *VolumeSnapshotAutodeleteAttributesType {
 	o.TriggerPtr = &newValue
 	return o
 }
 
+// VolumeSnapshotAttributesType is a structure to represent a volume-snapshot-attributes ZAPI object
 type VolumeSnapshotAttributesType struct {
 	XMLName xml.Name `xml:"volume-snapshot-attributes"`
 
@@ -1642,6 +1865,7 @@ type VolumeSnapshotAttributesType struct {
 	SnapshotPolicyPtr                 *string `xml:"snapshot-policy"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSnapshotAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -1650,10 +1874,12 @@ func (o *VolumeSnapshotAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSnapshotAttributesType is a factory method for creating new instances of VolumeSnapshotAttributesType objects
 func NewVolumeSnapshotAttributesType() *VolumeSnapshotAttributesType {
 	return &VolumeSnapshotAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSnapshotAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.AutoSnapshotsEnabledPtr != nil {
@@ -1684,56 +1910,67 @@ func (o VolumeSnapshotAttributesType) String() string {
 	return buffer.String()
 }
 
+// AutoSnapshotsEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAttributesType) AutoSnapshotsEnabled() bool {
 	r := *o.AutoSnapshotsEnabledPtr
 	return r
 }
 
+// SetAutoSnapshotsEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SetAutoSnapshotsEnabled(newValue bool) *VolumeSnapshotAttributesType {
 	o.AutoSnapshotsEnabledPtr = &newValue
 	return o
 }
 
+// SnapdirAccessEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SnapdirAccessEnabled() bool {
 	r := *o.SnapdirAccessEnabledPtr
 	return r
 }
 
+// SetSnapdirAccessEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SetSnapdirAccessEnabled(newValue bool) *VolumeSnapshotAttributesType {
 	o.SnapdirAccessEnabledPtr = &newValue
 	return o
 }
 
+// SnapshotCloneDependencyEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SnapshotCloneDependencyEnabled() bool {
 	r := *o.SnapshotCloneDependencyEnabledPtr
 	return r
 }
 
+// SetSnapshotCloneDependencyEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SetSnapshotCloneDependencyEnabled(newValue bool) *VolumeSnapshotAttributesType {
 	o.SnapshotCloneDependencyEnabledPtr = &newValue
 	return o
 }
 
+// SnapshotCount is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SnapshotCount() int {
 	r := *o.SnapshotCountPtr
 	return r
 }
 
+// SetSnapshotCount is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SetSnapshotCount(newValue int) *VolumeSnapshotAttributesType {
 	o.SnapshotCountPtr = &newValue
 	return o
 }
 
+// SnapshotPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SnapshotPolicy() string {
 	r := *o.SnapshotPolicyPtr
 	return r
 }
 
+// SetSnapshotPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeSnapshotAttributesType) SetSnapshotPolicy(newValue string) *VolumeSnapshotAttributesType {
 	o.SnapshotPolicyPtr = &newValue
 	return o
 }
 
+// VolumeSisAttributesType is a structure to represent a volume-sis-attributes ZAPI object
 type VolumeSisAttributesType struct {
 	XMLName xml.Name `xml:"volume-sis-attributes"`
 
@@ -1748,6 +1985,7 @@ type VolumeSisAttributesType struct {
 	TotalSpaceSavedPtr                   *int      `xml:"total-space-saved"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSisAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -1756,8 +1994,10 @@ func (o *VolumeSisAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSisAttributesType is a factory method for creating new instances of VolumeSisAttributesType objects
 func NewVolumeSisAttributesType() *VolumeSisAttributesType { return &VolumeSisAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSisAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.CompressionSpaceSavedPtr != nil {
@@ -1808,96 +2048,115 @@ func (o VolumeSisAttributesType) String() string {
 	return buffer.String()
 }
 
+// CompressionSpaceSaved is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) CompressionSpaceSaved() int {
 	r := *o.CompressionSpaceSavedPtr
 	return r
 }
 
+// SetCompressionSpaceSaved is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetCompressionSpaceSaved(newValue int) *VolumeSisAttributesType {
 	o.CompressionSpaceSavedPtr = &newValue
 	return o
 }
 
+// DeduplicationSpaceSaved is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) DeduplicationSpaceSaved() int {
 	r := *o.DeduplicationSpaceSavedPtr
 	return r
 }
 
+// SetDeduplicationSpaceSaved is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetDeduplicationSpaceSaved(newValue int) *VolumeSisAttributesType {
 	o.DeduplicationSpaceSavedPtr = &newValue
 	return o
 }
 
+// DeduplicationSpaceShared is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) DeduplicationSpaceShared() SizeType {
 	r := *o.DeduplicationSpaceSharedPtr
 	return r
 }
 
+// SetDeduplicationSpaceShared is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetDeduplicationSpaceShared(newValue SizeType) *VolumeSisAttributesType {
 	o.DeduplicationSpaceSharedPtr = &newValue
 	return o
 }
 
+// IsSisLoggingEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) IsSisLoggingEnabled() bool {
 	r := *o.IsSisLoggingEnabledPtr
 	return r
 }
 
+// SetIsSisLoggingEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetIsSisLoggingEnabled(newValue bool) *VolumeSisAttributesType {
 	o.IsSisLoggingEnabledPtr = &newValue
 	return o
 }
 
+// IsSisVolume is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) IsSisVolume() bool {
 	r := *o.IsSisVolumePtr
 	return r
 }
 
+// SetIsSisVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetIsSisVolume(newValue bool) *VolumeSisAttributesType {
 	o.IsSisVolumePtr = &newValue
 	return o
 }
 
+// PercentageCompressionSpaceSaved is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) PercentageCompressionSpaceSaved() int {
 	r := *o.PercentageCompressionSpaceSavedPtr
 	return r
 }
 
+// SetPercentageCompressionSpaceSaved is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetPercentageCompressionSpaceSaved(newValue int) *VolumeSisAttributesType {
 	o.PercentageCompressionSpaceSavedPtr = &newValue
 	return o
 }
 
+// PercentageDeduplicationSpaceSaved is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) PercentageDeduplicationSpaceSaved() int {
 	r := *o.PercentageDeduplicationSpaceSavedPtr
 	return r
 }
 
+// SetPercentageDeduplicationSpaceSaved is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetPercentageDeduplicationSpaceSaved(newValue int) *VolumeSisAttributesType {
 	o.PercentageDeduplicationSpaceSavedPtr = &newValue
 	return o
 }
 
+// PercentageTotalSpaceSaved is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) PercentageTotalSpaceSaved() int {
 	r := *o.PercentageTotalSpaceSavedPtr
 	return r
 }
 
+// SetPercentageTotalSpaceSaved is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetPercentageTotalSpaceSaved(newValue int) *VolumeSisAttributesType {
 	o.PercentageTotalSpaceSavedPtr = &newValue
 	return o
 }
 
+// TotalSpaceSaved is a fluent style 'getter' method that can be chained
 func (o *VolumeSisAttributesType) TotalSpaceSaved() int {
 	r := *o.TotalSpaceSavedPtr
 	return r
 }
 
+// SetTotalSpaceSaved is a fluent style 'setter' method that can be chained
 func (o *VolumeSisAttributesType) SetTotalSpaceSaved(newValue int) *VolumeSisAttributesType {
 	o.TotalSpaceSavedPtr = &newValue
 	return o
 }
 
+// VolumeSecurityUnixAttributesType is a structure to represent a volume-security-unix-attributes ZAPI object
 type VolumeSecurityUnixAttributesType struct {
 	XMLName xml.Name `xml:"volume-security-unix-attributes"`
 
@@ -1906,6 +2165,7 @@ type VolumeSecurityUnixAttributesType struct {
 	UserIdPtr      *int    `xml:"user-id"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSecurityUnixAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -1914,10 +2174,12 @@ func (o *VolumeSecurityUnixAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSecurityUnixAttributesType is a factory method for creating new instances of VolumeSecurityUnixAttributesType objects
 func NewVolumeSecurityUnixAttributesType() *VolumeSecurityUnixAttributesType {
 	return &VolumeSecurityUnixAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSecurityUnixAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.GroupIdPtr != nil {
@@ -1938,36 +2200,43 @@ func (o VolumeSecurityUnixAttributesType) String() string {
 	return buffer.String()
 }
 
+// GroupId is a fluent style 'getter' method that can be chained
 func (o *VolumeSecurityUnixAttributesType) GroupId() int {
 	r := *o.GroupIdPtr
 	return r
 }
 
+// SetGroupId is a fluent style 'setter' method that can be chained
 func (o *VolumeSecurityUnixAttributesType) SetGroupId(newValue int) *VolumeSecurityUnixAttributesType {
 	o.GroupIdPtr = &newValue
 	return o
 }
 
+// Permissions is a fluent style 'getter' method that can be chained
 func (o *VolumeSecurityUnixAttributesType) Permissions() string {
 	r := *o.PermissionsPtr
 	return r
 }
 
+// SetPermissions is a fluent style 'setter' method that can be chained
 func (o *VolumeSecurityUnixAttributesType) SetPermissions(newValue string) *VolumeSecurityUnixAttributesType {
 	o.PermissionsPtr = &newValue
 	return o
 }
 
+// UserId is a fluent style 'getter' method that can be chained
 func (o *VolumeSecurityUnixAttributesType) UserId() int {
 	r := *o.UserIdPtr
 	return r
 }
 
+// SetUserId is a fluent style 'setter' method that can be chained
 func (o *VolumeSecurityUnixAttributesType) SetUserId(newValue int) *VolumeSecurityUnixAttributesType {
 	o.UserIdPtr = &newValue
 	return o
 }
 
+// VolumeSecurityAttributesType is a structure to represent a volume-security-attributes ZAPI object
 type VolumeSecurityAttributesType struct {
 	XMLName xml.Name `xml:"volume-security-attributes"`
 
@@ -1975,6 +2244,7 @@ type VolumeSecurityAttributesType struct {
 	VolumeSecurityUnixAttributesPtr *VolumeSecurityUnixAttributesType `xml:"volume-security-unix-attributes"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSecurityAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -1983,10 +2253,12 @@ func (o *VolumeSecurityAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSecurityAttributesType is a factory method for creating new instances of VolumeSecurityAttributesType objects
 func NewVolumeSecurityAttributesType() *VolumeSecurityAttributesType {
 	return &VolumeSecurityAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSecurityAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.StylePtr != nil {
@@ -2002,32 +2274,38 @@ func (o VolumeSecurityAttributesType) String() string {
 	return buffer.String()
 }
 
+// Style is a fluent style 'getter' method that can be chained
 func (o *VolumeSecurityAttributesType) Style() string {
 	r := *o.StylePtr
 	return r
 }
 
+// SetStyle is a fluent style 'setter' method that can be chained
 func (o *VolumeSecurityAttributesType) SetStyle(newValue string) *VolumeSecurityAttributesType {
 	o.StylePtr = &newValue
 	return o
 }
 
+// VolumeSecurityUnixAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeSecurityAttributesType) VolumeSecurityUnixAttributes() VolumeSecurityUnixAttributesType {
 	r := *o.VolumeSecurityUnixAttributesPtr
 	return r
 }
 
+// SetVolumeSecurityUnixAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeSecurityAttributesType) SetVolumeSecurityUnixAttributes(newValue VolumeSecurityUnixAttributesType) *VolumeSecurityAttributesType {
 	o.VolumeSecurityUnixAttributesPtr = &newValue
 	return o
 }
 
+// VolumeQosAttributesType is a structure to represent a volume-qos-attributes ZAPI object
 type VolumeQosAttributesType struct {
 	XMLName xml.Name `xml:"volume-qos-attributes"`
 
 	PolicyGroupNamePtr *string `xml:"policy-group-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeQosAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2036,8 +2314,10 @@ func (o *VolumeQosAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeQosAttributesType is a factory method for creating new instances of VolumeQosAttributesType objects
 func NewVolumeQosAttributesType() *VolumeQosAttributesType { return &VolumeQosAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeQosAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.PolicyGroupNamePtr != nil {
@@ -2048,16 +2328,19 @@ func (o VolumeQosAttributesType) String() string {
 	return buffer.String()
 }
 
+// PolicyGroupName is a fluent style 'getter' method that can be chained
 func (o *VolumeQosAttributesType) PolicyGroupName() string {
 	r := *o.PolicyGroupNamePtr
 	return r
 }
 
+// SetPolicyGroupName is a fluent style 'setter' method that can be chained
 func (o *VolumeQosAttributesType) SetPolicyGroupName(newValue string) *VolumeQosAttributesType {
 	o.PolicyGroupNamePtr = &newValue
 	return o
 }
 
+// VolumePerformanceAttributesType is a structure to represent a volume-performance-attributes ZAPI object
 type VolumePerformanceAttributesType struct {
 	XMLName xml.Name `xml:"volume-performance-attributes"`
 
@@ -2069,6 +2352,7 @@ type VolumePerformanceAttributesType struct {
 	ReadReallocPtr          *string `xml:"read-realloc"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumePerformanceAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2077,10 +2361,12 @@ func (o *VolumePerformanceAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumePerformanceAttributesType is a factory method for creating new instances of VolumePerformanceAttributesType objects
 func NewVolumePerformanceAttributesType() *VolumePerformanceAttributesType {
 	return &VolumePerformanceAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumePerformanceAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.ExtentEnabledPtr != nil {
@@ -2116,66 +2402,79 @@ func (o VolumePerformanceAttributesType) String() string {
 	return buffer.String()
 }
 
+// ExtentEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumePerformanceAttributesType) ExtentEnabled() string {
 	r := *o.ExtentEnabledPtr
 	return r
 }
 
+// SetExtentEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumePerformanceAttributesType) SetExtentEnabled(newValue string) *VolumePerformanceAttributesType {
 	o.ExtentEnabledPtr = &newValue
 	return o
 }
 
+// FcDelegsEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumePerformanceAttributesType) FcDelegsEnabled() bool {
 	r := *o.FcDelegsEnabledPtr
 	return r
 }
 
+// SetFcDelegsEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumePerformanceAttributesType) SetFcDelegsEnabled(newValue bool) *VolumePerformanceAttributesType {
 	o.FcDelegsEnabledPtr = &newValue
 	return o
 }
 
+// IsAtimeUpdateEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumePerformanceAttributesType) IsAtimeUpdateEnabled() bool {
 	r := *o.IsAtimeUpdateEnabledPtr
 	return r
 }
 
+// SetIsAtimeUpdateEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumePerformanceAttributesType) SetIsAtimeUpdateEnabled(newValue bool) *VolumePerformanceAttributesType {
 	o.IsAtimeUpdateEnabledPtr = &newValue
 	return o
 }
 
+// MaxWriteAllocBlocks is a fluent style 'getter' method that can be chained
 func (o *VolumePerformanceAttributesType) MaxWriteAllocBlocks() int {
 	r := *o.MaxWriteAllocBlocksPtr
 	return r
 }
 
+// SetMaxWriteAllocBlocks is a fluent style 'setter' method that can be chained
 func (o *VolumePerformanceAttributesType) SetMaxWriteAllocBlocks(newValue int) *VolumePerformanceAttributesType {
 	o.MaxWriteAllocBlocksPtr = &newValue
 	return o
 }
 
+// MinimalReadAhead is a fluent style 'getter' method that can be chained
 func (o *VolumePerformanceAttributesType) MinimalReadAhead() bool {
 	r := *o.MinimalReadAheadPtr
 	return r
 }
 
+// SetMinimalReadAhead is a fluent style 'setter' method that can be chained
 func (o *VolumePerformanceAttributesType) SetMinimalReadAhead(newValue bool) *VolumePerformanceAttributesType {
 	o.MinimalReadAheadPtr = &newValue
 	return o
 }
 
+// ReadRealloc is a fluent style 'getter' method that can be chained
 func (o *VolumePerformanceAttributesType) ReadRealloc() string {
 	r := *o.ReadReallocPtr
 	return r
 }
 
+// SetReadRealloc is a fluent style 'setter' method that can be chained
 func (o *VolumePerformanceAttributesType) SetReadRealloc(newValue string) *VolumePerformanceAttributesType {
 	o.ReadReallocPtr = &newValue
 	return o
 }
 
+// VolumeMirrorAttributesType is a structure to represent a volume-mirror-attributes ZAPI object
 type VolumeMirrorAttributesType struct {
 	XMLName xml.Name `xml:"volume-mirror-attributes"`
 
@@ -2187,6 +2486,7 @@ type VolumeMirrorAttributesType struct {
 	RedirectSnapshotIdPtr       *int  `xml:"redirect-snapshot-id"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeMirrorAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2195,8 +2495,12 @@ func (o *VolumeMirrorAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
-func NewVolumeMirrorAttributesType() *VolumeMirrorAttributesType { return &VolumeMirrorAttributesType{} }
+// NewVolumeMirrorAttributesType is a factory method for creating new instances of VolumeMirrorAttributesType objects
+func NewVolumeMirrorAttributesType() *VolumeMirrorAttributesType {
+	return &VolumeMirrorAttributesType{}
+}
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeMirrorAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.IsDataProtectionMirrorPtr != nil {
@@ -2232,68 +2536,82 @@ func (o VolumeMirrorAttributesType) String() string {
 	return buffer.String()
 }
 
+// IsDataProtectionMirror is a fluent style 'getter' method that can be chained
 func (o *VolumeMirrorAttributesType) IsDataProtectionMirror() bool {
 	r := *o.IsDataProtectionMirrorPtr
 	return r
 }
 
+// SetIsDataProtectionMirror is a fluent style 'setter' method that can be chained
 func (o *VolumeMirrorAttributesType) SetIsDataProtectionMirror(newValue bool) *VolumeMirrorAttributesType {
 	o.IsDataProtectionMirrorPtr = &newValue
 	return o
 }
 
+// IsLoadSharingMirror is a fluent style 'getter' method that can be chained
 func (o *VolumeMirrorAttributesType) IsLoadSharingMirror() bool {
 	r := *o.IsLoadSharingMirrorPtr
 	return r
 }
 
+// SetIsLoadSharingMirror is a fluent style 'setter' method that can be chained
 func (o *VolumeMirrorAttributesType) SetIsLoadSharingMirror(newValue bool) *VolumeMirrorAttributesType {
 	o.IsLoadSharingMirrorPtr = &newValue
 	return o
 }
 
+// IsMoveMirror is a fluent style 'getter' method that can be chained
 func (o *VolumeMirrorAttributesType) IsMoveMirror() bool {
 	r := *o.IsMoveMirrorPtr
 	return r
 }
 
+// SetIsMoveMirror is a fluent style 'setter' method that can be chained
 func (o *VolumeMirrorAttributesType) SetIsMoveMirror(newValue bool) *VolumeMirrorAttributesType {
 	o.IsMoveMirrorPtr = &newValue
 	return o
 }
 
+// IsReplicaVolume is a fluent style 'getter' method that can be chained
 func (o *VolumeMirrorAttributesType) IsReplicaVolume() bool {
 	r := *o.IsReplicaVolumePtr
 	return r
 }
 
+// SetIsReplicaVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeMirrorAttributesType) SetIsReplicaVolume(newValue bool) *VolumeMirrorAttributesType {
 	o.IsReplicaVolumePtr = &newValue
 	return o
 }
 
+// MirrorTransferInProgress is a fluent style 'getter' method that can be chained
 func (o *VolumeMirrorAttributesType) MirrorTransferInProgress() bool {
 	r := *o.MirrorTransferInProgressPtr
 	return r
 }
 
+// SetMirrorTransferInProgress is a fluent style 'setter' method that can be chained
 func (o *VolumeMirrorAttributesType) SetMirrorTransferInProgress(newValue bool) *VolumeMirrorAttributesType {
 	o.MirrorTransferInProgressPtr = &newValue
 	return o
 }
 
+// RedirectSnapshotId is a fluent style 'getter' method that can be chained
 func (o *VolumeMirrorAttributesType) RedirectSnapshotId() int {
 	r := *o.RedirectSnapshotIdPtr
 	return r
 }
 
+// SetRedirectSnapshotId is a fluent style 'setter' method that can be chained
 func (o *VolumeMirrorAttributesType) SetRedirectSnapshotId(newValue int) *VolumeMirrorAttributesType {
 	o.RedirectSnapshotIdPtr = &newValue
 	return o
 }
 
+// LanguageCodeType is a ZAPI type represented as a string
 type LanguageCodeType string
 
+// VolumeLanguageAttributesType is a structure to represent a volume-language-attributes ZAPI object
 type VolumeLanguageAttributesType struct {
 	XMLName xml.Name `xml:"volume-language-attributes"`
 
@@ -2305,6 +2623,7 @@ type VolumeLanguageAttributesType struct {
 	OemCharacterSetPtr       *string           `xml:"oem-character-set"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeLanguageAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2313,10 +2632,12 @@ func (o *VolumeLanguageAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeLanguageAttributesType is a factory method for creating new instances of VolumeLanguageAttributesType objects
 func NewVolumeLanguageAttributesType() *VolumeLanguageAttributesType {
 	return &VolumeLanguageAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeLanguageAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.IsConvertUcodeEnabledPtr != nil {
@@ -2352,66 +2673,79 @@ func (o VolumeLanguageAttributesType) String() string {
 	return buffer.String()
 }
 
+// IsConvertUcodeEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeLanguageAttributesType) IsConvertUcodeEnabled() bool {
 	r := *o.IsConvertUcodeEnabledPtr
 	return r
 }
 
+// SetIsConvertUcodeEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeLanguageAttributesType) SetIsConvertUcodeEnabled(newValue bool) *VolumeLanguageAttributesType {
 	o.IsConvertUcodeEnabledPtr = &newValue
 	return o
 }
 
+// IsCreateUcodeEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeLanguageAttributesType) IsCreateUcodeEnabled() bool {
 	r := *o.IsCreateUcodeEnabledPtr
 	return r
 }
 
+// SetIsCreateUcodeEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeLanguageAttributesType) SetIsCreateUcodeEnabled(newValue bool) *VolumeLanguageAttributesType {
 	o.IsCreateUcodeEnabledPtr = &newValue
 	return o
 }
 
+// Language is a fluent style 'getter' method that can be chained
 func (o *VolumeLanguageAttributesType) Language() string {
 	r := *o.LanguagePtr
 	return r
 }
 
+// SetLanguage is a fluent style 'setter' method that can be chained
 func (o *VolumeLanguageAttributesType) SetLanguage(newValue string) *VolumeLanguageAttributesType {
 	o.LanguagePtr = &newValue
 	return o
 }
 
+// LanguageCode is a fluent style 'getter' method that can be chained
 func (o *VolumeLanguageAttributesType) LanguageCode() LanguageCodeType {
 	r := *o.LanguageCodePtr
 	return r
 }
 
+// SetLanguageCode is a fluent style 'setter' method that can be chained
 func (o *VolumeLanguageAttributesType) SetLanguageCode(newValue LanguageCodeType) *VolumeLanguageAttributesType {
 	o.LanguageCodePtr = &newValue
 	return o
 }
 
+// NfsCharacterSet is a fluent style 'getter' method that can be chained
 func (o *VolumeLanguageAttributesType) NfsCharacterSet() string {
 	r := *o.NfsCharacterSetPtr
 	return r
 }
 
+// SetNfsCharacterSet is a fluent style 'setter' method that can be chained
 func (o *VolumeLanguageAttributesType) SetNfsCharacterSet(newValue string) *VolumeLanguageAttributesType {
 	o.NfsCharacterSetPtr = &newValue
 	return o
 }
 
+// OemCharacterSet is a fluent style 'getter' method that can be chained
 func (o *VolumeLanguageAttributesType) OemCharacterSet() string {
 	r := *o.OemCharacterSetPtr
 	return r
 }
 
+// SetOemCharacterSet is a fluent style 'setter' method that can be chained
 func (o *VolumeLanguageAttributesType) SetOemCharacterSet(newValue string) *VolumeLanguageAttributesType {
 	o.OemCharacterSetPtr = &newValue
 	return o
 }
 
+// VolumeInodeAttributesType is a structure to represent a volume-inode-attributes ZAPI object
 type VolumeInodeAttributesType struct {
 	XMLName xml.Name `xml:"volume-inode-attributes"`
 
@@ -2423,6 +2757,7 @@ type VolumeInodeAttributesType struct {
 	InodefilePublicCapacityPtr  *int    `xml:"inodefile-public-capacity"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeInodeAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2431,8 +2766,10 @@ func (o *VolumeInodeAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeInodeAttributesType is a factory method for creating new instances of VolumeInodeAttributesType objects
 func NewVolumeInodeAttributesType() *VolumeInodeAttributesType { return &VolumeInodeAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeInodeAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.BlockTypePtr != nil {
@@ -2468,70 +2805,85 @@ func (o VolumeInodeAttributesType) String() string {
 	return buffer.String()
 }
 
+// BlockType is a fluent style 'getter' method that can be chained
 func (o *VolumeInodeAttributesType) BlockType() string {
 	r := *o.BlockTypePtr
 	return r
 }
 
+// SetBlockType is a fluent style 'setter' method that can be chained
 func (o *VolumeInodeAttributesType) SetBlockType(newValue string) *VolumeInodeAttributesType {
 	o.BlockTypePtr = &newValue
 	return o
 }
 
+// FilesPrivateUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeInodeAttributesType) FilesPrivateUsed() int {
 	r := *o.FilesPrivateUsedPtr
 	return r
 }
 
+// SetFilesPrivateUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeInodeAttributesType) SetFilesPrivateUsed(newValue int) *VolumeInodeAttributesType {
 	o.FilesPrivateUsedPtr = &newValue
 	return o
 }
 
+// FilesTotal is a fluent style 'getter' method that can be chained
 func (o *VolumeInodeAttributesType) FilesTotal() int {
 	r := *o.FilesTotalPtr
 	return r
 }
 
+// SetFilesTotal is a fluent style 'setter' method that can be chained
 func (o *VolumeInodeAttributesType) SetFilesTotal(newValue int) *VolumeInodeAttributesType {
 	o.FilesTotalPtr = &newValue
 	return o
 }
 
+// FilesUsed is a fluent style 'getter' method that can be chained
 func (o *VolumeInodeAttributesType) FilesUsed() int {
 	r := *o.FilesUsedPtr
 	return r
 }
 
+// SetFilesUsed is a fluent style 'setter' method that can be chained
 func (o *VolumeInodeAttributesType) SetFilesUsed(newValue int) *VolumeInodeAttributesType {
 	o.FilesUsedPtr = &newValue
 	return o
 }
 
+// InodefilePrivateCapacity is a fluent style 'getter' method that can be chained
 func (o *VolumeInodeAttributesType) InodefilePrivateCapacity() int {
 	r := *o.InodefilePrivateCapacityPtr
 	return r
 }
 
+// SetInodefilePrivateCapacity is a fluent style 'setter' method that can be chained
 func (o *VolumeInodeAttributesType) SetInodefilePrivateCapacity(newValue int) *VolumeInodeAttributesType {
 	o.InodefilePrivateCapacityPtr = &newValue
 	return o
 }
 
+// InodefilePublicCapacity is a fluent style 'getter' method that can be chained
 func (o *VolumeInodeAttributesType) InodefilePublicCapacity() int {
 	r := *o.InodefilePublicCapacityPtr
 	return r
 }
 
+// SetInodefilePublicCapacity is a fluent style 'setter' method that can be chained
 func (o *VolumeInodeAttributesType) SetInodefilePublicCapacity(newValue int) *VolumeInodeAttributesType {
 	o.InodefilePublicCapacityPtr = &newValue
 	return o
 }
 
+// AggrNameType is a ZAPI type represented as a string
 type AggrNameType string
 
+// ReposConstituentRoleType is a ZAPI type represented as a string
 type ReposConstituentRoleType string
 
+// VolumeInfinitevolAttributesType is a structure to represent a volume-infinitevol-attributes ZAPI object
 type VolumeInfinitevolAttributesType struct {
 	XMLName xml.Name `xml:"volume-infinitevol-attributes"`
 
@@ -2544,6 +2896,7 @@ type VolumeInfinitevolAttributesType struct {
 	StorageServicePtr              *string                   `xml:"storage-service"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeInfinitevolAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2552,10 +2905,12 @@ func (o *VolumeInfinitevolAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeInfinitevolAttributesType is a factory method for creating new instances of VolumeInfinitevolAttributesType objects
 func NewVolumeInfinitevolAttributesType() *VolumeInfinitevolAttributesType {
 	return &VolumeInfinitevolAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeInfinitevolAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.ConstituentRolePtr != nil {
@@ -2596,61 +2951,73 @@ func (o VolumeInfinitevolAttributesType) String() string {
 	return buffer.String()
 }
 
+// ConstituentRole is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) ConstituentRole() ReposConstituentRoleType {
 	r := *o.ConstituentRolePtr
 	return r
 }
 
+// SetConstituentRole is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetConstituentRole(newValue ReposConstituentRoleType) *VolumeInfinitevolAttributesType {
 	o.ConstituentRolePtr = &newValue
 	return o
 }
 
+// EnableSnapdiff is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) EnableSnapdiff() bool {
 	r := *o.EnableSnapdiffPtr
 	return r
 }
 
+// SetEnableSnapdiff is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetEnableSnapdiff(newValue bool) *VolumeInfinitevolAttributesType {
 	o.EnableSnapdiffPtr = &newValue
 	return o
 }
 
+// IsManagedByService is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) IsManagedByService() bool {
 	r := *o.IsManagedByServicePtr
 	return r
 }
 
+// SetIsManagedByService is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetIsManagedByService(newValue bool) *VolumeInfinitevolAttributesType {
 	o.IsManagedByServicePtr = &newValue
 	return o
 }
 
+// MaxDataConstituentSize is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) MaxDataConstituentSize() SizeType {
 	r := *o.MaxDataConstituentSizePtr
 	return r
 }
 
+// SetMaxDataConstituentSize is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetMaxDataConstituentSize(newValue SizeType) *VolumeInfinitevolAttributesType {
 	o.MaxDataConstituentSizePtr = &newValue
 	return o
 }
 
+// MaxNamespaceConstituentSize is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) MaxNamespaceConstituentSize() SizeType {
 	r := *o.MaxNamespaceConstituentSizePtr
 	return r
 }
 
+// SetMaxNamespaceConstituentSize is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetMaxNamespaceConstituentSize(newValue SizeType) *VolumeInfinitevolAttributesType {
 	o.MaxNamespaceConstituentSizePtr = &newValue
 	return o
 }
 
+// NamespaceMirrorAggrList is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) NamespaceMirrorAggrList() []AggrNameType {
 	r := o.NamespaceMirrorAggrListPtr
 	return r
 }
 
+// SetNamespaceMirrorAggrList is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetNamespaceMirrorAggrList(newValue []AggrNameType) *VolumeInfinitevolAttributesType {
 	newSlice := make([]AggrNameType, len(newValue))
 	copy(newSlice, newValue)
@@ -2658,18 +3025,22 @@ func (o *VolumeInfinitevolAttributesType) SetNamespaceMirrorAggrList(newValue []
 	return o
 }
 
+// StorageService is a fluent style 'getter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) StorageService() string {
 	r := *o.StorageServicePtr
 	return r
 }
 
+// SetStorageService is a fluent style 'setter' method that can be chained
 func (o *VolumeInfinitevolAttributesType) SetStorageService(newValue string) *VolumeInfinitevolAttributesType {
 	o.StorageServicePtr = &newValue
 	return o
 }
 
+// JunctionPathType is a ZAPI type represented as a string
 type JunctionPathType string
 
+// VolumeIdAttributesType is a structure to represent a volume-id-attributes ZAPI object
 type VolumeIdAttributesType struct {
 	XMLName xml.Name `xml:"volume-id-attributes"`
 
@@ -2694,6 +3065,7 @@ type VolumeIdAttributesType struct {
 	UuidPtr                    *UuidType         `xml:"uuid"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeIdAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -2702,8 +3074,10 @@ func (o *VolumeIdAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeIdAttributesType is a factory method for creating new instances of VolumeIdAttributesType objects
 func NewVolumeIdAttributesType() *VolumeIdAttributesType { return &VolumeIdAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeIdAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.CommentPtr != nil {
@@ -2804,196 +3178,235 @@ func (o VolumeIdAttributesType) String() string {
 	return buffer.String()
 }
 
+// Comment is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Comment() string {
 	r := *o.CommentPtr
 	return r
 }
 
+// SetComment is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetComment(newValue string) *VolumeIdAttributesType {
 	o.CommentPtr = &newValue
 	return o
 }
 
+// ContainingAggregateName is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) ContainingAggregateName() string {
 	r := *o.ContainingAggregateNamePtr
 	return r
 }
 
+// SetContainingAggregateName is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetContainingAggregateName(newValue string) *VolumeIdAttributesType {
 	o.ContainingAggregateNamePtr = &newValue
 	return o
 }
 
+// ContainingAggregateUuid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) ContainingAggregateUuid() UuidType {
 	r := *o.ContainingAggregateUuidPtr
 	return r
 }
 
+// SetContainingAggregateUuid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetContainingAggregateUuid(newValue UuidType) *VolumeIdAttributesType {
 	o.ContainingAggregateUuidPtr = &newValue
 	return o
 }
 
+// CreationTime is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) CreationTime() int {
 	r := *o.CreationTimePtr
 	return r
 }
 
+// SetCreationTime is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetCreationTime(newValue int) *VolumeIdAttributesType {
 	o.CreationTimePtr = &newValue
 	return o
 }
 
+// Dsid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Dsid() int {
 	r := *o.DsidPtr
 	return r
 }
 
+// SetDsid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetDsid(newValue int) *VolumeIdAttributesType {
 	o.DsidPtr = &newValue
 	return o
 }
 
+// Fsid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Fsid() string {
 	r := *o.FsidPtr
 	return r
 }
 
+// SetFsid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetFsid(newValue string) *VolumeIdAttributesType {
 	o.FsidPtr = &newValue
 	return o
 }
 
+// InstanceUuid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) InstanceUuid() UuidType {
 	r := *o.InstanceUuidPtr
 	return r
 }
 
+// SetInstanceUuid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetInstanceUuid(newValue UuidType) *VolumeIdAttributesType {
 	o.InstanceUuidPtr = &newValue
 	return o
 }
 
+// JunctionParentName is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) JunctionParentName() VolumeNameType {
 	r := *o.JunctionParentNamePtr
 	return r
 }
 
+// SetJunctionParentName is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetJunctionParentName(newValue VolumeNameType) *VolumeIdAttributesType {
 	o.JunctionParentNamePtr = &newValue
 	return o
 }
 
+// JunctionPath is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) JunctionPath() JunctionPathType {
 	r := *o.JunctionPathPtr
 	return r
 }
 
+// SetJunctionPath is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetJunctionPath(newValue JunctionPathType) *VolumeIdAttributesType {
 	o.JunctionPathPtr = &newValue
 	return o
 }
 
+// Msid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Msid() int {
 	r := *o.MsidPtr
 	return r
 }
 
+// SetMsid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetMsid(newValue int) *VolumeIdAttributesType {
 	o.MsidPtr = &newValue
 	return o
 }
 
+// Name is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Name() VolumeNameType {
 	r := *o.NamePtr
 	return r
 }
 
+// SetName is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetName(newValue VolumeNameType) *VolumeIdAttributesType {
 	o.NamePtr = &newValue
 	return o
 }
 
+// NameOrdinal is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) NameOrdinal() string {
 	r := *o.NameOrdinalPtr
 	return r
 }
 
+// SetNameOrdinal is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetNameOrdinal(newValue string) *VolumeIdAttributesType {
 	o.NameOrdinalPtr = &newValue
 	return o
 }
 
+// Node is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Node() NodeNameType {
 	r := *o.NodePtr
 	return r
 }
 
+// SetNode is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetNode(newValue NodeNameType) *VolumeIdAttributesType {
 	o.NodePtr = &newValue
 	return o
 }
 
+// OwningVserverName is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) OwningVserverName() string {
 	r := *o.OwningVserverNamePtr
 	return r
 }
 
+// SetOwningVserverName is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetOwningVserverName(newValue string) *VolumeIdAttributesType {
 	o.OwningVserverNamePtr = &newValue
 	return o
 }
 
+// OwningVserverUuid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) OwningVserverUuid() UuidType {
 	r := *o.OwningVserverUuidPtr
 	return r
 }
 
+// SetOwningVserverUuid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetOwningVserverUuid(newValue UuidType) *VolumeIdAttributesType {
 	o.OwningVserverUuidPtr = &newValue
 	return o
 }
 
+// ProvenanceUuid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) ProvenanceUuid() UuidType {
 	r := *o.ProvenanceUuidPtr
 	return r
 }
 
+// SetProvenanceUuid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetProvenanceUuid(newValue UuidType) *VolumeIdAttributesType {
 	o.ProvenanceUuidPtr = &newValue
 	return o
 }
 
+// Style is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Style() string {
 	r := *o.StylePtr
 	return r
 }
 
+// SetStyle is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetStyle(newValue string) *VolumeIdAttributesType {
 	o.StylePtr = &newValue
 	return o
 }
 
+// Type is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Type() string {
 	r := *o.TypePtr
 	return r
 }
 
+// SetType is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetType(newValue string) *VolumeIdAttributesType {
 	o.TypePtr = &newValue
 	return o
 }
 
+// Uuid is a fluent style 'getter' method that can be chained
 func (o *VolumeIdAttributesType) Uuid() UuidType {
 	r := *o.UuidPtr
 	return r
 }
 
+// SetUuid is a fluent style 'setter' method that can be chained
 func (o *VolumeIdAttributesType) SetUuid(newValue UuidType) *VolumeIdAttributesType {
 	o.UuidPtr = &newValue
 	return o
 }
 
+// VolumeHybridCacheAttributesType is a structure to represent a volume-hybrid-cache-attributes ZAPI object
 type VolumeHybridCacheAttributesType struct {
 	XMLName xml.Name `xml:"volume-hybrid-cache-attributes"`
 
@@ -3002,6 +3415,7 @@ type VolumeHybridCacheAttributesType struct {
 	WriteCacheIneligibilityReasonPtr *string `xml:"write-cache-ineligibility-reason"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeHybridCacheAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3010,10 +3424,12 @@ func (o *VolumeHybridCacheAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeHybridCacheAttributesType is a factory method for creating new instances of VolumeHybridCacheAttributesType objects
 func NewVolumeHybridCacheAttributesType() *VolumeHybridCacheAttributesType {
 	return &VolumeHybridCacheAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeHybridCacheAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.CachingPolicyPtr != nil {
@@ -3034,40 +3450,49 @@ func (o VolumeHybridCacheAttributesType) String() string {
 	return buffer.String()
 }
 
+// CachingPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeHybridCacheAttributesType) CachingPolicy() string {
 	r := *o.CachingPolicyPtr
 	return r
 }
 
+// SetCachingPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeHybridCacheAttributesType) SetCachingPolicy(newValue string) *VolumeHybridCacheAttributesType {
 	o.CachingPolicyPtr = &newValue
 	return o
 }
 
+// Eligibility is a fluent style 'getter' method that can be chained
 func (o *VolumeHybridCacheAttributesType) Eligibility() string {
 	r := *o.EligibilityPtr
 	return r
 }
 
+// SetEligibility is a fluent style 'setter' method that can be chained
 func (o *VolumeHybridCacheAttributesType) SetEligibility(newValue string) *VolumeHybridCacheAttributesType {
 	o.EligibilityPtr = &newValue
 	return o
 }
 
+// WriteCacheIneligibilityReason is a fluent style 'getter' method that can be chained
 func (o *VolumeHybridCacheAttributesType) WriteCacheIneligibilityReason() string {
 	r := *o.WriteCacheIneligibilityReasonPtr
 	return r
 }
 
+// SetWriteCacheIneligibilityReason is a fluent style 'setter' method that can be chained
 func (o *VolumeHybridCacheAttributesType) SetWriteCacheIneligibilityReason(newValue string) *VolumeHybridCacheAttributesType {
 	o.WriteCacheIneligibilityReasonPtr = &newValue
 	return o
 }
 
+// SizeType is a ZAPI type represented as a int
 type SizeType int
 
+// CachePolicyType is a ZAPI type represented as a string
 type CachePolicyType string
 
+// VolumeFlexcacheAttributesType is a structure to represent a volume-flexcache-attributes ZAPI object
 type VolumeFlexcacheAttributesType struct {
 	XMLName xml.Name `xml:"volume-flexcache-attributes"`
 
@@ -3077,6 +3502,7 @@ type VolumeFlexcacheAttributesType struct {
 	OriginPtr      *VolumeNameType  `xml:"origin"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeFlexcacheAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3085,10 +3511,12 @@ func (o *VolumeFlexcacheAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeFlexcacheAttributesType is a factory method for creating new instances of VolumeFlexcacheAttributesType objects
 func NewVolumeFlexcacheAttributesType() *VolumeFlexcacheAttributesType {
 	return &VolumeFlexcacheAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeFlexcacheAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.CachePolicyPtr != nil {
@@ -3114,52 +3542,62 @@ func (o VolumeFlexcacheAttributesType) String() string {
 	return buffer.String()
 }
 
+// CachePolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) CachePolicy() CachePolicyType {
 	r := *o.CachePolicyPtr
 	return r
 }
 
+// SetCachePolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) SetCachePolicy(newValue CachePolicyType) *VolumeFlexcacheAttributesType {
 	o.CachePolicyPtr = &newValue
 	return o
 }
 
+// FillPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) FillPolicy() CachePolicyType {
 	r := *o.FillPolicyPtr
 	return r
 }
 
+// SetFillPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) SetFillPolicy(newValue CachePolicyType) *VolumeFlexcacheAttributesType {
 	o.FillPolicyPtr = &newValue
 	return o
 }
 
+// MinReserve is a fluent style 'getter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) MinReserve() SizeType {
 	r := *o.MinReservePtr
 	return r
 }
 
+// SetMinReserve is a fluent style 'setter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) SetMinReserve(newValue SizeType) *VolumeFlexcacheAttributesType {
 	o.MinReservePtr = &newValue
 	return o
 }
 
+// Origin is a fluent style 'getter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) Origin() VolumeNameType {
 	r := *o.OriginPtr
 	return r
 }
 
+// SetOrigin is a fluent style 'setter' method that can be chained
 func (o *VolumeFlexcacheAttributesType) SetOrigin(newValue VolumeNameType) *VolumeFlexcacheAttributesType {
 	o.OriginPtr = &newValue
 	return o
 }
 
+// VolumeExportAttributesType is a structure to represent a volume-export-attributes ZAPI object
 type VolumeExportAttributesType struct {
 	XMLName xml.Name `xml:"volume-export-attributes"`
 
 	PolicyPtr *string `xml:"policy"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeExportAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3168,8 +3606,12 @@ func (o *VolumeExportAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
-func NewVolumeExportAttributesType() *VolumeExportAttributesType { return &VolumeExportAttributesType{} }
+// NewVolumeExportAttributesType is a factory method for creating new instances of VolumeExportAttributesType objects
+func NewVolumeExportAttributesType() *VolumeExportAttributesType {
+	return &VolumeExportAttributesType{}
+}
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeExportAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.PolicyPtr != nil {
@@ -3180,16 +3622,19 @@ func (o VolumeExportAttributesType) String() string {
 	return buffer.String()
 }
 
+// Policy is a fluent style 'getter' method that can be chained
 func (o *VolumeExportAttributesType) Policy() string {
 	r := *o.PolicyPtr
 	return r
 }
 
+// SetPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeExportAttributesType) SetPolicy(newValue string) *VolumeExportAttributesType {
 	o.PolicyPtr = &newValue
 	return o
 }
 
+// VolumeDirectoryAttributesType is a structure to represent a volume-directory-attributes ZAPI object
 type VolumeDirectoryAttributesType struct {
 	XMLName xml.Name `xml:"volume-directory-attributes"`
 
@@ -3198,6 +3643,7 @@ type VolumeDirectoryAttributesType struct {
 	RootDirGenPtr *string `xml:"root-dir-gen"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeDirectoryAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3206,10 +3652,12 @@ func (o *VolumeDirectoryAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeDirectoryAttributesType is a factory method for creating new instances of VolumeDirectoryAttributesType objects
 func NewVolumeDirectoryAttributesType() *VolumeDirectoryAttributesType {
 	return &VolumeDirectoryAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeDirectoryAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.I2pEnabledPtr != nil {
@@ -3230,38 +3678,46 @@ func (o VolumeDirectoryAttributesType) String() string {
 	return buffer.String()
 }
 
+// I2pEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeDirectoryAttributesType) I2pEnabled() bool {
 	r := *o.I2pEnabledPtr
 	return r
 }
 
+// SetI2pEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeDirectoryAttributesType) SetI2pEnabled(newValue bool) *VolumeDirectoryAttributesType {
 	o.I2pEnabledPtr = &newValue
 	return o
 }
 
+// MaxDirSize is a fluent style 'getter' method that can be chained
 func (o *VolumeDirectoryAttributesType) MaxDirSize() int {
 	r := *o.MaxDirSizePtr
 	return r
 }
 
+// SetMaxDirSize is a fluent style 'setter' method that can be chained
 func (o *VolumeDirectoryAttributesType) SetMaxDirSize(newValue int) *VolumeDirectoryAttributesType {
 	o.MaxDirSizePtr = &newValue
 	return o
 }
 
+// RootDirGen is a fluent style 'getter' method that can be chained
 func (o *VolumeDirectoryAttributesType) RootDirGen() string {
 	r := *o.RootDirGenPtr
 	return r
 }
 
+// SetRootDirGen is a fluent style 'setter' method that can be chained
 func (o *VolumeDirectoryAttributesType) SetRootDirGen(newValue string) *VolumeDirectoryAttributesType {
 	o.RootDirGenPtr = &newValue
 	return o
 }
 
+// VolumeNameType is a ZAPI type represented as a string
 type VolumeNameType string
 
+// VolumeCloneParentAttributesType is a structure to represent a volume-clone-parent-attributes ZAPI object
 type VolumeCloneParentAttributesType struct {
 	XMLName xml.Name `xml:"volume-clone-parent-attributes"`
 
@@ -3273,6 +3729,7 @@ type VolumeCloneParentAttributesType struct {
 	UuidPtr         *UuidType       `xml:"uuid"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeCloneParentAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3281,10 +3738,12 @@ func (o *VolumeCloneParentAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeCloneParentAttributesType is a factory method for creating new instances of VolumeCloneParentAttributesType objects
 func NewVolumeCloneParentAttributesType() *VolumeCloneParentAttributesType {
 	return &VolumeCloneParentAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeCloneParentAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.DsidPtr != nil {
@@ -3320,66 +3779,79 @@ func (o VolumeCloneParentAttributesType) String() string {
 	return buffer.String()
 }
 
+// Dsid is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneParentAttributesType) Dsid() int {
 	r := *o.DsidPtr
 	return r
 }
 
+// SetDsid is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SetDsid(newValue int) *VolumeCloneParentAttributesType {
 	o.DsidPtr = &newValue
 	return o
 }
 
+// Msid is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneParentAttributesType) Msid() int {
 	r := *o.MsidPtr
 	return r
 }
 
+// SetMsid is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SetMsid(newValue int) *VolumeCloneParentAttributesType {
 	o.MsidPtr = &newValue
 	return o
 }
 
+// Name is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneParentAttributesType) Name() VolumeNameType {
 	r := *o.NamePtr
 	return r
 }
 
+// SetName is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SetName(newValue VolumeNameType) *VolumeCloneParentAttributesType {
 	o.NamePtr = &newValue
 	return o
 }
 
+// SnapshotId is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SnapshotId() int {
 	r := *o.SnapshotIdPtr
 	return r
 }
 
+// SetSnapshotId is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SetSnapshotId(newValue int) *VolumeCloneParentAttributesType {
 	o.SnapshotIdPtr = &newValue
 	return o
 }
 
+// SnapshotName is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SnapshotName() string {
 	r := *o.SnapshotNamePtr
 	return r
 }
 
+// SetSnapshotName is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SetSnapshotName(newValue string) *VolumeCloneParentAttributesType {
 	o.SnapshotNamePtr = &newValue
 	return o
 }
 
+// Uuid is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneParentAttributesType) Uuid() UuidType {
 	r := *o.UuidPtr
 	return r
 }
 
+// SetUuid is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneParentAttributesType) SetUuid(newValue UuidType) *VolumeCloneParentAttributesType {
 	o.UuidPtr = &newValue
 	return o
 }
 
+// VolumeCloneAttributesType is a structure to represent a volume-clone-attributes ZAPI object
 type VolumeCloneAttributesType struct {
 	XMLName xml.Name `xml:"volume-clone-attributes"`
 
@@ -3387,6 +3859,7 @@ type VolumeCloneAttributesType struct {
 	VolumeCloneParentAttributesPtr *VolumeCloneParentAttributesType `xml:"volume-clone-parent-attributes"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeCloneAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3395,8 +3868,10 @@ func (o *VolumeCloneAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeCloneAttributesType is a factory method for creating new instances of VolumeCloneAttributesType objects
 func NewVolumeCloneAttributesType() *VolumeCloneAttributesType { return &VolumeCloneAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeCloneAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.CloneChildCountPtr != nil {
@@ -3412,26 +3887,31 @@ func (o VolumeCloneAttributesType) String() string {
 	return buffer.String()
 }
 
+// CloneChildCount is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneAttributesType) CloneChildCount() int {
 	r := *o.CloneChildCountPtr
 	return r
 }
 
+// SetCloneChildCount is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneAttributesType) SetCloneChildCount(newValue int) *VolumeCloneAttributesType {
 	o.CloneChildCountPtr = &newValue
 	return o
 }
 
+// VolumeCloneParentAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeCloneAttributesType) VolumeCloneParentAttributes() VolumeCloneParentAttributesType {
 	r := *o.VolumeCloneParentAttributesPtr
 	return r
 }
 
+// SetVolumeCloneParentAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeCloneAttributesType) SetVolumeCloneParentAttributes(newValue VolumeCloneParentAttributesType) *VolumeCloneAttributesType {
 	o.VolumeCloneParentAttributesPtr = &newValue
 	return o
 }
 
+// VolumeAutosizeAttributesType is a structure to represent a volume-autosize-attributes ZAPI object
 type VolumeAutosizeAttributesType struct {
 	XMLName xml.Name `xml:"volume-autosize-attributes"`
 
@@ -3446,6 +3926,7 @@ type VolumeAutosizeAttributesType struct {
 	ShrinkThresholdPercentPtr *int    `xml:"shrink-threshold-percent"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeAutosizeAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3454,10 +3935,12 @@ func (o *VolumeAutosizeAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeAutosizeAttributesType is a factory method for creating new instances of VolumeAutosizeAttributesType objects
 func NewVolumeAutosizeAttributesType() *VolumeAutosizeAttributesType {
 	return &VolumeAutosizeAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeAutosizeAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.GrowThresholdPercentPtr != nil {
@@ -3508,102 +3991,122 @@ func (o VolumeAutosizeAttributesType) String() string {
 	return buffer.String()
 }
 
+// GrowThresholdPercent is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) GrowThresholdPercent() int {
 	r := *o.GrowThresholdPercentPtr
 	return r
 }
 
+// SetGrowThresholdPercent is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetGrowThresholdPercent(newValue int) *VolumeAutosizeAttributesType {
 	o.GrowThresholdPercentPtr = &newValue
 	return o
 }
 
+// IncrementPercent is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) IncrementPercent() int {
 	r := *o.IncrementPercentPtr
 	return r
 }
 
+// SetIncrementPercent is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetIncrementPercent(newValue int) *VolumeAutosizeAttributesType {
 	o.IncrementPercentPtr = &newValue
 	return o
 }
 
+// IncrementSize is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) IncrementSize() int {
 	r := *o.IncrementSizePtr
 	return r
 }
 
+// SetIncrementSize is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetIncrementSize(newValue int) *VolumeAutosizeAttributesType {
 	o.IncrementSizePtr = &newValue
 	return o
 }
 
+// IsEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) IsEnabled() bool {
 	r := *o.IsEnabledPtr
 	return r
 }
 
+// SetIsEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetIsEnabled(newValue bool) *VolumeAutosizeAttributesType {
 	o.IsEnabledPtr = &newValue
 	return o
 }
 
+// MaximumSize is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) MaximumSize() int {
 	r := *o.MaximumSizePtr
 	return r
 }
 
+// SetMaximumSize is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetMaximumSize(newValue int) *VolumeAutosizeAttributesType {
 	o.MaximumSizePtr = &newValue
 	return o
 }
 
+// MinimumSize is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) MinimumSize() int {
 	r := *o.MinimumSizePtr
 	return r
 }
 
+// SetMinimumSize is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetMinimumSize(newValue int) *VolumeAutosizeAttributesType {
 	o.MinimumSizePtr = &newValue
 	return o
 }
 
+// Mode is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) Mode() string {
 	r := *o.ModePtr
 	return r
 }
 
+// SetMode is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetMode(newValue string) *VolumeAutosizeAttributesType {
 	o.ModePtr = &newValue
 	return o
 }
 
+// Reset is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) Reset() bool {
 	r := *o.ResetPtr
 	return r
 }
 
+// SetReset is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetReset(newValue bool) *VolumeAutosizeAttributesType {
 	o.ResetPtr = &newValue
 	return o
 }
 
+// ShrinkThresholdPercent is a fluent style 'getter' method that can be chained
 func (o *VolumeAutosizeAttributesType) ShrinkThresholdPercent() int {
 	r := *o.ShrinkThresholdPercentPtr
 	return r
 }
 
+// SetShrinkThresholdPercent is a fluent style 'setter' method that can be chained
 func (o *VolumeAutosizeAttributesType) SetShrinkThresholdPercent(newValue int) *VolumeAutosizeAttributesType {
 	o.ShrinkThresholdPercentPtr = &newValue
 	return o
 }
 
+// VolumeAutobalanceAttributesType is a structure to represent a volume-autobalance-attributes ZAPI object
 type VolumeAutobalanceAttributesType struct {
 	XMLName xml.Name `xml:"volume-autobalance-attributes"`
 
 	IsAutobalanceEligiblePtr *bool `xml:"is-autobalance-eligible"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeAutobalanceAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3612,10 +4115,12 @@ func (o *VolumeAutobalanceAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeAutobalanceAttributesType is a factory method for creating new instances of VolumeAutobalanceAttributesType objects
 func NewVolumeAutobalanceAttributesType() *VolumeAutobalanceAttributesType {
 	return &VolumeAutobalanceAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeAutobalanceAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.IsAutobalanceEligiblePtr != nil {
@@ -3626,22 +4131,26 @@ func (o VolumeAutobalanceAttributesType) String() string {
 	return buffer.String()
 }
 
+// IsAutobalanceEligible is a fluent style 'getter' method that can be chained
 func (o *VolumeAutobalanceAttributesType) IsAutobalanceEligible() bool {
 	r := *o.IsAutobalanceEligiblePtr
 	return r
 }
 
+// SetIsAutobalanceEligible is a fluent style 'setter' method that can be chained
 func (o *VolumeAutobalanceAttributesType) SetIsAutobalanceEligible(newValue bool) *VolumeAutobalanceAttributesType {
 	o.IsAutobalanceEligiblePtr = &newValue
 	return o
 }
 
+// VolumeAntivirusAttributesType is a structure to represent a volume-antivirus-attributes ZAPI object
 type VolumeAntivirusAttributesType struct {
 	XMLName xml.Name `xml:"volume-antivirus-attributes"`
 
 	OnAccessPolicyPtr *string `xml:"on-access-policy"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeAntivirusAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3650,10 +4159,12 @@ func (o *VolumeAntivirusAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeAntivirusAttributesType is a factory method for creating new instances of VolumeAntivirusAttributesType objects
 func NewVolumeAntivirusAttributesType() *VolumeAntivirusAttributesType {
 	return &VolumeAntivirusAttributesType{}
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeAntivirusAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.OnAccessPolicyPtr != nil {
@@ -3664,16 +4175,19 @@ func (o VolumeAntivirusAttributesType) String() string {
 	return buffer.String()
 }
 
+// OnAccessPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeAntivirusAttributesType) OnAccessPolicy() string {
 	r := *o.OnAccessPolicyPtr
 	return r
 }
 
+// SetOnAccessPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeAntivirusAttributesType) SetOnAccessPolicy(newValue string) *VolumeAntivirusAttributesType {
 	o.OnAccessPolicyPtr = &newValue
 	return o
 }
 
+// VolumeAttributesType is a structure to represent a volume-attributes ZAPI object
 type VolumeAttributesType struct {
 	XMLName xml.Name `xml:"volume-attributes"`
 
@@ -3702,6 +4216,7 @@ type VolumeAttributesType struct {
 	VolumeVmAlignAttributesPtr            *VolumeVmAlignAttributesType            `xml:"volume-vm-align-attributes"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeAttributesType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -3710,8 +4225,10 @@ func (o *VolumeAttributesType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeAttributesType is a factory method for creating new instances of VolumeAttributesType objects
 func NewVolumeAttributesType() *VolumeAttributesType { return &VolumeAttributesType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeAttributesType) String() string {
 	var buffer bytes.Buffer
 	if o.VolumeAntivirusAttributesPtr != nil {
@@ -3832,236 +4349,283 @@ func (o VolumeAttributesType) String() string {
 	return buffer.String()
 }
 
+// VolumeAntivirusAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeAntivirusAttributes() VolumeAntivirusAttributesType {
 	r := *o.VolumeAntivirusAttributesPtr
 	return r
 }
 
+// SetVolumeAntivirusAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeAntivirusAttributes(newValue VolumeAntivirusAttributesType) *VolumeAttributesType {
 	o.VolumeAntivirusAttributesPtr = &newValue
 	return o
 }
 
+// VolumeAutobalanceAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeAutobalanceAttributes() VolumeAutobalanceAttributesType {
 	r := *o.VolumeAutobalanceAttributesPtr
 	return r
 }
 
+// SetVolumeAutobalanceAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeAutobalanceAttributes(newValue VolumeAutobalanceAttributesType) *VolumeAttributesType {
 	o.VolumeAutobalanceAttributesPtr = &newValue
 	return o
 }
 
+// VolumeAutosizeAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeAutosizeAttributes() VolumeAutosizeAttributesType {
 	r := *o.VolumeAutosizeAttributesPtr
 	return r
 }
 
+// SetVolumeAutosizeAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeAutosizeAttributes(newValue VolumeAutosizeAttributesType) *VolumeAttributesType {
 	o.VolumeAutosizeAttributesPtr = &newValue
 	return o
 }
 
+// VolumeCloneAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeCloneAttributes() VolumeCloneAttributesType {
 	r := *o.VolumeCloneAttributesPtr
 	return r
 }
 
+// SetVolumeCloneAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeCloneAttributes(newValue VolumeCloneAttributesType) *VolumeAttributesType {
 	o.VolumeCloneAttributesPtr = &newValue
 	return o
 }
 
+// VolumeDirectoryAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeDirectoryAttributes() VolumeDirectoryAttributesType {
 	r := *o.VolumeDirectoryAttributesPtr
 	return r
 }
 
+// SetVolumeDirectoryAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeDirectoryAttributes(newValue VolumeDirectoryAttributesType) *VolumeAttributesType {
 	o.VolumeDirectoryAttributesPtr = &newValue
 	return o
 }
 
+// VolumeExportAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeExportAttributes() VolumeExportAttributesType {
 	r := *o.VolumeExportAttributesPtr
 	return r
 }
 
+// SetVolumeExportAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeExportAttributes(newValue VolumeExportAttributesType) *VolumeAttributesType {
 	o.VolumeExportAttributesPtr = &newValue
 	return o
 }
 
+// VolumeFlexcacheAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeFlexcacheAttributes() VolumeFlexcacheAttributesType {
 	r := *o.VolumeFlexcacheAttributesPtr
 	return r
 }
 
+// SetVolumeFlexcacheAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeFlexcacheAttributes(newValue VolumeFlexcacheAttributesType) *VolumeAttributesType {
 	o.VolumeFlexcacheAttributesPtr = &newValue
 	return o
 }
 
+// VolumeHybridCacheAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeHybridCacheAttributes() VolumeHybridCacheAttributesType {
 	r := *o.VolumeHybridCacheAttributesPtr
 	return r
 }
 
+// SetVolumeHybridCacheAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeHybridCacheAttributes(newValue VolumeHybridCacheAttributesType) *VolumeAttributesType {
 	o.VolumeHybridCacheAttributesPtr = &newValue
 	return o
 }
 
+// VolumeIdAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeIdAttributes() VolumeIdAttributesType {
 	r := *o.VolumeIdAttributesPtr
 	return r
 }
 
+// SetVolumeIdAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeIdAttributes(newValue VolumeIdAttributesType) *VolumeAttributesType {
 	o.VolumeIdAttributesPtr = &newValue
 	return o
 }
 
+// VolumeInfinitevolAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeInfinitevolAttributes() VolumeInfinitevolAttributesType {
 	r := *o.VolumeInfinitevolAttributesPtr
 	return r
 }
 
+// SetVolumeInfinitevolAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeInfinitevolAttributes(newValue VolumeInfinitevolAttributesType) *VolumeAttributesType {
 	o.VolumeInfinitevolAttributesPtr = &newValue
 	return o
 }
 
+// VolumeInodeAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeInodeAttributes() VolumeInodeAttributesType {
 	r := *o.VolumeInodeAttributesPtr
 	return r
 }
 
+// SetVolumeInodeAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeInodeAttributes(newValue VolumeInodeAttributesType) *VolumeAttributesType {
 	o.VolumeInodeAttributesPtr = &newValue
 	return o
 }
 
+// VolumeLanguageAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeLanguageAttributes() VolumeLanguageAttributesType {
 	r := *o.VolumeLanguageAttributesPtr
 	return r
 }
 
+// SetVolumeLanguageAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeLanguageAttributes(newValue VolumeLanguageAttributesType) *VolumeAttributesType {
 	o.VolumeLanguageAttributesPtr = &newValue
 	return o
 }
 
+// VolumeMirrorAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeMirrorAttributes() VolumeMirrorAttributesType {
 	r := *o.VolumeMirrorAttributesPtr
 	return r
 }
 
+// SetVolumeMirrorAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeMirrorAttributes(newValue VolumeMirrorAttributesType) *VolumeAttributesType {
 	o.VolumeMirrorAttributesPtr = &newValue
 	return o
 }
 
+// VolumePerformanceAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumePerformanceAttributes() VolumePerformanceAttributesType {
 	r := *o.VolumePerformanceAttributesPtr
 	return r
 }
 
+// SetVolumePerformanceAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumePerformanceAttributes(newValue VolumePerformanceAttributesType) *VolumeAttributesType {
 	o.VolumePerformanceAttributesPtr = &newValue
 	return o
 }
 
+// VolumeQosAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeQosAttributes() VolumeQosAttributesType {
 	r := *o.VolumeQosAttributesPtr
 	return r
 }
 
+// SetVolumeQosAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeQosAttributes(newValue VolumeQosAttributesType) *VolumeAttributesType {
 	o.VolumeQosAttributesPtr = &newValue
 	return o
 }
 
+// VolumeSecurityAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeSecurityAttributes() VolumeSecurityAttributesType {
 	r := *o.VolumeSecurityAttributesPtr
 	return r
 }
 
+// SetVolumeSecurityAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeSecurityAttributes(newValue VolumeSecurityAttributesType) *VolumeAttributesType {
 	o.VolumeSecurityAttributesPtr = &newValue
 	return o
 }
 
+// VolumeSisAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeSisAttributes() VolumeSisAttributesType {
 	r := *o.VolumeSisAttributesPtr
 	return r
 }
 
+// SetVolumeSisAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeSisAttributes(newValue VolumeSisAttributesType) *VolumeAttributesType {
 	o.VolumeSisAttributesPtr = &newValue
 	return o
 }
 
+// VolumeSnapshotAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeSnapshotAttributes() VolumeSnapshotAttributesType {
 	r := *o.VolumeSnapshotAttributesPtr
 	return r
 }
 
+// SetVolumeSnapshotAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeSnapshotAttributes(newValue VolumeSnapshotAttributesType) *VolumeAttributesType {
 	o.VolumeSnapshotAttributesPtr = &newValue
 	return o
 }
 
+// VolumeSnapshotAutodeleteAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeSnapshotAutodeleteAttributes() VolumeSnapshotAutodeleteAttributesType {
 	r := *o.VolumeSnapshotAutodeleteAttributesPtr
 	return r
 }
 
+// SetVolumeSnapshotAutodeleteAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeSnapshotAutodeleteAttributes(newValue VolumeSnapshotAutodeleteAttributesType) *VolumeAttributesType {
 	o.VolumeSnapshotAutodeleteAttributesPtr = &newValue
 	return o
 }
 
+// VolumeSpaceAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeSpaceAttributes() VolumeSpaceAttributesType {
 	r := *o.VolumeSpaceAttributesPtr
 	return r
 }
 
+// SetVolumeSpaceAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeSpaceAttributes(newValue VolumeSpaceAttributesType) *VolumeAttributesType {
 	o.VolumeSpaceAttributesPtr = &newValue
 	return o
 }
 
+// VolumeStateAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeStateAttributes() VolumeStateAttributesType {
 	r := *o.VolumeStateAttributesPtr
 	return r
 }
 
+// SetVolumeStateAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeStateAttributes(newValue VolumeStateAttributesType) *VolumeAttributesType {
 	o.VolumeStateAttributesPtr = &newValue
 	return o
 }
 
+// VolumeTransitionAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeTransitionAttributes() VolumeTransitionAttributesType {
 	r := *o.VolumeTransitionAttributesPtr
 	return r
 }
 
+// SetVolumeTransitionAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeTransitionAttributes(newValue VolumeTransitionAttributesType) *VolumeAttributesType {
 	o.VolumeTransitionAttributesPtr = &newValue
 	return o
 }
 
+// VolumeVmAlignAttributes is a fluent style 'getter' method that can be chained
 func (o *VolumeAttributesType) VolumeVmAlignAttributes() VolumeVmAlignAttributesType {
 	r := *o.VolumeVmAlignAttributesPtr
 	return r
 }
 
+// SetVolumeVmAlignAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeAttributesType) SetVolumeVmAlignAttributes(newValue VolumeVmAlignAttributesType) *VolumeAttributesType {
 	o.VolumeVmAlignAttributesPtr = &newValue
 	return o
 }
 
+// SystemVersionTupleType is a structure to represent a system-version-tuple ZAPI object
 type SystemVersionTupleType struct {
 	XMLName xml.Name `xml:"system-version-tuple"`
 
@@ -4070,6 +4634,7 @@ type SystemVersionTupleType struct {
 	MinorPtr      *int `xml:"minor"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *SystemVersionTupleType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -4078,8 +4643,10 @@ func (o *SystemVersionTupleType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewSystemVersionTupleType is a factory method for creating new instances of SystemVersionTupleType objects
 func NewSystemVersionTupleType() *SystemVersionTupleType { return &SystemVersionTupleType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SystemVersionTupleType) String() string {
 	var buffer bytes.Buffer
 	if o.GenerationPtr != nil {
@@ -4100,56 +4667,71 @@ func (o SystemVersionTupleType) String() string {
 	return buffer.String()
 }
 
+// Generation is a fluent style 'getter' method that can be chained
 func (o *SystemVersionTupleType) Generation() int {
 	r := *o.GenerationPtr
 	return r
 }
 
+// SetGeneration is a fluent style 'setter' method that can be chained
 func (o *SystemVersionTupleType) SetGeneration(newValue int) *SystemVersionTupleType {
 	o.GenerationPtr = &newValue
 	return o
 }
 
+// Major is a fluent style 'getter' method that can be chained
 func (o *SystemVersionTupleType) Major() int {
 	r := *o.MajorPtr
 	return r
 }
 
+// SetMajor is a fluent style 'setter' method that can be chained
 func (o *SystemVersionTupleType) SetMajor(newValue int) *SystemVersionTupleType {
 	o.MajorPtr = &newValue
 	return o
 }
 
+// Minor is a fluent style 'getter' method that can be chained
 func (o *SystemVersionTupleType) Minor() int {
 	r := *o.MinorPtr
 	return r
 }
 
+// SetMinor is a fluent style 'setter' method that can be chained
 func (o *SystemVersionTupleType) SetMinor(newValue int) *SystemVersionTupleType {
 	o.MinorPtr = &newValue
 	return o
 }
 
+// SubnetNameType is a ZAPI type represented as a string
 type SubnetNameType string
 
+// RoutingGroupType is a ZAPI type represented as a string
 type RoutingGroupType string
 
+// UuidType is a ZAPI type represented as a string
 type UuidType string
 
+// FailoverGroupType is a ZAPI type represented as a string
 type FailoverGroupType string
 
+// DnsZoneType is a ZAPI type represented as a string
 type DnsZoneType string
 
+// DataProtocolType is a ZAPI type represented as a string
 type DataProtocolType string
 
+// IpAddressType is a ZAPI type represented as a string
 type IpAddressType string
 
+// InitiatorInfoType is a structure to represent a initiator-info ZAPI object
 type InitiatorInfoType struct {
 	XMLName xml.Name `xml:"initiator-info"`
 
 	InitiatorNamePtr *string `xml:"initiator-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *InitiatorInfoType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -4158,8 +4740,10 @@ func (o *InitiatorInfoType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewInitiatorInfoType is a factory method for creating new instances of InitiatorInfoType objects
 func NewInitiatorInfoType() *InitiatorInfoType { return &InitiatorInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o InitiatorInfoType) String() string {
 	var buffer bytes.Buffer
 	if o.InitiatorNamePtr != nil {
@@ -4170,16 +4754,19 @@ func (o InitiatorInfoType) String() string {
 	return buffer.String()
 }
 
+// InitiatorName is a fluent style 'getter' method that can be chained
 func (o *InitiatorInfoType) InitiatorName() string {
 	r := *o.InitiatorNamePtr
 	return r
 }
 
+// SetInitiatorName is a fluent style 'setter' method that can be chained
 func (o *InitiatorInfoType) SetInitiatorName(newValue string) *InitiatorInfoType {
 	o.InitiatorNamePtr = &newValue
 	return o
 }
 
+// InitiatorGroupInfoType is a structure to represent a initiator-group-info ZAPI object
 type InitiatorGroupInfoType struct {
 	XMLName xml.Name `xml:"initiator-group-info"`
 
@@ -4199,6 +4786,7 @@ type InitiatorGroupInfoType struct {
 	VserverPtr                             *string             `xml:"vserver"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *InitiatorGroupInfoType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -4207,8 +4795,10 @@ func (o *InitiatorGroupInfoType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewInitiatorGroupInfoType is a factory method for creating new instances of InitiatorGroupInfoType objects
 func NewInitiatorGroupInfoType() *InitiatorGroupInfoType { return &InitiatorGroupInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o InitiatorGroupInfoType) String() string {
 	var buffer bytes.Buffer
 	if o.InitiatorGroupAluaEnabledPtr != nil {
@@ -4284,121 +4874,145 @@ func (o InitiatorGroupInfoType) String() string {
 	return buffer.String()
 }
 
+// InitiatorGroupAluaEnabled is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupAluaEnabled() bool {
 	r := *o.InitiatorGroupAluaEnabledPtr
 	return r
 }
 
+// SetInitiatorGroupAluaEnabled is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupAluaEnabled(newValue bool) *InitiatorGroupInfoType {
 	o.InitiatorGroupAluaEnabledPtr = &newValue
 	return o
 }
 
+// InitiatorGroupName is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupName() string {
 	r := *o.InitiatorGroupNamePtr
 	return r
 }
 
+// SetInitiatorGroupName is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupName(newValue string) *InitiatorGroupInfoType {
 	o.InitiatorGroupNamePtr = &newValue
 	return o
 }
 
+// InitiatorGroupOsType is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupOsType() string {
 	r := *o.InitiatorGroupOsTypePtr
 	return r
 }
 
+// SetInitiatorGroupOsType is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupOsType(newValue string) *InitiatorGroupInfoType {
 	o.InitiatorGroupOsTypePtr = &newValue
 	return o
 }
 
+// InitiatorGroupPortsetName is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupPortsetName() string {
 	r := *o.InitiatorGroupPortsetNamePtr
 	return r
 }
 
+// SetInitiatorGroupPortsetName is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupPortsetName(newValue string) *InitiatorGroupInfoType {
 	o.InitiatorGroupPortsetNamePtr = &newValue
 	return o
 }
 
+// InitiatorGroupReportScsiNameEnabled is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupReportScsiNameEnabled() bool {
 	r := *o.InitiatorGroupReportScsiNameEnabledPtr
 	return r
 }
 
+// SetInitiatorGroupReportScsiNameEnabled is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupReportScsiNameEnabled(newValue bool) *InitiatorGroupInfoType {
 	o.InitiatorGroupReportScsiNameEnabledPtr = &newValue
 	return o
 }
 
+// InitiatorGroupThrottleBorrow is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupThrottleBorrow() bool {
 	r := *o.InitiatorGroupThrottleBorrowPtr
 	return r
 }
 
+// SetInitiatorGroupThrottleBorrow is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupThrottleBorrow(newValue bool) *InitiatorGroupInfoType {
 	o.InitiatorGroupThrottleBorrowPtr = &newValue
 	return o
 }
 
+// InitiatorGroupThrottleReserve is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupThrottleReserve() int {
 	r := *o.InitiatorGroupThrottleReservePtr
 	return r
 }
 
+// SetInitiatorGroupThrottleReserve is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupThrottleReserve(newValue int) *InitiatorGroupInfoType {
 	o.InitiatorGroupThrottleReservePtr = &newValue
 	return o
 }
 
+// InitiatorGroupType is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupType() string {
 	r := *o.InitiatorGroupTypePtr
 	return r
 }
 
+// SetInitiatorGroupType is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupType(newValue string) *InitiatorGroupInfoType {
 	o.InitiatorGroupTypePtr = &newValue
 	return o
 }
 
+// InitiatorGroupUsePartner is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupUsePartner() bool {
 	r := *o.InitiatorGroupUsePartnerPtr
 	return r
 }
 
+// SetInitiatorGroupUsePartner is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupUsePartner(newValue bool) *InitiatorGroupInfoType {
 	o.InitiatorGroupUsePartnerPtr = &newValue
 	return o
 }
 
+// InitiatorGroupUuid is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupUuid() string {
 	r := *o.InitiatorGroupUuidPtr
 	return r
 }
 
+// SetInitiatorGroupUuid is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupUuid(newValue string) *InitiatorGroupInfoType {
 	o.InitiatorGroupUuidPtr = &newValue
 	return o
 }
 
+// InitiatorGroupVsaEnabled is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) InitiatorGroupVsaEnabled() bool {
 	r := *o.InitiatorGroupVsaEnabledPtr
 	return r
 }
 
+// SetInitiatorGroupVsaEnabled is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiatorGroupVsaEnabled(newValue bool) *InitiatorGroupInfoType {
 	o.InitiatorGroupVsaEnabledPtr = &newValue
 	return o
 }
 
+// Initiators is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) Initiators() []InitiatorInfoType {
 	r := o.InitiatorsPtr
 	return r
 }
 
+// SetInitiators is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetInitiators(newValue []InitiatorInfoType) *InitiatorGroupInfoType {
 	newSlice := make([]InitiatorInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -4406,28 +5020,34 @@ func (o *InitiatorGroupInfoType) SetInitiators(newValue []InitiatorInfoType) *In
 	return o
 }
 
+// LunId is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) LunId() int {
 	r := *o.LunIdPtr
 	return r
 }
 
+// SetLunId is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetLunId(newValue int) *InitiatorGroupInfoType {
 	o.LunIdPtr = &newValue
 	return o
 }
 
+// Vserver is a fluent style 'getter' method that can be chained
 func (o *InitiatorGroupInfoType) Vserver() string {
 	r := *o.VserverPtr
 	return r
 }
 
+// SetVserver is a fluent style 'setter' method that can be chained
 func (o *InitiatorGroupInfoType) SetVserver(newValue string) *InitiatorGroupInfoType {
 	o.VserverPtr = &newValue
 	return o
 }
 
+// NodeNameType is a ZAPI type represented as a string
 type NodeNameType string
 
+// NetInterfaceInfoType is a structure to represent a net-interface-info ZAPI object
 type NetInterfaceInfoType struct {
 	XMLName xml.Name `xml:"net-interface-info"`
 
@@ -4462,6 +5082,7 @@ type NetInterfaceInfoType struct {
 	WwpnPtr                   *string            `xml:"wwpn"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *NetInterfaceInfoType) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -4470,8 +5091,10 @@ func (o *NetInterfaceInfoType) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewNetInterfaceInfoType is a factory method for creating new instances of NetInterfaceInfoType objects
 func NewNetInterfaceInfoType() *NetInterfaceInfoType { return &NetInterfaceInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o NetInterfaceInfoType) String() string {
 	var buffer bytes.Buffer
 	if o.AddressPtr != nil {
@@ -4622,71 +5245,85 @@ func (o NetInterfaceInfoType) String() string {
 	return buffer.String()
 }
 
+// Address is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) Address() IpAddressType {
 	r := *o.AddressPtr
 	return r
 }
 
+// SetAddress is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetAddress(newValue IpAddressType) *NetInterfaceInfoType {
 	o.AddressPtr = &newValue
 	return o
 }
 
+// AddressFamily is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) AddressFamily() string {
 	r := *o.AddressFamilyPtr
 	return r
 }
 
+// SetAddressFamily is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetAddressFamily(newValue string) *NetInterfaceInfoType {
 	o.AddressFamilyPtr = &newValue
 	return o
 }
 
+// AdministrativeStatus is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) AdministrativeStatus() string {
 	r := *o.AdministrativeStatusPtr
 	return r
 }
 
+// SetAdministrativeStatus is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetAdministrativeStatus(newValue string) *NetInterfaceInfoType {
 	o.AdministrativeStatusPtr = &newValue
 	return o
 }
 
+// Comment is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) Comment() string {
 	r := *o.CommentPtr
 	return r
 }
 
+// SetComment is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetComment(newValue string) *NetInterfaceInfoType {
 	o.CommentPtr = &newValue
 	return o
 }
 
+// CurrentNode is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) CurrentNode() string {
 	r := *o.CurrentNodePtr
 	return r
 }
 
+// SetCurrentNode is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetCurrentNode(newValue string) *NetInterfaceInfoType {
 	o.CurrentNodePtr = &newValue
 	return o
 }
 
+// CurrentPort is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) CurrentPort() string {
 	r := *o.CurrentPortPtr
 	return r
 }
 
+// SetCurrentPort is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetCurrentPort(newValue string) *NetInterfaceInfoType {
 	o.CurrentPortPtr = &newValue
 	return o
 }
 
+// DataProtocols is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) DataProtocols() []DataProtocolType {
 	r := o.DataProtocolsPtr
 	return r
 }
 
+// SetDataProtocols is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetDataProtocols(newValue []DataProtocolType) *NetInterfaceInfoType {
 	newSlice := make([]DataProtocolType, len(newValue))
 	copy(newSlice, newValue)
@@ -4694,652 +5331,2442 @@ func (o *NetInterfaceInfoType) SetDataProtocols(newValue []DataProtocolType) *Ne
 	return o
 }
 
+// DnsDomainName is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) DnsDomainName() DnsZoneType {
 	r := *o.DnsDomainNamePtr
 	return r
 }
 
+// SetDnsDomainName is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetDnsDomainName(newValue DnsZoneType) *NetInterfaceInfoType {
 	o.DnsDomainNamePtr = &newValue
 	return o
 }
 
+// FailoverGroup is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) FailoverGroup() FailoverGroupType {
 	r := *o.FailoverGroupPtr
 	return r
 }
 
+// SetFailoverGroup is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetFailoverGroup(newValue FailoverGroupType) *NetInterfaceInfoType {
 	o.FailoverGroupPtr = &newValue
 	return o
 }
 
+// FailoverPolicy is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) FailoverPolicy() string {
 	r := *o.FailoverPolicyPtr
 	return r
 }
 
+// SetFailoverPolicy is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetFailoverPolicy(newValue string) *NetInterfaceInfoType {
 	o.FailoverPolicyPtr = &newValue
 	return o
 }
 
+// FirewallPolicy is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) FirewallPolicy() string {
 	r := *o.FirewallPolicyPtr
 	return r
 }
 
+// SetFirewallPolicy is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetFirewallPolicy(newValue string) *NetInterfaceInfoType {
 	o.FirewallPolicyPtr = &newValue
 	return o
 }
 
+// ForceSubnetAssociation is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) ForceSubnetAssociation() bool {
 	r := *o.ForceSubnetAssociationPtr
 	return r
 }
 
+// SetForceSubnetAssociation is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetForceSubnetAssociation(newValue bool) *NetInterfaceInfoType {
 	o.ForceSubnetAssociationPtr = &newValue
 	return o
 }
 
+// HomeNode is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) HomeNode() string {
 	r := *o.HomeNodePtr
 	return r
 }
 
+// SetHomeNode is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetHomeNode(newValue string) *NetInterfaceInfoType {
 	o.HomeNodePtr = &newValue
 	return o
 }
 
+// HomePort is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) HomePort() string {
 	r := *o.HomePortPtr
 	return r
 }
 
+// SetHomePort is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetHomePort(newValue string) *NetInterfaceInfoType {
 	o.HomePortPtr = &newValue
 	return o
 }
 
+// InterfaceName is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) InterfaceName() string {
 	r := *o.InterfaceNamePtr
 	return r
 }
 
+// SetInterfaceName is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetInterfaceName(newValue string) *NetInterfaceInfoType {
 	o.InterfaceNamePtr = &newValue
 	return o
 }
 
+// IsAutoRevert is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) IsAutoRevert() bool {
 	r := *o.IsAutoRevertPtr
 	return r
 }
 
+// SetIsAutoRevert is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetIsAutoRevert(newValue bool) *NetInterfaceInfoType {
 	o.IsAutoRevertPtr = &newValue
 	return o
 }
 
+// IsHome is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) IsHome() bool {
 	r := *o.IsHomePtr
 	return r
 }
 
+// SetIsHome is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetIsHome(newValue bool) *NetInterfaceInfoType {
 	o.IsHomePtr = &newValue
 	return o
 }
 
+// IsIpv4LinkLocal is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) IsIpv4LinkLocal() bool {
 	r := *o.IsIpv4LinkLocalPtr
 	return r
 }
 
+// SetIsIpv4LinkLocal is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetIsIpv4LinkLocal(newValue bool) *NetInterfaceInfoType {
 	o.IsIpv4LinkLocalPtr = &newValue
 	return o
 }
 
+// LifUuid is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) LifUuid() UuidType {
 	r := *o.LifUuidPtr
 	return r
 }
 
+// SetLifUuid is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetLifUuid(newValue UuidType) *NetInterfaceInfoType {
 	o.LifUuidPtr = &newValue
 	return o
 }
 
+// ListenForDnsQuery is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) ListenForDnsQuery() bool {
 	r := *o.ListenForDnsQueryPtr
 	return r
 }
 
+// SetListenForDnsQuery is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetListenForDnsQuery(newValue bool) *NetInterfaceInfoType {
 	o.ListenForDnsQueryPtr = &newValue
 	return o
 }
 
+// Netmask is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) Netmask() IpAddressType {
 	r := *o.NetmaskPtr
 	return r
 }
 
+// SetNetmask is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetNetmask(newValue IpAddressType) *NetInterfaceInfoType {
 	o.NetmaskPtr = &newValue
 	return o
 }
 
+// NetmaskLength is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) NetmaskLength() int {
 	r := *o.NetmaskLengthPtr
 	return r
 }
 
+// SetNetmaskLength is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetNetmaskLength(newValue int) *NetInterfaceInfoType {
 	o.NetmaskLengthPtr = &newValue
 	return o
 }
 
+// OperationalStatus is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) OperationalStatus() string {
 	r := *o.OperationalStatusPtr
 	return r
 }
 
+// SetOperationalStatus is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetOperationalStatus(newValue string) *NetInterfaceInfoType {
 	o.OperationalStatusPtr = &newValue
 	return o
 }
 
+// Role is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) Role() string {
 	r := *o.RolePtr
 	return r
 }
 
+// SetRole is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetRole(newValue string) *NetInterfaceInfoType {
 	o.RolePtr = &newValue
 	return o
 }
 
+// RoutingGroupName is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) RoutingGroupName() RoutingGroupType {
 	r := *o.RoutingGroupNamePtr
 	return r
 }
 
+// SetRoutingGroupName is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetRoutingGroupName(newValue RoutingGroupType) *NetInterfaceInfoType {
 	o.RoutingGroupNamePtr = &newValue
 	return o
 }
 
+// SubnetName is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) SubnetName() SubnetNameType {
 	r := *o.SubnetNamePtr
 	return r
 }
 
+// SetSubnetName is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetSubnetName(newValue SubnetNameType) *NetInterfaceInfoType {
 	o.SubnetNamePtr = &newValue
 	return o
 }
 
+// UseFailoverGroup is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) UseFailoverGroup() string {
 	r := *o.UseFailoverGroupPtr
 	return r
 }
 
+// SetUseFailoverGroup is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetUseFailoverGroup(newValue string) *NetInterfaceInfoType {
 	o.UseFailoverGroupPtr = &newValue
 	return o
 }
 
+// Vserver is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) Vserver() string {
 	r := *o.VserverPtr
 	return r
 }
 
+// SetVserver is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetVserver(newValue string) *NetInterfaceInfoType {
 	o.VserverPtr = &newValue
 	return o
 }
 
+// Wwpn is a fluent style 'getter' method that can be chained
 func (o *NetInterfaceInfoType) Wwpn() string {
 	r := *o.WwpnPtr
 	return r
 }
 
+// SetWwpn is a fluent style 'setter' method that can be chained
 func (o *NetInterfaceInfoType) SetWwpn(newValue string) *NetInterfaceInfoType {
 	o.WwpnPtr = &newValue
 	return o
 }
 
+// SnapshotIdType is a ZAPI type represented as a string
 type SnapshotIdType string
 
+// SnapshotInfoType is a structure to represent a snapshot-info ZAPI object
 type SnapshotInfoType struct {
-    XMLName xml.Name `xml:"snapshot-info"`
-
-    AccessTimePtr *int `xml:"access-time"`
-    BusyPtr *bool `xml:"busy"`
-    ContainsLunClonesPtr *bool `xml:"contains-lun-clones"`
-    CumulativePercentageOfTotalBlocksPtr *int `xml:"cumulative-percentage-of-total-blocks"`
-    CumulativePercentageOfUsedBlocksPtr *int `xml:"cumulative-percentage-of-used-blocks"`
-    CumulativeTotalPtr *int `xml:"cumulative-total"`
-    DependencyPtr *string `xml:"dependency"`
-    Is7ModeSnapshotPtr *bool `xml:"is-7-mode-snapshot"`
-    IsConstituentSnapshotPtr *bool `xml:"is-constituent-snapshot"`
-    NamePtr *string `xml:"name"`
-    PercentageOfTotalBlocksPtr *int `xml:"percentage-of-total-blocks"`
-    PercentageOfUsedBlocksPtr *int `xml:"percentage-of-used-blocks"`
-    SnapmirrorLabelPtr *string `xml:"snapmirror-label"`
-    SnapshotInstanceUuidPtr *UUIDType `xml:"snapshot-instance-uuid"`
-    SnapshotOwnersListPtr []SnapshotOwnerType `xml:"snapshot-owners-list>snapshot-owner"`
-    SnapshotVersionUuidPtr *UUIDType `xml:"snapshot-version-uuid"`
-    StatePtr *string `xml:"state"`
-    TotalPtr *int `xml:"total"`
-    VolumePtr *string `xml:"volume"`
-    VolumeProvenanceUuidPtr *UUIDType `xml:"volume-provenance-uuid"`
-    VserverPtr *string `xml:"vserver"`
-}
-
+	XMLName xml.Name `xml:"snapshot-info"`
+
+	AccessTimePtr                        *int                `xml:"access-time"`
+	BusyPtr                              *bool               `xml:"busy"`
+	ContainsLunClonesPtr                 *bool               `xml:"contains-lun-clones"`
+	CumulativePercentageOfTotalBlocksPtr *int                `xml:"cumulative-percentage-of-total-blocks"`
+	CumulativePercentageOfUsedBlocksPtr  *int                `xml:"cumulative-percentage-of-used-blocks"`
+	CumulativeTotalPtr                   *int                `xml:"cumulative-total"`
+	DependencyPtr                        *string             `xml:"dependency"`
+	Is7ModeSnapshotPtr                   *bool               `xml:"is-7-mode-snapshot"`
+	IsConstituentSnapshotPtr             *bool               `xml:"is-constituent-snapshot"`
+	NamePtr                              *string             `xml:"name"`
+	PercentageOfTotalBlocksPtr           *int                `xml:"percentage-of-total-blocks"`
+	PercentageOfUsedBlocksPtr            *int                `xml:"percentage-of-used-blocks"`
+	SnapmirrorLabelPtr                   *string             `xml:"snapmirror-label"`
+	SnapshotInstanceUuidPtr              *UUIDType           `xml:"snapshot-instance-uuid"`
+	SnapshotOwnersListPtr                []SnapshotOwnerType `xml:"snapshot-owners-list>snapshot-owner"`
+	SnapshotVersionUuidPtr               *UUIDType           `xml:"snapshot-version-uuid"`
+	StatePtr                             *string             `xml:"state"`
+	TotalPtr                             *int                `xml:"total"`
+	VolumePtr                            *string             `xml:"volume"`
+	VolumeProvenanceUuidPtr              *UUIDType           `xml:"volume-provenance-uuid"`
+	VserverPtr                           *string             `xml:"vserver"`
+}
+
+// ToXML converts this object into an xml string representation
 func (o *SnapshotInfoType) ToXML() (string, error) {
-    output, err := xml.MarshalIndent(o, " ", "    ")
-    if err != nil { fmt.Printf("error: %v\n", err) } // TODO: handle better
-    //fmt.Println(string(output))
-    return string(output), err
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
 }
 
+// NewSnapshotInfoType is a factory method for creating new instances of SnapshotInfoType objects
 func NewSnapshotInfoType() *SnapshotInfoType { return &SnapshotInfoType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SnapshotInfoType) String() string {
-    var buffer bytes.Buffer
-    if o.AccessTimePtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "access-time", *o.AccessTimePtr)) } else { buffer.WriteString(fmt.Sprintf("access-time: nil\n")) } 
-    if o.BusyPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "busy", *o.BusyPtr)) } else { buffer.WriteString(fmt.Sprintf("busy: nil\n")) } 
-    if o.ContainsLunClonesPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "contains-lun-clones", *o.ContainsLunClonesPtr)) } else { buffer.WriteString(fmt.Sprintf("contains-lun-clones: nil\n")) } 
-    if o.CumulativePercentageOfTotalBlocksPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "cumulative-percentage-of-total-blocks", *o.CumulativePercentageOfTotalBlocksPtr)) } else { buffer.WriteString(fmt.Sprintf("cumulative-percentage-of-total-blocks: nil\n")) } 
-    if o.CumulativePercentageOfUsedBlocksPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "cumulative-percentage-of-used-blocks", *o.CumulativePercentageOfUsedBlocksPtr)) } else { buffer.WriteString(fmt.Sprintf("cumulative-percentage-of-used-blocks: nil\n")) } 
-    if o.CumulativeTotalPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "cumulative-total", *o.CumulativeTotalPtr)) } else { buffer.WriteString(fmt.Sprintf("cumulative-total: nil\n")) } 
-    if o.DependencyPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "dependency", *o.DependencyPtr)) } else { buffer.WriteString(fmt.Sprintf("dependency: nil\n")) } 
-    if o.Is7ModeSnapshotPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "is-7-mode-snapshot", *o.Is7ModeSnapshotPtr)) } else { buffer.WriteString(fmt.Sprintf("is-7-mode-snapshot: nil\n")) } 
-    if o.IsConstituentSnapshotPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "is-constituent-snapshot", *o.IsConstituentSnapshotPtr)) } else { buffer.WriteString(fmt.Sprintf("is-constituent-snapshot: nil\n")) } 
-    if o.NamePtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "name", *o.NamePtr)) } else { buffer.WriteString(fmt.Sprintf("name: nil\n")) } 
-    if o.PercentageOfTotalBlocksPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "percentage-of-total-blocks", *o.PercentageOfTotalBlocksPtr)) } else { buffer.WriteString(fmt.Sprintf("percentage-of-total-blocks: nil\n")) } 
-    if o.PercentageOfUsedBlocksPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "percentage-of-used-blocks", *o.PercentageOfUsedBlocksPtr)) } else { buffer.WriteString(fmt.Sprintf("percentage-of-used-blocks: nil\n")) } 
-    if o.SnapmirrorLabelPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapmirror-label", *o.SnapmirrorLabelPtr)) } else { buffer.WriteString(fmt.Sprintf("snapmirror-label: nil\n")) } 
-    if o.SnapshotInstanceUuidPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapshot-instance-uuid", *o.SnapshotInstanceUuidPtr)) } else { buffer.WriteString(fmt.Sprintf("snapshot-instance-uuid: nil\n")) } 
-    if o.SnapshotOwnersListPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapshot-owners-list", o.SnapshotOwnersListPtr)) } else { buffer.WriteString(fmt.Sprintf("snapshot-owners-list: nil\n")) } 
-    if o.SnapshotVersionUuidPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapshot-version-uuid", *o.SnapshotVersionUuidPtr)) } else { buffer.WriteString(fmt.Sprintf("snapshot-version-uuid: nil\n")) } 
-    if o.StatePtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "state", *o.StatePtr)) } else { buffer.WriteString(fmt.Sprintf("state: nil\n")) } 
-    if o.TotalPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "total", *o.TotalPtr)) } else { buffer.WriteString(fmt.Sprintf("total: nil\n")) } 
-    if o.VolumePtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume", *o.VolumePtr)) } else { buffer.WriteString(fmt.Sprintf("volume: nil\n")) } 
-    if o.VolumeProvenanceUuidPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume-provenance-uuid", *o.VolumeProvenanceUuidPtr)) } else { buffer.WriteString(fmt.Sprintf("volume-provenance-uuid: nil\n")) } 
-    if o.VserverPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr)) } else { buffer.WriteString(fmt.Sprintf("vserver: nil\n")) } 
-    return buffer.String()
+	var buffer bytes.Buffer
+	if o.AccessTimePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "access-time", *o.AccessTimePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("access-time: nil\n"))
+	}
+	if o.BusyPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "busy", *o.BusyPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("busy: nil\n"))
+	}
+	if o.ContainsLunClonesPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "contains-lun-clones", *o.ContainsLunClonesPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("contains-lun-clones: nil\n"))
+	}
+	if o.CumulativePercentageOfTotalBlocksPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "cumulative-percentage-of-total-blocks", *o.CumulativePercentageOfTotalBlocksPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("cumulative-percentage-of-total-blocks: nil\n"))
+	}
+	if o.CumulativePercentageOfUsedBlocksPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "cumulative-percentage-of-used-blocks", *o.CumulativePercentageOfUsedBlocksPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("cumulative-percentage-of-used-blocks: nil\n"))
+	}
+	if o.CumulativeTotalPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "cumulative-total", *o.CumulativeTotalPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("cumulative-total: nil\n"))
+	}
+	if o.DependencyPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "dependency", *o.DependencyPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("dependency: nil\n"))
+	}
+	if o.Is7ModeSnapshotPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "is-7-mode-snapshot", *o.Is7ModeSnapshotPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("is-7-mode-snapshot: nil\n"))
+	}
+	if o.IsConstituentSnapshotPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "is-constituent-snapshot", *o.IsConstituentSnapshotPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("is-constituent-snapshot: nil\n"))
+	}
+	if o.NamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "name", *o.NamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("name: nil\n"))
+	}
+	if o.PercentageOfTotalBlocksPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "percentage-of-total-blocks", *o.PercentageOfTotalBlocksPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("percentage-of-total-blocks: nil\n"))
+	}
+	if o.PercentageOfUsedBlocksPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "percentage-of-used-blocks", *o.PercentageOfUsedBlocksPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("percentage-of-used-blocks: nil\n"))
+	}
+	if o.SnapmirrorLabelPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapmirror-label", *o.SnapmirrorLabelPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("snapmirror-label: nil\n"))
+	}
+	if o.SnapshotInstanceUuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapshot-instance-uuid", *o.SnapshotInstanceUuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("snapshot-instance-uuid: nil\n"))
+	}
+	if o.SnapshotOwnersListPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapshot-owners-list", o.SnapshotOwnersListPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("snapshot-owners-list: nil\n"))
+	}
+	if o.SnapshotVersionUuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "snapshot-version-uuid", *o.SnapshotVersionUuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("snapshot-version-uuid: nil\n"))
+	}
+	if o.StatePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "state", *o.StatePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("state: nil\n"))
+	}
+	if o.TotalPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "total", *o.TotalPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("total: nil\n"))
+	}
+	if o.VolumePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume", *o.VolumePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("volume: nil\n"))
+	}
+	if o.VolumeProvenanceUuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume-provenance-uuid", *o.VolumeProvenanceUuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("volume-provenance-uuid: nil\n"))
+	}
+	if o.VserverPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("vserver: nil\n"))
+	}
+	return buffer.String()
 }
 
+// AccessTime is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) AccessTime() int {
-    r := *o.AccessTimePtr
-    return r
+	r := *o.AccessTimePtr
+	return r
 }
 
+// SetAccessTime is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetAccessTime(newValue int) *SnapshotInfoType {
-    o.AccessTimePtr = &newValue
-    return o
+	o.AccessTimePtr = &newValue
+	return o
 }
 
+// Busy is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Busy() bool {
-    r := *o.BusyPtr
-    return r
+	r := *o.BusyPtr
+	return r
 }
 
+// SetBusy is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetBusy(newValue bool) *SnapshotInfoType {
-    o.BusyPtr = &newValue
-    return o
+	o.BusyPtr = &newValue
+	return o
 }
 
+// ContainsLunClones is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) ContainsLunClones() bool {
-    r := *o.ContainsLunClonesPtr
-    return r
+	r := *o.ContainsLunClonesPtr
+	return r
 }
 
+// SetContainsLunClones is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetContainsLunClones(newValue bool) *SnapshotInfoType {
-    o.ContainsLunClonesPtr = &newValue
-    return o
+	o.ContainsLunClonesPtr = &newValue
+	return o
 }
 
+// CumulativePercentageOfTotalBlocks is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) CumulativePercentageOfTotalBlocks() int {
-    r := *o.CumulativePercentageOfTotalBlocksPtr
-    return r
+	r := *o.CumulativePercentageOfTotalBlocksPtr
+	return r
 }
 
+// SetCumulativePercentageOfTotalBlocks is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetCumulativePercentageOfTotalBlocks(newValue int) *SnapshotInfoType {
-    o.CumulativePercentageOfTotalBlocksPtr = &newValue
-    return o
+	o.CumulativePercentageOfTotalBlocksPtr = &newValue
+	return o
 }
 
+// CumulativePercentageOfUsedBlocks is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) CumulativePercentageOfUsedBlocks() int {
-    r := *o.CumulativePercentageOfUsedBlocksPtr
-    return r
+	r := *o.CumulativePercentageOfUsedBlocksPtr
+	return r
 }
 
+// SetCumulativePercentageOfUsedBlocks is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetCumulativePercentageOfUsedBlocks(newValue int) *SnapshotInfoType {
-    o.CumulativePercentageOfUsedBlocksPtr = &newValue
-    return o
+	o.CumulativePercentageOfUsedBlocksPtr = &newValue
+	return o
 }
 
+// CumulativeTotal is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) CumulativeTotal() int {
-    r := *o.CumulativeTotalPtr
-    return r
+	r := *o.CumulativeTotalPtr
+	return r
 }
 
+// SetCumulativeTotal is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetCumulativeTotal(newValue int) *SnapshotInfoType {
-    o.CumulativeTotalPtr = &newValue
-    return o
+	o.CumulativeTotalPtr = &newValue
+	return o
 }
 
+// Dependency is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Dependency() string {
-    r := *o.DependencyPtr
-    return r
+	r := *o.DependencyPtr
+	return r
 }
 
+// SetDependency is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetDependency(newValue string) *SnapshotInfoType {
-    o.DependencyPtr = &newValue
-    return o
+	o.DependencyPtr = &newValue
+	return o
 }
 
+// Is7ModeSnapshot is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Is7ModeSnapshot() bool {
-    r := *o.Is7ModeSnapshotPtr
-    return r
+	r := *o.Is7ModeSnapshotPtr
+	return r
 }
 
+// SetIs7ModeSnapshot is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetIs7ModeSnapshot(newValue bool) *SnapshotInfoType {
-    o.Is7ModeSnapshotPtr = &newValue
-    return o
+	o.Is7ModeSnapshotPtr = &newValue
+	return o
 }
 
+// IsConstituentSnapshot is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) IsConstituentSnapshot() bool {
-    r := *o.IsConstituentSnapshotPtr
-    return r
+	r := *o.IsConstituentSnapshotPtr
+	return r
 }
 
+// SetIsConstituentSnapshot is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetIsConstituentSnapshot(newValue bool) *SnapshotInfoType {
-    o.IsConstituentSnapshotPtr = &newValue
-    return o
+	o.IsConstituentSnapshotPtr = &newValue
+	return o
 }
 
+// Name is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Name() string {
-    r := *o.NamePtr
-    return r
+	r := *o.NamePtr
+	return r
 }
 
+// SetName is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetName(newValue string) *SnapshotInfoType {
-    o.NamePtr = &newValue
-    return o
+	o.NamePtr = &newValue
+	return o
 }
 
+// PercentageOfTotalBlocks is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) PercentageOfTotalBlocks() int {
-    r := *o.PercentageOfTotalBlocksPtr
-    return r
+	r := *o.PercentageOfTotalBlocksPtr
+	return r
 }
 
+// SetPercentageOfTotalBlocks is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetPercentageOfTotalBlocks(newValue int) *SnapshotInfoType {
-    o.PercentageOfTotalBlocksPtr = &newValue
-    return o
+	o.PercentageOfTotalBlocksPtr = &newValue
+	return o
 }
 
+// PercentageOfUsedBlocks is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) PercentageOfUsedBlocks() int {
-    r := *o.PercentageOfUsedBlocksPtr
-    return r
+	r := *o.PercentageOfUsedBlocksPtr
+	return r
 }
 
+// SetPercentageOfUsedBlocks is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetPercentageOfUsedBlocks(newValue int) *SnapshotInfoType {
-    o.PercentageOfUsedBlocksPtr = &newValue
-    return o
+	o.PercentageOfUsedBlocksPtr = &newValue
+	return o
 }
 
+// SnapmirrorLabel is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) SnapmirrorLabel() string {
-    r := *o.SnapmirrorLabelPtr
-    return r
+	r := *o.SnapmirrorLabelPtr
+	return r
 }
 
+// SetSnapmirrorLabel is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetSnapmirrorLabel(newValue string) *SnapshotInfoType {
-    o.SnapmirrorLabelPtr = &newValue
-    return o
+	o.SnapmirrorLabelPtr = &newValue
+	return o
 }
 
+// SnapshotInstanceUuid is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) SnapshotInstanceUuid() UUIDType {
-    r := *o.SnapshotInstanceUuidPtr
-    return r
+	r := *o.SnapshotInstanceUuidPtr
+	return r
 }
 
+// SetSnapshotInstanceUuid is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetSnapshotInstanceUuid(newValue UUIDType) *SnapshotInfoType {
-    o.SnapshotInstanceUuidPtr = &newValue
-    return o
+	o.SnapshotInstanceUuidPtr = &newValue
+	return o
 }
 
+// SnapshotOwnersList is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) SnapshotOwnersList() []SnapshotOwnerType {
-    r := o.SnapshotOwnersListPtr
-    return r
+	r := o.SnapshotOwnersListPtr
+	return r
 }
 
+// SetSnapshotOwnersList is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetSnapshotOwnersList(newValue []SnapshotOwnerType) *SnapshotInfoType {
-    newSlice := make([]SnapshotOwnerType, len(newValue))
-    copy(newSlice, newValue)
-    o.SnapshotOwnersListPtr = newSlice
-    return o
+	newSlice := make([]SnapshotOwnerType, len(newValue))
+	copy(newSlice, newValue)
+	o.SnapshotOwnersListPtr = newSlice
+	return o
 }
 
+// SnapshotVersionUuid is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) SnapshotVersionUuid() UUIDType {
-    r := *o.SnapshotVersionUuidPtr
-    return r
+	r := *o.SnapshotVersionUuidPtr
+	return r
 }
 
+// SetSnapshotVersionUuid is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetSnapshotVersionUuid(newValue UUIDType) *SnapshotInfoType {
-    o.SnapshotVersionUuidPtr = &newValue
-    return o
+	o.SnapshotVersionUuidPtr = &newValue
+	return o
 }
 
+// State is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) State() string {
-    r := *o.StatePtr
-    return r
+	r := *o.StatePtr
+	return r
 }
 
+// SetState is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetState(newValue string) *SnapshotInfoType {
-    o.StatePtr = &newValue
-    return o
+	o.StatePtr = &newValue
+	return o
 }
 
+// Total is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Total() int {
-    r := *o.TotalPtr
-    return r
+	r := *o.TotalPtr
+	return r
 }
 
+// SetTotal is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetTotal(newValue int) *SnapshotInfoType {
-    o.TotalPtr = &newValue
-    return o
+	o.TotalPtr = &newValue
+	return o
 }
 
+// Volume is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Volume() string {
-    r := *o.VolumePtr
-    return r
+	r := *o.VolumePtr
+	return r
 }
 
+// SetVolume is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetVolume(newValue string) *SnapshotInfoType {
-    o.VolumePtr = &newValue
-    return o
+	o.VolumePtr = &newValue
+	return o
 }
 
+// VolumeProvenanceUuid is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) VolumeProvenanceUuid() UUIDType {
-    r := *o.VolumeProvenanceUuidPtr
-    return r
+	r := *o.VolumeProvenanceUuidPtr
+	return r
 }
 
+// SetVolumeProvenanceUuid is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetVolumeProvenanceUuid(newValue UUIDType) *SnapshotInfoType {
-    o.VolumeProvenanceUuidPtr = &newValue
-    return o
+	o.VolumeProvenanceUuidPtr = &newValue
+	return o
 }
 
+// Vserver is a fluent style 'getter' method that can be chained
 func (o *SnapshotInfoType) Vserver() string {
-    r := *o.VserverPtr
-    return r
+	r := *o.VserverPtr
+	return r
 }
 
+// SetVserver is a fluent style 'setter' method that can be chained
 func (o *SnapshotInfoType) SetVserver(newValue string) *SnapshotInfoType {
-    o.VserverPtr = &newValue
-    return o
+	o.VserverPtr = &newValue
+	return o
 }
 
+// SnapshotOwnerType is a structure to represent a snapshot-owner ZAPI object
 type SnapshotOwnerType struct {
-    XMLName xml.Name `xml:"snapshot-owner"`
+	XMLName xml.Name `xml:"snapshot-owner"`
 
-    OwnerPtr *string `xml:"owner"`
+	OwnerPtr *string `xml:"owner"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *SnapshotOwnerType) ToXML() (string, error) {
-    output, err := xml.MarshalIndent(o, " ", "    ")
-    if err != nil { fmt.Printf("error: %v\n", err) } // TODO: handle better
-    //fmt.Println(string(output))
-    return string(output), err
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
 }
 
+// NewSnapshotOwnerType is a factory method for creating new instances of SnapshotOwnerType objects
 func NewSnapshotOwnerType() *SnapshotOwnerType { return &SnapshotOwnerType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o SnapshotOwnerType) String() string {
-    var buffer bytes.Buffer
-    if o.OwnerPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "owner", *o.OwnerPtr)) } else { buffer.WriteString(fmt.Sprintf("owner: nil\n")) }
-    return buffer.String()
+	var buffer bytes.Buffer
+	if o.OwnerPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "owner", *o.OwnerPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("owner: nil\n"))
+	}
+	return buffer.String()
 }
 
+// Owner is a fluent style 'getter' method that can be chained
 func (o *SnapshotOwnerType) Owner() string {
-    r := *o.OwnerPtr
-    return r
+	r := *o.OwnerPtr
+	return r
 }
 
+// SetOwner is a fluent style 'setter' method that can be chained
 func (o *SnapshotOwnerType) SetOwner(newValue string) *SnapshotOwnerType {
-    o.OwnerPtr = &newValue
-    return o
+	o.OwnerPtr = &newValue
+	return o
 }
 
+// UUIDType is a ZAPI type represented as a string
 type UUIDType string
 
+// VolumeErrorType is a structure to represent a volume-error ZAPI object
 type VolumeErrorType struct {
-    XMLName xml.Name `xml:"volume-error"`
+	XMLName xml.Name `xml:"volume-error"`
 
-    ErrnoPtr *int `xml:"errno"`
-    NamePtr *VolumeNameType `xml:"name"`
-    ReasonPtr *string `xml:"reason"`
-    VserverPtr *string `xml:"vserver"`
+	ErrnoPtr   *int            `xml:"errno"`
+	NamePtr    *VolumeNameType `xml:"name"`
+	ReasonPtr  *string         `xml:"reason"`
+	VserverPtr *string         `xml:"vserver"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeErrorType) ToXML() (string, error) {
-    output, err := xml.MarshalIndent(o, " ", "    ")
-    if err != nil { fmt.Printf("error: %v\n", err) } // TODO: handle better
-    //fmt.Println(string(output))
-    return string(output), err
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
 }
 
+// NewVolumeErrorType is a factory method for creating new instances of VolumeErrorType objects
 func NewVolumeErrorType() *VolumeErrorType { return &VolumeErrorType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeErrorType) String() string {
-    var buffer bytes.Buffer
-    if o.ErrnoPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "errno", *o.ErrnoPtr)) } else { buffer.WriteString(fmt.Sprintf("errno: nil\n")) }
-    if o.NamePtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "name", *o.NamePtr)) } else { buffer.WriteString(fmt.Sprintf("name: nil\n")) }
-    if o.ReasonPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "reason", *o.ReasonPtr)) } else { buffer.WriteString(fmt.Sprintf("reason: nil\n")) }
-    if o.VserverPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr)) } else { buffer.WriteString(fmt.Sprintf("vserver: nil\n")) }
-    return buffer.String()
+	var buffer bytes.Buffer
+	if o.ErrnoPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "errno", *o.ErrnoPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("errno: nil\n"))
+	}
+	if o.NamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "name", *o.NamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("name: nil\n"))
+	}
+	if o.ReasonPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "reason", *o.ReasonPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("reason: nil\n"))
+	}
+	if o.VserverPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("vserver: nil\n"))
+	}
+	return buffer.String()
 }
 
+// Errno is a fluent style 'getter' method that can be chained
 func (o *VolumeErrorType) Errno() int {
-    r := *o.ErrnoPtr
-    return r
+	r := *o.ErrnoPtr
+	return r
 }
 
+// SetErrno is a fluent style 'setter' method that can be chained
 func (o *VolumeErrorType) SetErrno(newValue int) *VolumeErrorType {
-    o.ErrnoPtr = &newValue
-    return o
+	o.ErrnoPtr = &newValue
+	return o
 }
 
+// Name is a fluent style 'getter' method that can be chained
 func (o *VolumeErrorType) Name() VolumeNameType {
-    r := *o.NamePtr
-    return r
+	r := *o.NamePtr
+	return r
 }
 
+// SetName is a fluent style 'setter' method that can be chained
 func (o *VolumeErrorType) SetName(newValue VolumeNameType) *VolumeErrorType {
-    o.NamePtr = &newValue
-    return o
+	o.NamePtr = &newValue
+	return o
 }
 
+// Reason is a fluent style 'getter' method that can be chained
 func (o *VolumeErrorType) Reason() string {
-    r := *o.ReasonPtr
-    return r
+	r := *o.ReasonPtr
+	return r
 }
 
+// SetReason is a fluent style 'setter' method that can be chained
 func (o *VolumeErrorType) SetReason(newValue string) *VolumeErrorType {
-    o.ReasonPtr = &newValue
-    return o
+	o.ReasonPtr = &newValue
+	return o
 }
 
+// Vserver is a fluent style 'getter' method that can be chained
 func (o *VolumeErrorType) Vserver() string {
-    r := *o.VserverPtr
-    return r
+	r := *o.VserverPtr
+	return r
 }
 
+// SetVserver is a fluent style 'setter' method that can be chained
 func (o *VolumeErrorType) SetVserver(newValue string) *VolumeErrorType {
-    o.VserverPtr = &newValue
-    return o
+	o.VserverPtr = &newValue
+	return o
 }
 
+// BlockRangeType is a structure to represent a block-range ZAPI object
 type BlockRangeType struct {
-    XMLName xml.Name `xml:"block-range"`
+	XMLName xml.Name `xml:"block-range"`
 
-    BlockCountPtr *int `xml:"block-count"`
-    DestinationBlockNumberPtr *int `xml:"destination-block-number"`
-    SourceBlockNumberPtr *int `xml:"source-block-number"`
+	BlockCountPtr             *int `xml:"block-count"`
+	DestinationBlockNumberPtr *int `xml:"destination-block-number"`
+	SourceBlockNumberPtr      *int `xml:"source-block-number"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *BlockRangeType) ToXML() (string, error) {
-    output, err := xml.MarshalIndent(o, " ", "    ")
-    if err != nil { fmt.Printf("error: %v\n", err) } // TODO: handle better
-    //fmt.Println(string(output))
-    return string(output), err
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
 }
 
+// NewBlockRangeType is a factory method for creating new instances of BlockRangeType objects
 func NewBlockRangeType() *BlockRangeType { return &BlockRangeType{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o BlockRangeType) String() string {
-    var buffer bytes.Buffer
-    if o.BlockCountPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "block-count", *o.BlockCountPtr)) } else { buffer.WriteString(fmt.Sprintf("block-count: nil\n")) }
-    if o.DestinationBlockNumberPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "destination-block-number", *o.DestinationBlockNumberPtr)) } else { buffer.WriteString(fmt.Sprintf("destination-block-number: nil\n")) }
-    if o.SourceBlockNumberPtr != nil { buffer.WriteString(fmt.Sprintf("%s: %v\n", "source-block-number", *o.SourceBlockNumberPtr)) } else { buffer.WriteString(fmt.Sprintf("source-block-number: nil\n")) }
-    return buffer.String()
+	var buffer bytes.Buffer
+	if o.BlockCountPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "block-count", *o.BlockCountPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("block-count: nil\n"))
+	}
+	if o.DestinationBlockNumberPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "destination-block-number", *o.DestinationBlockNumberPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("destination-block-number: nil\n"))
+	}
+	if o.SourceBlockNumberPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "source-block-number", *o.SourceBlockNumberPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("source-block-number: nil\n"))
+	}
+	return buffer.String()
 }
 
+// BlockCount is a fluent style 'getter' method that can be chained
 func (o *BlockRangeType) BlockCount() int {
-    r := *o.BlockCountPtr
-    return r
+	r := *o.BlockCountPtr
+	return r
 }
 
+// SetBlockCount is a fluent style 'setter' method that can be chained
 func (o *BlockRangeType) SetBlockCount(newValue int) *BlockRangeType {
-    o.BlockCountPtr = &newValue
-    return o
+	o.BlockCountPtr = &newValue
+	return o
 }
 
+// DestinationBlockNumber is a fluent style 'getter' method that can be chained
 func (o *BlockRangeType) DestinationBlockNumber() int {
-    r := *o.DestinationBlockNumberPtr
-    return r
+	r := *o.DestinationBlockNumberPtr
+	return r
 }
 
+// SetDestinationBlockNumber is a fluent style 'setter' method that can be chained
 func (o *BlockRangeType) SetDestinationBlockNumber(newValue int) *BlockRangeType {
-    o.DestinationBlockNumberPtr = &newValue
-    return o
+	o.DestinationBlockNumberPtr = &newValue
+	return o
 }
 
+// SourceBlockNumber is a fluent style 'getter' method that can be chained
 func (o *BlockRangeType) SourceBlockNumber() int {
-    r := *o.SourceBlockNumberPtr
-    return r
+	r := *o.SourceBlockNumberPtr
+	return r
 }
 
+// SetSourceBlockNumber is a fluent style 'setter' method that can be chained
 func (o *BlockRangeType) SetSourceBlockNumber(newValue int) *BlockRangeType {
-    o.SourceBlockNumberPtr = &newValue
-    return o
-}
\ No newline at end of file
+	o.SourceBlockNumberPtr = &newValue
+	return o
+}
+
+// VolumeCloneInfoType is a structure to represent a volume-clone-info ZAPI object
+type VolumeCloneInfoType struct {
+	XMLName xml.Name `xml:"volume-clone-info"`
+
+	BlockPercentageCompletePtr *int    `xml:"block-percentage-complete"`
+	BlocksScannedPtr           *int    `xml:"blocks-scanned"`
+	BlocksUpdatedPtr           *int    `xml:"blocks-updated"`
+	InodePercentageCompletePtr *int    `xml:"inode-percentage-complete"`
+	InodesProcessedPtr         *int    `xml:"inodes-processed"`
+	InodesTotalPtr             *int    `xml:"inodes-total"`
+	JunctionActivePtr          *bool   `xml:"junction-active"`
+	JunctionPathPtr            *string `xml:"junction-path"`
+	ParentSnapshotPtr          *string `xml:"parent-snapshot"`
+	ParentVolumePtr            *string `xml:"parent-volume"`
+	SpaceReservePtr            *string `xml:"space-reserve"`
+	SplitEstimatePtr           *int    `xml:"split-estimate"`
+	VolumePtr                  *string `xml:"volume"`
+	VolumeTypePtr              *string `xml:"volume-type"`
+	VserverPtr                 *string `xml:"vserver"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *VolumeCloneInfoType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewVolumeCloneInfoType is a factory method for creating new instances of VolumeCloneInfoType objects
+func NewVolumeCloneInfoType() *VolumeCloneInfoType { return &VolumeCloneInfoType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o VolumeCloneInfoType) String() string {
+	var buffer bytes.Buffer
+	if o.BlockPercentageCompletePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "block-percentage-complete", *o.BlockPercentageCompletePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("block-percentage-complete: nil\n"))
+	}
+	if o.BlocksScannedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "blocks-scanned", *o.BlocksScannedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("blocks-scanned: nil\n"))
+	}
+	if o.BlocksUpdatedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "blocks-updated", *o.BlocksUpdatedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("blocks-updated: nil\n"))
+	}
+	if o.InodePercentageCompletePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "inode-percentage-complete", *o.InodePercentageCompletePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("inode-percentage-complete: nil\n"))
+	}
+	if o.InodesProcessedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "inodes-processed", *o.InodesProcessedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("inodes-processed: nil\n"))
+	}
+	if o.InodesTotalPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "inodes-total", *o.InodesTotalPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("inodes-total: nil\n"))
+	}
+	if o.JunctionActivePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "junction-active", *o.JunctionActivePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("junction-active: nil\n"))
+	}
+	if o.JunctionPathPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "junction-path", *o.JunctionPathPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("junction-path: nil\n"))
+	}
+	if o.ParentSnapshotPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "parent-snapshot", *o.ParentSnapshotPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("parent-snapshot: nil\n"))
+	}
+	if o.ParentVolumePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "parent-volume", *o.ParentVolumePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("parent-volume: nil\n"))
+	}
+	if o.SpaceReservePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "space-reserve", *o.SpaceReservePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("space-reserve: nil\n"))
+	}
+	if o.SplitEstimatePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "split-estimate", *o.SplitEstimatePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("split-estimate: nil\n"))
+	}
+	if o.VolumePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume", *o.VolumePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("volume: nil\n"))
+	}
+	if o.VolumeTypePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume-type", *o.VolumeTypePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("volume-type: nil\n"))
+	}
+	if o.VserverPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("vserver: nil\n"))
+	}
+	return buffer.String()
+}
+
+// BlockPercentageComplete is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) BlockPercentageComplete() int {
+	r := *o.BlockPercentageCompletePtr
+	return r
+}
+
+// SetBlockPercentageComplete is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetBlockPercentageComplete(newValue int) *VolumeCloneInfoType {
+	o.BlockPercentageCompletePtr = &newValue
+	return o
+}
+
+// BlocksScanned is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) BlocksScanned() int {
+	r := *o.BlocksScannedPtr
+	return r
+}
+
+// SetBlocksScanned is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetBlocksScanned(newValue int) *VolumeCloneInfoType {
+	o.BlocksScannedPtr = &newValue
+	return o
+}
+
+// BlocksUpdated is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) BlocksUpdated() int {
+	r := *o.BlocksUpdatedPtr
+	return r
+}
+
+// SetBlocksUpdated is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetBlocksUpdated(newValue int) *VolumeCloneInfoType {
+	o.BlocksUpdatedPtr = &newValue
+	return o
+}
+
+// InodePercentageComplete is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) InodePercentageComplete() int {
+	r := *o.InodePercentageCompletePtr
+	return r
+}
+
+// SetInodePercentageComplete is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetInodePercentageComplete(newValue int) *VolumeCloneInfoType {
+	o.InodePercentageCompletePtr = &newValue
+	return o
+}
+
+// InodesProcessed is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) InodesProcessed() int {
+	r := *o.InodesProcessedPtr
+	return r
+}
+
+// SetInodesProcessed is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetInodesProcessed(newValue int) *VolumeCloneInfoType {
+	o.InodesProcessedPtr = &newValue
+	return o
+}
+
+// InodesTotal is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) InodesTotal() int {
+	r := *o.InodesTotalPtr
+	return r
+}
+
+// SetInodesTotal is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetInodesTotal(newValue int) *VolumeCloneInfoType {
+	o.InodesTotalPtr = &newValue
+	return o
+}
+
+// JunctionActive is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) JunctionActive() bool {
+	r := *o.JunctionActivePtr
+	return r
+}
+
+// SetJunctionActive is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetJunctionActive(newValue bool) *VolumeCloneInfoType {
+	o.JunctionActivePtr = &newValue
+	return o
+}
+
+// JunctionPath is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) JunctionPath() string {
+	r := *o.JunctionPathPtr
+	return r
+}
+
+// SetJunctionPath is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetJunctionPath(newValue string) *VolumeCloneInfoType {
+	o.JunctionPathPtr = &newValue
+	return o
+}
+
+// ParentSnapshot is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) ParentSnapshot() string {
+	r := *o.ParentSnapshotPtr
+	return r
+}
+
+// SetParentSnapshot is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetParentSnapshot(newValue string) *VolumeCloneInfoType {
+	o.ParentSnapshotPtr = &newValue
+	return o
+}
+
+// ParentVolume is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) ParentVolume() string {
+	r := *o.ParentVolumePtr
+	return r
+}
+
+// SetParentVolume is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetParentVolume(newValue string) *VolumeCloneInfoType {
+	o.ParentVolumePtr = &newValue
+	return o
+}
+
+// SpaceReserve is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) SpaceReserve() string {
+	r := *o.SpaceReservePtr
+	return r
+}
+
+// SetSpaceReserve is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetSpaceReserve(newValue string) *VolumeCloneInfoType {
+	o.SpaceReservePtr = &newValue
+	return o
+}
+
+// SplitEstimate is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) SplitEstimate() int {
+	r := *o.SplitEstimatePtr
+	return r
+}
+
+// SetSplitEstimate is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetSplitEstimate(newValue int) *VolumeCloneInfoType {
+	o.SplitEstimatePtr = &newValue
+	return o
+}
+
+// Volume is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) Volume() string {
+	r := *o.VolumePtr
+	return r
+}
+
+// SetVolume is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetVolume(newValue string) *VolumeCloneInfoType {
+	o.VolumePtr = &newValue
+	return o
+}
+
+// VolumeType is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) VolumeType() string {
+	r := *o.VolumeTypePtr
+	return r
+}
+
+// SetVolumeType is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetVolumeType(newValue string) *VolumeCloneInfoType {
+	o.VolumeTypePtr = &newValue
+	return o
+}
+
+// Vserver is a fluent style 'getter' method that can be chained
+func (o *VolumeCloneInfoType) Vserver() string {
+	r := *o.VserverPtr
+	return r
+}
+
+// SetVserver is a fluent style 'setter' method that can be chained
+func (o *VolumeCloneInfoType) SetVserver(newValue string) *VolumeCloneInfoType {
+	o.VserverPtr = &newValue
+	return o
+}
+
+// CloneSplitDetailInfoType is a structure to represent a clone-split-detail-info ZAPI object
+type CloneSplitDetailInfoType struct {
+	XMLName xml.Name `xml:"clone-split-detail-info"`
+
+	BlockPercentageCompletePtr *int    `xml:"block-percentage-complete"`
+	BlocksScannedPtr           *int    `xml:"blocks-scanned"`
+	BlocksUpdatedPtr           *int    `xml:"blocks-updated"`
+	InodePercentageCompletePtr *int    `xml:"inode-percentage-complete"`
+	InodesProcessedPtr         *int    `xml:"inodes-processed"`
+	InodesTotalPtr             *int    `xml:"inodes-total"`
+	NamePtr                    *string `xml:"name"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *CloneSplitDetailInfoType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewCloneSplitDetailInfoType is a factory method for creating new instances of CloneSplitDetailInfoType objects
+func NewCloneSplitDetailInfoType() *CloneSplitDetailInfoType { return &CloneSplitDetailInfoType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o CloneSplitDetailInfoType) String() string {
+	var buffer bytes.Buffer
+	if o.BlockPercentageCompletePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "block-percentage-complete", *o.BlockPercentageCompletePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("block-percentage-complete: nil\n"))
+	}
+	if o.BlocksScannedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "blocks-scanned", *o.BlocksScannedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("blocks-scanned: nil\n"))
+	}
+	if o.BlocksUpdatedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "blocks-updated", *o.BlocksUpdatedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("blocks-updated: nil\n"))
+	}
+	if o.InodePercentageCompletePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "inode-percentage-complete", *o.InodePercentageCompletePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("inode-percentage-complete: nil\n"))
+	}
+	if o.InodesProcessedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "inodes-processed", *o.InodesProcessedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("inodes-processed: nil\n"))
+	}
+	if o.InodesTotalPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "inodes-total", *o.InodesTotalPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("inodes-total: nil\n"))
+	}
+	if o.NamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "name", *o.NamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("name: nil\n"))
+	}
+	return buffer.String()
+}
+
+// BlockPercentageComplete is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) BlockPercentageComplete() int {
+	r := *o.BlockPercentageCompletePtr
+	return r
+}
+
+// SetBlockPercentageComplete is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetBlockPercentageComplete(newValue int) *CloneSplitDetailInfoType {
+	o.BlockPercentageCompletePtr = &newValue
+	return o
+}
+
+// BlocksScanned is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) BlocksScanned() int {
+	r := *o.BlocksScannedPtr
+	return r
+}
+
+// SetBlocksScanned is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetBlocksScanned(newValue int) *CloneSplitDetailInfoType {
+	o.BlocksScannedPtr = &newValue
+	return o
+}
+
+// BlocksUpdated is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) BlocksUpdated() int {
+	r := *o.BlocksUpdatedPtr
+	return r
+}
+
+// SetBlocksUpdated is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetBlocksUpdated(newValue int) *CloneSplitDetailInfoType {
+	o.BlocksUpdatedPtr = &newValue
+	return o
+}
+
+// InodePercentageComplete is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) InodePercentageComplete() int {
+	r := *o.InodePercentageCompletePtr
+	return r
+}
+
+// SetInodePercentageComplete is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetInodePercentageComplete(newValue int) *CloneSplitDetailInfoType {
+	o.InodePercentageCompletePtr = &newValue
+	return o
+}
+
+// InodesProcessed is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) InodesProcessed() int {
+	r := *o.InodesProcessedPtr
+	return r
+}
+
+// SetInodesProcessed is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetInodesProcessed(newValue int) *CloneSplitDetailInfoType {
+	o.InodesProcessedPtr = &newValue
+	return o
+}
+
+// InodesTotal is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) InodesTotal() int {
+	r := *o.InodesTotalPtr
+	return r
+}
+
+// SetInodesTotal is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetInodesTotal(newValue int) *CloneSplitDetailInfoType {
+	o.InodesTotalPtr = &newValue
+	return o
+}
+
+// Name is a fluent style 'getter' method that can be chained
+func (o *CloneSplitDetailInfoType) Name() string {
+	r := *o.NamePtr
+	return r
+}
+
+// SetName is a fluent style 'setter' method that can be chained
+func (o *CloneSplitDetailInfoType) SetName(newValue string) *CloneSplitDetailInfoType {
+	o.NamePtr = &newValue
+	return o
+}
+
+// ShowAggregatesType is a structure to represent a show-aggregates ZAPI object
+type ShowAggregatesType struct {
+	XMLName xml.Name `xml:"show-aggregates"`
+
+	AggregateNamePtr *AggrNameType `xml:"aggregate-name"`
+	AggregateTypePtr *string       `xml:"aggregate-type"`
+	AvailableSizePtr *SizeType     `xml:"available-size"`
+	IsNveCapablePtr  *bool         `xml:"is-nve-capable"`
+	SnaplockTypePtr  *string       `xml:"snaplock-type"`
+	VserverNamePtr   *string       `xml:"vserver-name"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *ShowAggregatesType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewShowAggregatesType is a factory method for creating new instances of ShowAggregatesType objects
+func NewShowAggregatesType() *ShowAggregatesType { return &ShowAggregatesType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o ShowAggregatesType) String() string {
+	var buffer bytes.Buffer
+	if o.AggregateNamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "aggregate-name", *o.AggregateNamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("aggregate-name: nil\n"))
+	}
+	if o.AggregateTypePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "aggregate-type", *o.AggregateTypePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("aggregate-type: nil\n"))
+	}
+	if o.AvailableSizePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "available-size", *o.AvailableSizePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("available-size: nil\n"))
+	}
+	if o.IsNveCapablePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "is-nve-capable", *o.IsNveCapablePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("is-nve-capable: nil\n"))
+	}
+	if o.SnaplockTypePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "snaplock-type", *o.SnaplockTypePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("snaplock-type: nil\n"))
+	}
+	if o.VserverNamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver-name", *o.VserverNamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("vserver-name: nil\n"))
+	}
+	return buffer.String()
+}
+
+// AggregateName is a fluent style 'getter' method that can be chained
+func (o *ShowAggregatesType) AggregateName() AggrNameType {
+	r := *o.AggregateNamePtr
+	return r
+}
+
+// SetAggregateName is a fluent style 'setter' method that can be chained
+func (o *ShowAggregatesType) SetAggregateName(newValue AggrNameType) *ShowAggregatesType {
+	o.AggregateNamePtr = &newValue
+	return o
+}
+
+// AggregateType is a fluent style 'getter' method that can be chained
+func (o *ShowAggregatesType) AggregateType() string {
+	r := *o.AggregateTypePtr
+	return r
+}
+
+// SetAggregateType is a fluent style 'setter' method that can be chained
+func (o *ShowAggregatesType) SetAggregateType(newValue string) *ShowAggregatesType {
+	o.AggregateTypePtr = &newValue
+	return o
+}
+
+// AvailableSize is a fluent style 'getter' method that can be chained
+func (o *ShowAggregatesType) AvailableSize() SizeType {
+	r := *o.AvailableSizePtr
+	return r
+}
+
+// SetAvailableSize is a fluent style 'setter' method that can be chained
+func (o *ShowAggregatesType) SetAvailableSize(newValue SizeType) *ShowAggregatesType {
+	o.AvailableSizePtr = &newValue
+	return o
+}
+
+// IsNveCapable is a fluent style 'getter' method that can be chained
+func (o *ShowAggregatesType) IsNveCapable() bool {
+	r := *o.IsNveCapablePtr
+	return r
+}
+
+// SetIsNveCapable is a fluent style 'setter' method that can be chained
+func (o *ShowAggregatesType) SetIsNveCapable(newValue bool) *ShowAggregatesType {
+	o.IsNveCapablePtr = &newValue
+	return o
+}
+
+// SnaplockType is a fluent style 'getter' method that can be chained
+func (o *ShowAggregatesType) SnaplockType() string {
+	r := *o.SnaplockTypePtr
+	return r
+}
+
+// SetSnaplockType is a fluent style 'setter' method that can be chained
+func (o *ShowAggregatesType) SetSnaplockType(newValue string) *ShowAggregatesType {
+	o.SnaplockTypePtr = &newValue
+	return o
+}
+
+// VserverName is a fluent style 'getter' method that can be chained
+func (o *ShowAggregatesType) VserverName() string {
+	r := *o.VserverNamePtr
+	return r
+}
+
+// SetVserverName is a fluent style 'setter' method that can be chained
+func (o *ShowAggregatesType) SetVserverName(newValue string) *ShowAggregatesType {
+	o.VserverNamePtr = &newValue
+	return o
+}
+
+// AggrSpaceAttributesType is a structure to represent a aggr-space-attributes ZAPI object
+type AggrSpaceAttributesType struct {
+	XMLName xml.Name `xml:"aggr-space-attributes"`
+
+	PercentUsedCapacityPtr *string `xml:"percent-used-capacity"`
+	SizeAvailablePtr       *int    `xml:"size-available"`
+	SizeTotalPtr           *int    `xml:"size-total"`
+	SizeUsedPtr            *int    `xml:"size-used"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *AggrSpaceAttributesType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewAggrSpaceAttributesType is a factory method for creating new instances of AggrSpaceAttributesType objects
+func NewAggrSpaceAttributesType() *AggrSpaceAttributesType { return &AggrSpaceAttributesType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o AggrSpaceAttributesType) String() string {
+	var buffer bytes.Buffer
+	if o.PercentUsedCapacityPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "percent-used-capacity", *o.PercentUsedCapacityPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("percent-used-capacity: nil\n"))
+	}
+	if o.SizeAvailablePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "size-available", *o.SizeAvailablePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("size-available: nil\n"))
+	}
+	if o.SizeTotalPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "size-total", *o.SizeTotalPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("size-total: nil\n"))
+	}
+	if o.SizeUsedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "size-used", *o.SizeUsedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("size-used: nil\n"))
+	}
+	return buffer.String()
+}
+
+// PercentUsedCapacity is a fluent style 'getter' method that can be chained
+func (o *AggrSpaceAttributesType) PercentUsedCapacity() string {
+	r := *o.PercentUsedCapacityPtr
+	return r
+}
+
+// SetPercentUsedCapacity is a fluent style 'setter' method that can be chained
+func (o *AggrSpaceAttributesType) SetPercentUsedCapacity(newValue string) *AggrSpaceAttributesType {
+	o.PercentUsedCapacityPtr = &newValue
+	return o
+}
+
+// SizeAvailable is a fluent style 'getter' method that can be chained
+func (o *AggrSpaceAttributesType) SizeAvailable() int {
+	r := *o.SizeAvailablePtr
+	return r
+}
+
+// SetSizeAvailable is a fluent style 'setter' method that can be chained
+func (o *AggrSpaceAttributesType) SetSizeAvailable(newValue int) *AggrSpaceAttributesType {
+	o.SizeAvailablePtr = &newValue
+	return o
+}
+
+// SizeTotal is a fluent style 'getter' method that can be chained
+func (o *AggrSpaceAttributesType) SizeTotal() int {
+	r := *o.SizeTotalPtr
+	return r
+}
+
+// SetSizeTotal is a fluent style 'setter' method that can be chained
+func (o *AggrSpaceAttributesType) SetSizeTotal(newValue int) *AggrSpaceAttributesType {
+	o.SizeTotalPtr = &newValue
+	return o
+}
+
+// SizeUsed is a fluent style 'getter' method that can be chained
+func (o *AggrSpaceAttributesType) SizeUsed() int {
+	r := *o.SizeUsedPtr
+	return r
+}
+
+// SetSizeUsed is a fluent style 'setter' method that can be chained
+func (o *AggrSpaceAttributesType) SetSizeUsed(newValue int) *AggrSpaceAttributesType {
+	o.SizeUsedPtr = &newValue
+	return o
+}
+
+// AggrAttributesType is a structure to represent a aggr-attributes ZAPI object
+type AggrAttributesType struct {
+	XMLName xml.Name `xml:"aggr-attributes"`
+
+	AggrSpaceAttributesPtr *AggrSpaceAttributesType `xml:"aggr-space-attributes"`
+	AggregateNamePtr       *string                  `xml:"aggregate-name"`
+	AggregateUuidPtr       *string                  `xml:"aggregate-uuid"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *AggrAttributesType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewAggrAttributesType is a factory method for creating new instances of AggrAttributesType objects
+func NewAggrAttributesType() *AggrAttributesType { return &AggrAttributesType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o AggrAttributesType) String() string {
+	var buffer bytes.Buffer
+	if o.AggrSpaceAttributesPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "aggr-space-attributes", *o.AggrSpaceAttributesPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("aggr-space-attributes: nil\n"))
+	}
+	if o.AggregateNamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "aggregate-name", *o.AggregateNamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("aggregate-name: nil\n"))
+	}
+	if o.AggregateUuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "aggregate-uuid", *o.AggregateUuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("aggregate-uuid: nil\n"))
+	}
+	return buffer.String()
+}
+
+// AggrSpaceAttributes is a fluent style 'getter' method that can be chained
+func (o *AggrAttributesType) AggrSpaceAttributes() AggrSpaceAttributesType {
+	r := *o.AggrSpaceAttributesPtr
+	return r
+}
+
+// SetAggrSpaceAttributes is a fluent style 'setter' method that can be chained
+func (o *AggrAttributesType) SetAggrSpaceAttributes(newValue AggrSpaceAttributesType) *AggrAttributesType {
+	o.AggrSpaceAttributesPtr = &newValue
+	return o
+}
+
+// AggregateName is a fluent style 'getter' method that can be chained
+func (o *AggrAttributesType) AggregateName() string {
+	r := *o.AggregateNamePtr
+	return r
+}
+
+// SetAggregateName is a fluent style 'setter' method that can be chained
+func (o *AggrAttributesType) SetAggregateName(newValue string) *AggrAttributesType {
+	o.AggregateNamePtr = &newValue
+	return o
+}
+
+// AggregateUuid is a fluent style 'getter' method that can be chained
+func (o *AggrAttributesType) AggregateUuid() string {
+	r := *o.AggregateUuidPtr
+	return r
+}
+
+// SetAggregateUuid is a fluent style 'setter' method that can be chained
+func (o *AggrAttributesType) SetAggregateUuid(newValue string) *AggrAttributesType {
+	o.AggregateUuidPtr = &newValue
+	return o
+}
+
+// QosPolicyGroupInfoType is a structure to represent a qos-policy-group-info ZAPI object
+type QosPolicyGroupInfoType struct {
+	XMLName xml.Name `xml:"qos-policy-group-info"`
+
+	MaxThroughputPtr    *string `xml:"max-throughput"`
+	NumWorkloadsPtr     *int    `xml:"num-workloads"`
+	PgidPtr             *int    `xml:"pgid"`
+	PolicyGroupPtr      *string `xml:"policy-group"`
+	PolicyGroupClassPtr *string `xml:"policy-group-class"`
+	UuidPtr             *string `xml:"uuid"`
+	VserverPtr          *string `xml:"vserver"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *QosPolicyGroupInfoType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewQosPolicyGroupInfoType is a factory method for creating new instances of QosPolicyGroupInfoType objects
+func NewQosPolicyGroupInfoType() *QosPolicyGroupInfoType { return &QosPolicyGroupInfoType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o QosPolicyGroupInfoType) String() string {
+	var buffer bytes.Buffer
+	if o.MaxThroughputPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "max-throughput", *o.MaxThroughputPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("max-throughput: nil\n"))
+	}
+	if o.NumWorkloadsPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "num-workloads", *o.NumWorkloadsPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("num-workloads: nil\n"))
+	}
+	if o.PgidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "pgid", *o.PgidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("pgid: nil\n"))
+	}
+	if o.PolicyGroupPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "policy-group", *o.PolicyGroupPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("policy-group: nil\n"))
+	}
+	if o.PolicyGroupClassPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "policy-group-class", *o.PolicyGroupClassPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("policy-group-class: nil\n"))
+	}
+	if o.UuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "uuid", *o.UuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("uuid: nil\n"))
+	}
+	if o.VserverPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("vserver: nil\n"))
+	}
+	return buffer.String()
+}
+
+// MaxThroughput is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) MaxThroughput() string {
+	r := *o.MaxThroughputPtr
+	return r
+}
+
+// SetMaxThroughput is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetMaxThroughput(newValue string) *QosPolicyGroupInfoType {
+	o.MaxThroughputPtr = &newValue
+	return o
+}
+
+// NumWorkloads is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) NumWorkloads() int {
+	r := *o.NumWorkloadsPtr
+	return r
+}
+
+// SetNumWorkloads is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetNumWorkloads(newValue int) *QosPolicyGroupInfoType {
+	o.NumWorkloadsPtr = &newValue
+	return o
+}
+
+// Pgid is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) Pgid() int {
+	r := *o.PgidPtr
+	return r
+}
+
+// SetPgid is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetPgid(newValue int) *QosPolicyGroupInfoType {
+	o.PgidPtr = &newValue
+	return o
+}
+
+// PolicyGroup is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) PolicyGroup() string {
+	r := *o.PolicyGroupPtr
+	return r
+}
+
+// SetPolicyGroup is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetPolicyGroup(newValue string) *QosPolicyGroupInfoType {
+	o.PolicyGroupPtr = &newValue
+	return o
+}
+
+// PolicyGroupClass is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) PolicyGroupClass() string {
+	r := *o.PolicyGroupClassPtr
+	return r
+}
+
+// SetPolicyGroupClass is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetPolicyGroupClass(newValue string) *QosPolicyGroupInfoType {
+	o.PolicyGroupClassPtr = &newValue
+	return o
+}
+
+// Uuid is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) Uuid() string {
+	r := *o.UuidPtr
+	return r
+}
+
+// SetUuid is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetUuid(newValue string) *QosPolicyGroupInfoType {
+	o.UuidPtr = &newValue
+	return o
+}
+
+// Vserver is a fluent style 'getter' method that can be chained
+func (o *QosPolicyGroupInfoType) Vserver() string {
+	r := *o.VserverPtr
+	return r
+}
+
+// SetVserver is a fluent style 'setter' method that can be chained
+func (o *QosPolicyGroupInfoType) SetVserver(newValue string) *QosPolicyGroupInfoType {
+	o.VserverPtr = &newValue
+	return o
+}
+
+// JobInfoType is a structure to represent a job-info ZAPI object
+type JobInfoType struct {
+	XMLName xml.Name `xml:"job-info"`
+
+	JobCategoryPtr    *string   `xml:"job-category"`
+	JobCompletionPtr  *string   `xml:"job-completion"`
+	JobDescriptionPtr *string   `xml:"job-description"`
+	JobEndTimePtr     *int      `xml:"job-end-time"`
+	JobIdPtr          *int      `xml:"job-id"`
+	JobNamePtr        *string   `xml:"job-name"`
+	JobNodePtr        *string   `xml:"job-node"`
+	JobProgressPtr    *string   `xml:"job-progress"`
+	JobQueueTimePtr   *int      `xml:"job-queue-time"`
+	JobStartTimePtr   *int      `xml:"job-start-time"`
+	JobStatePtr       *string   `xml:"job-state"`
+	JobStatusCodePtr  *int      `xml:"job-status-code"`
+	JobTypePtr        *string   `xml:"job-type"`
+	JobUuidPtr        *UUIDType `xml:"job-uuid"`
+	JobVserverPtr     *string   `xml:"job-vserver"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *JobInfoType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewJobInfoType is a factory method for creating new instances of JobInfoType objects
+func NewJobInfoType() *JobInfoType { return &JobInfoType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o JobInfoType) String() string {
+	var buffer bytes.Buffer
+	if o.JobCategoryPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-category", *o.JobCategoryPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-category: nil\n"))
+	}
+	if o.JobCompletionPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-completion", *o.JobCompletionPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-completion: nil\n"))
+	}
+	if o.JobDescriptionPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-description", *o.JobDescriptionPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-description: nil\n"))
+	}
+	if o.JobEndTimePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-end-time", *o.JobEndTimePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-end-time: nil\n"))
+	}
+	if o.JobIdPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-id", *o.JobIdPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-id: nil\n"))
+	}
+	if o.JobNamePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-name", *o.JobNamePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-name: nil\n"))
+	}
+	if o.JobNodePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-node", *o.JobNodePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-node: nil\n"))
+	}
+	if o.JobProgressPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-progress", *o.JobProgressPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-progress: nil\n"))
+	}
+	if o.JobQueueTimePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-queue-time", *o.JobQueueTimePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-queue-time: nil\n"))
+	}
+	if o.JobStartTimePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-start-time", *o.JobStartTimePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-start-time: nil\n"))
+	}
+	if o.JobStatePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-state", *o.JobStatePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-state: nil\n"))
+	}
+	if o.JobStatusCodePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-status-code", *o.JobStatusCodePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-status-code: nil\n"))
+	}
+	if o.JobTypePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-type", *o.JobTypePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-type: nil\n"))
+	}
+	if o.JobUuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-uuid", *o.JobUuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-uuid: nil\n"))
+	}
+	if o.JobVserverPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "job-vserver", *o.JobVserverPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("job-vserver: nil\n"))
+	}
+	return buffer.String()
+}
+
+// JobCategory is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobCategory() string {
+	r := *o.JobCategoryPtr
+	return r
+}
+
+// SetJobCategory is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobCategory(newValue string) *JobInfoType {
+	o.JobCategoryPtr = &newValue
+	return o
+}
+
+// JobCompletion is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobCompletion() string {
+	r := *o.JobCompletionPtr
+	return r
+}
+
+// SetJobCompletion is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobCompletion(newValue string) *JobInfoType {
+	o.JobCompletionPtr = &newValue
+	return o
+}
+
+// JobDescription is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobDescription() string {
+	r := *o.JobDescriptionPtr
+	return r
+}
+
+// SetJobDescription is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobDescription(newValue string) *JobInfoType {
+	o.JobDescriptionPtr = &newValue
+	return o
+}
+
+// JobEndTime is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobEndTime() int {
+	r := *o.JobEndTimePtr
+	return r
+}
+
+// SetJobEndTime is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobEndTime(newValue int) *JobInfoType {
+	o.JobEndTimePtr = &newValue
+	return o
+}
+
+// JobId is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobId() int {
+	r := *o.JobIdPtr
+	return r
+}
+
+// SetJobId is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobId(newValue int) *JobInfoType {
+	o.JobIdPtr = &newValue
+	return o
+}
+
+// JobName is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobName() string {
+	r := *o.JobNamePtr
+	return r
+}
+
+// SetJobName is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobName(newValue string) *JobInfoType {
+	o.JobNamePtr = &newValue
+	return o
+}
+
+// JobNode is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobNode() string {
+	r := *o.JobNodePtr
+	return r
+}
+
+// SetJobNode is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobNode(newValue string) *JobInfoType {
+	o.JobNodePtr = &newValue
+	return o
+}
+
+// JobProgress is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobProgress() string {
+	r := *o.JobProgressPtr
+	return r
+}
+
+// SetJobProgress is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobProgress(newValue string) *JobInfoType {
+	o.JobProgressPtr = &newValue
+	return o
+}
+
+// JobQueueTime is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobQueueTime() int {
+	r := *o.JobQueueTimePtr
+	return r
+}
+
+// SetJobQueueTime is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobQueueTime(newValue int) *JobInfoType {
+	o.JobQueueTimePtr = &newValue
+	return o
+}
+
+// JobStartTime is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobStartTime() int {
+	r := *o.JobStartTimePtr
+	return r
+}
+
+// SetJobStartTime is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobStartTime(newValue int) *JobInfoType {
+	o.JobStartTimePtr = &newValue
+	return o
+}
+
+// JobState is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobState() string {
+	r := *o.JobStatePtr
+	return r
+}
+
+// SetJobState is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobState(newValue string) *JobInfoType {
+	o.JobStatePtr = &newValue
+	return o
+}
+
+// JobStatusCode is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobStatusCode() int {
+	r := *o.JobStatusCodePtr
+	return r
+}
+
+// SetJobStatusCode is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobStatusCode(newValue int) *JobInfoType {
+	o.JobStatusCodePtr = &newValue
+	return o
+}
+
+// JobType is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobType() string {
+	r := *o.JobTypePtr
+	return r
+}
+
+// SetJobType is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobType(newValue string) *JobInfoType {
+	o.JobTypePtr = &newValue
+	return o
+}
+
+// JobUuid is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobUuid() UUIDType {
+	r := *o.JobUuidPtr
+	return r
+}
+
+// SetJobUuid is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobUuid(newValue UUIDType) *JobInfoType {
+	o.JobUuidPtr = &newValue
+	return o
+}
+
+// JobVserver is a fluent style 'getter' method that can be chained
+func (o *JobInfoType) JobVserver() string {
+	r := *o.JobVserverPtr
+	return r
+}
+
+// SetJobVserver is a fluent style 'setter' method that can be chained
+func (o *JobInfoType) SetJobVserver(newValue string) *JobInfoType {
+	o.JobVserverPtr = &newValue
+	return o
+}
+
+// VolumeModifyIterAsyncInfoType is a structure to represent a volume-modify-iter-async-info ZAPI object
+type VolumeModifyIterAsyncInfoType struct {
+	XMLName xml.Name `xml:"volume-modify-iter-async-info"`
+
+	ErrorCodePtr    *int                  `xml:"error-code"`
+	ErrorMessagePtr *string               `xml:"error-message"`
+	JobidPtr        *int                  `xml:"jobid"`
+	StatusPtr       *string               `xml:"status"`
+	VolumeKeyPtr    *VolumeAttributesType `xml:"volume-key"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *VolumeModifyIterAsyncInfoType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewVolumeModifyIterAsyncInfoType is a factory method for creating new instances of VolumeModifyIterAsyncInfoType objects
+func NewVolumeModifyIterAsyncInfoType() *VolumeModifyIterAsyncInfoType {
+	return &VolumeModifyIterAsyncInfoType{}
+}
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o VolumeModifyIterAsyncInfoType) String() string {
+	var buffer bytes.Buffer
+	if o.ErrorCodePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "error-code", *o.ErrorCodePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("error-code: nil\n"))
+	}
+	if o.ErrorMessagePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "error-message", *o.ErrorMessagePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("error-message: nil\n"))
+	}
+	if o.JobidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "jobid", *o.JobidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("jobid: nil\n"))
+	}
+	if o.StatusPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "status", *o.StatusPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("status: nil\n"))
+	}
+	if o.VolumeKeyPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume-key", *o.VolumeKeyPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("volume-key: nil\n"))
+	}
+	return buffer.String()
+}
+
+// ErrorCode is a fluent style 'getter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) ErrorCode() int {
+	r := *o.ErrorCodePtr
+	return r
+}
+
+// SetErrorCode is a fluent style 'setter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) SetErrorCode(newValue int) *VolumeModifyIterAsyncInfoType {
+	o.ErrorCodePtr = &newValue
+	return o
+}
+
+// ErrorMessage is a fluent style 'getter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) ErrorMessage() string {
+	r := *o.ErrorMessagePtr
+	return r
+}
+
+// SetErrorMessage is a fluent style 'setter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) SetErrorMessage(newValue string) *VolumeModifyIterAsyncInfoType {
+	o.ErrorMessagePtr = &newValue
+	return o
+}
+
+// Jobid is a fluent style 'getter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) Jobid() int {
+	r := *o.JobidPtr
+	return r
+}
+
+// SetJobid is a fluent style 'setter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) SetJobid(newValue int) *VolumeModifyIterAsyncInfoType {
+	o.JobidPtr = &newValue
+	return o
+}
+
+// Status is a fluent style 'getter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) Status() string {
+	r := *o.StatusPtr
+	return r
+}
+
+// SetStatus is a fluent style 'setter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) SetStatus(newValue string) *VolumeModifyIterAsyncInfoType {
+	o.StatusPtr = &newValue
+	return o
+}
+
+// VolumeKey is a fluent style 'getter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) VolumeKey() VolumeAttributesType {
+	r := *o.VolumeKeyPtr
+	return r
+}
+
+// SetVolumeKey is a fluent style 'setter' method that can be chained
+func (o *VolumeModifyIterAsyncInfoType) SetVolumeKey(newValue VolumeAttributesType) *VolumeModifyIterAsyncInfoType {
+	o.VolumeKeyPtr = &newValue
+	return o
+}
+
+// LunInfoType is a structure to represent a lun-info ZAPI object
+type LunInfoType struct {
+	XMLName xml.Name `xml:"lun-info"`
+
+	CommentPtr                   *string `xml:"comment"`
+	IsSpaceReservationEnabledPtr *bool   `xml:"is-space-reservation-enabled"`
+	MappedPtr                    *bool   `xml:"mapped"`
+	OnlinePtr                    *bool   `xml:"online"`
+	PathPtr                      *string `xml:"path"`
+	QtreePtr                     *string `xml:"qtree"`
+	SerialNumberPtr              *string `xml:"serial-number"`
+	SizePtr                      *int    `xml:"size"`
+	SizeUsedPtr                  *int    `xml:"size-used"`
+	UuidPtr                      *string `xml:"uuid"`
+	VolumePtr                    *string `xml:"volume"`
+	VserverPtr                   *string `xml:"vserver"`
+}
+
+// ToXML converts this object into an xml string representation
+func (o *LunInfoType) ToXML() (string, error) {
+	output, err := xml.MarshalIndent(o, " ", "    ")
+	if err != nil {
+		log.Errorf("error: %v", err)
+	}
+	return string(output), err
+}
+
+// NewLunInfoType is a factory method for creating new instances of LunInfoType objects
+func NewLunInfoType() *LunInfoType { return &LunInfoType{} }
+
+// String returns a string representation of this object's fields and implements the Stringer interface
+func (o LunInfoType) String() string {
+	var buffer bytes.Buffer
+	if o.CommentPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "comment", *o.CommentPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("comment: nil\n"))
+	}
+	if o.IsSpaceReservationEnabledPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "is-space-reservation-enabled", *o.IsSpaceReservationEnabledPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("is-space-reservation-enabled: nil\n"))
+	}
+	if o.MappedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "mapped", *o.MappedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("mapped: nil\n"))
+	}
+	if o.OnlinePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "online", *o.OnlinePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("online: nil\n"))
+	}
+	if o.PathPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "path", *o.PathPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("path: nil\n"))
+	}
+	if o.QtreePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "qtree", *o.QtreePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("qtree: nil\n"))
+	}
+	if o.SerialNumberPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "serial-number", *o.SerialNumberPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("serial-number: nil\n"))
+	}
+	if o.SizePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "size", *o.SizePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("size: nil\n"))
+	}
+	if o.SizeUsedPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "size-used", *o.SizeUsedPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("size-used: nil\n"))
+	}
+	if o.UuidPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "uuid", *o.UuidPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("uuid: nil\n"))
+	}
+	if o.VolumePtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "volume", *o.VolumePtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("volume: nil\n"))
+	}
+	if o.VserverPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "vserver", *o.VserverPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("vserver: nil\n"))
+	}
+	return buffer.String()
+}
+
+// Comment is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Comment() string {
+	r := *o.CommentPtr
+	return r
+}
+
+// SetComment is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetComment(newValue string) *LunInfoType {
+	o.CommentPtr = &newValue
+	return o
+}
+
+// IsSpaceReservationEnabled is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) IsSpaceReservationEnabled() bool {
+	r := *o.IsSpaceReservationEnabledPtr
+	return r
+}
+
+// SetIsSpaceReservationEnabled is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetIsSpaceReservationEnabled(newValue bool) *LunInfoType {
+	o.IsSpaceReservationEnabledPtr = &newValue
+	return o
+}
+
+// Mapped is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Mapped() bool {
+	r := *o.MappedPtr
+	return r
+}
+
+// SetMapped is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetMapped(newValue bool) *LunInfoType {
+	o.MappedPtr = &newValue
+	return o
+}
+
+// Online is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Online() bool {
+	r := *o.OnlinePtr
+	return r
+}
+
+// SetOnline is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetOnline(newValue bool) *LunInfoType {
+	o.OnlinePtr = &newValue
+	return o
+}
+
+// Path is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Path() string {
+	r := *o.PathPtr
+	return r
+}
+
+// SetPath is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetPath(newValue string) *LunInfoType {
+	o.PathPtr = &newValue
+	return o
+}
+
+// Qtree is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Qtree() string {
+	r := *o.QtreePtr
+	return r
+}
+
+// SetQtree is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetQtree(newValue string) *LunInfoType {
+	o.QtreePtr = &newValue
+	return o
+}
+
+// SerialNumber is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) SerialNumber() string {
+	r := *o.SerialNumberPtr
+	return r
+}
+
+// SetSerialNumber is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetSerialNumber(newValue string) *LunInfoType {
+	o.SerialNumberPtr = &newValue
+	return o
+}
+
+// Size is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Size() int {
+	r := *o.SizePtr
+	return r
+}
+
+// SetSize is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetSize(newValue int) *LunInfoType {
+	o.SizePtr = &newValue
+	return o
+}
+
+// SizeUsed is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) SizeUsed() int {
+	r := *o.SizeUsedPtr
+	return r
+}
+
+// SetSizeUsed is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetSizeUsed(newValue int) *LunInfoType {
+	o.SizeUsedPtr = &newValue
+	return o
+}
+
+// Uuid is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Uuid() string {
+	r := *o.UuidPtr
+	return r
+}
+
+// SetUuid is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetUuid(newValue string) *LunInfoType {
+	o.UuidPtr = &newValue
+	return o
+}
+
+// Volume is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Volume() string {
+	r := *o.VolumePtr
+	return r
+}
+
+// SetVolume is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetVolume(newValue string) *LunInfoType {
+	o.VolumePtr = &newValue
+	return o
+}
+
+// Vserver is a fluent style 'getter' method that can be chained
+func (o *LunInfoType) Vserver() string {
+	r := *o.VserverPtr
+	return r
+}
+
+// SetVserver is a fluent style 'setter' method that can be chained
+func (o *LunInfoType) SetVserver(newValue string) *LunInfoType {
+	o.VserverPtr = &newValue
+	return o
+}
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeCreateRequest is a structure to represent a volume-create ZAPI request object
 type VolumeCreateRequest struct {
 	XMLName xml.Name `xml:"volume-create"`
 
@@ -18,6 +21,7 @@ type VolumeCreateRequest struct {
 	CachingPolicyPtr                *string `xml:"caching-policy"`
 	ConstituentRolePtr              *string `xml:"constituent-role"`
 	ContainingAggrNamePtr           *string `xml:"containing-aggr-name"`
+	EncryptPtr                      *bool   `xml:"encrypt"`
 	ExcludedFromAutobalancePtr      *bool   `xml:"excluded-from-autobalance"`
 	ExportPolicyPtr                 *string `xml:"export-policy"`
 	FlexcacheCachePolicyPtr         *string `xml:"flexcache-cache-policy"`
@@ -42,6 +46,7 @@ type VolumeCreateRequest struct {
 	StripeConstituentVolumeCountPtr *int    `xml:"stripe-constituent-volume-count"`
 	StripeOptimizePtr               *string `xml:"stripe-optimize"`
 	StripeWidthPtr                  *int    `xml:"stripe-width"`
+	TieringPolicyPtr                *string `xml:"tiering-policy"`
 	UnixPermissionsPtr              *string `xml:"unix-permissions"`
 	UserIdPtr                       *int    `xml:"user-id"`
 	VmAlignSectorPtr                *int    `xml:"vm-align-sector"`
@@ -53,6 +58,7 @@ type VolumeCreateRequest struct {
 	VolumeTypePtr                   *string `xml:"volume-type"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeCreateRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -61,10 +67,12 @@ func (o *VolumeCreateRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeCreateRequest is a factory method for creating new instances of VolumeCreateRequest objects
 func NewVolumeCreateRequest() *VolumeCreateRequest { return &VolumeCreateRequest{} }
 
-func (r *VolumeCreateRequest) ExecuteUsing(zr *ZapiRunner) (VolumeCreateResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeCreateRequest) ExecuteUsing(zr *ZapiRunner) (VolumeCreateResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -79,6 +87,7 @@ func (r *VolumeCreateRequest) ExecuteUsing(zr *ZapiRunner) (VolumeCreateResponse
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeCreateRequest) String() string {
 	var buffer bytes.Buffer
 	if o.AntivirusOnAccessPolicyPtr != nil {
@@ -101,6 +110,11 @@ func (o VolumeCreateRequest) String() string {
 	} else {
 		buffer.WriteString(fmt.Sprintf("containing-aggr-name: nil\n"))
 	}
+	if o.EncryptPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "encrypt", *o.EncryptPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("encrypt: nil\n"))
+	}
 	if o.ExcludedFromAutobalancePtr != nil {
 		buffer.WriteString(fmt.Sprintf("%s: %v\n", "excluded-from-autobalance", *o.ExcludedFromAutobalancePtr))
 	} else {
@@ -221,6 +235,11 @@ func (o VolumeCreateRequest) String() string {
 	} else {
 		buffer.WriteString(fmt.Sprintf("stripe-width: nil\n"))
 	}
+	if o.TieringPolicyPtr != nil {
+		buffer.WriteString(fmt.Sprintf("%s: %v\n", "tiering-policy", *o.TieringPolicyPtr))
+	} else {
+		buffer.WriteString(fmt.Sprintf("tiering-policy: nil\n"))
+	}
 	if o.UnixPermissionsPtr != nil {
 		buffer.WriteString(fmt.Sprintf("%s: %v\n", "unix-permissions", *o.UnixPermissionsPtr))
 	} else {
@@ -269,376 +288,475 @@ func (o VolumeCreateRequest) String() string {
 	return buffer.String()
 }
 
+// AntivirusOnAccessPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) AntivirusOnAccessPolicy() string {
 	r := *o.AntivirusOnAccessPolicyPtr
 	return r
 }
 
+// SetAntivirusOnAccessPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetAntivirusOnAccessPolicy(newValue string) *VolumeCreateRequest {
 	o.AntivirusOnAccessPolicyPtr = &newValue
 	return o
 }
 
+// CachingPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) CachingPolicy() string {
 	r := *o.CachingPolicyPtr
 	return r
 }
 
+// SetCachingPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetCachingPolicy(newValue string) *VolumeCreateRequest {
 	o.CachingPolicyPtr = &newValue
 	return o
 }
 
+// ConstituentRole is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) ConstituentRole() string {
 	r := *o.ConstituentRolePtr
 	return r
 }
 
+// SetConstituentRole is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetConstituentRole(newValue string) *VolumeCreateRequest {
 	o.ConstituentRolePtr = &newValue
 	return o
 }
 
+// ContainingAggrName is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) ContainingAggrName() string {
 	r := *o.ContainingAggrNamePtr
 	return r
 }
 
+// SetContainingAggrName is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetContainingAggrName(newValue string) *VolumeCreateRequest {
 	o.ContainingAggrNamePtr = &newValue
 	return o
 }
 
+// Encrypt is a fluent style 'getter' method that can be chained
+func (o *VolumeCreateRequest) Encrypt() bool {
+	r := *o.EncryptPtr
+	return r
+}
+
+// SetEncrypt is a fluent style 'setter' method that can be chained
+func (o *VolumeCreateRequest) SetEncrypt(newValue bool) *VolumeCreateRequest {
+	o.EncryptPtr = &newValue
+	return o
+}
+
+// ExcludedFromAutobalance is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) ExcludedFromAutobalance() bool {
 	r := *o.ExcludedFromAutobalancePtr
 	return r
 }
 
+// SetExcludedFromAutobalance is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetExcludedFromAutobalance(newValue bool) *VolumeCreateRequest {
 	o.ExcludedFromAutobalancePtr = &newValue
 	return o
 }
 
+// ExportPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) ExportPolicy() string {
 	r := *o.ExportPolicyPtr
 	return r
 }
 
+// SetExportPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetExportPolicy(newValue string) *VolumeCreateRequest {
 	o.ExportPolicyPtr = &newValue
 	return o
 }
 
+// FlexcacheCachePolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) FlexcacheCachePolicy() string {
 	r := *o.FlexcacheCachePolicyPtr
 	return r
 }
 
+// SetFlexcacheCachePolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetFlexcacheCachePolicy(newValue string) *VolumeCreateRequest {
 	o.FlexcacheCachePolicyPtr = &newValue
 	return o
 }
 
+// FlexcacheFillPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) FlexcacheFillPolicy() string {
 	r := *o.FlexcacheFillPolicyPtr
 	return r
 }
 
+// SetFlexcacheFillPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetFlexcacheFillPolicy(newValue string) *VolumeCreateRequest {
 	o.FlexcacheFillPolicyPtr = &newValue
 	return o
 }
 
+// FlexcacheOriginVolumeName is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) FlexcacheOriginVolumeName() string {
 	r := *o.FlexcacheOriginVolumeNamePtr
 	return r
 }
 
+// SetFlexcacheOriginVolumeName is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetFlexcacheOriginVolumeName(newValue string) *VolumeCreateRequest {
 	o.FlexcacheOriginVolumeNamePtr = &newValue
 	return o
 }
 
+// GroupId is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) GroupId() int {
 	r := *o.GroupIdPtr
 	return r
 }
 
+// SetGroupId is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetGroupId(newValue int) *VolumeCreateRequest {
 	o.GroupIdPtr = &newValue
 	return o
 }
 
+// IsJunctionActive is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) IsJunctionActive() bool {
 	r := *o.IsJunctionActivePtr
 	return r
 }
 
+// SetIsJunctionActive is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetIsJunctionActive(newValue bool) *VolumeCreateRequest {
 	o.IsJunctionActivePtr = &newValue
 	return o
 }
 
+// IsNvfailEnabled is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) IsNvfailEnabled() string {
 	r := *o.IsNvfailEnabledPtr
 	return r
 }
 
+// SetIsNvfailEnabled is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetIsNvfailEnabled(newValue string) *VolumeCreateRequest {
 	o.IsNvfailEnabledPtr = &newValue
 	return o
 }
 
+// IsVserverRoot is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) IsVserverRoot() bool {
 	r := *o.IsVserverRootPtr
 	return r
 }
 
+// SetIsVserverRoot is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetIsVserverRoot(newValue bool) *VolumeCreateRequest {
 	o.IsVserverRootPtr = &newValue
 	return o
 }
 
+// JunctionPath is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) JunctionPath() string {
 	r := *o.JunctionPathPtr
 	return r
 }
 
+// SetJunctionPath is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetJunctionPath(newValue string) *VolumeCreateRequest {
 	o.JunctionPathPtr = &newValue
 	return o
 }
 
+// LanguageCode is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) LanguageCode() string {
 	r := *o.LanguageCodePtr
 	return r
 }
 
+// SetLanguageCode is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetLanguageCode(newValue string) *VolumeCreateRequest {
 	o.LanguageCodePtr = &newValue
 	return o
 }
 
+// MaxDirSize is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) MaxDirSize() int {
 	r := *o.MaxDirSizePtr
 	return r
 }
 
+// SetMaxDirSize is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetMaxDirSize(newValue int) *VolumeCreateRequest {
 	o.MaxDirSizePtr = &newValue
 	return o
 }
 
+// MaxWriteAllocBlocks is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) MaxWriteAllocBlocks() int {
 	r := *o.MaxWriteAllocBlocksPtr
 	return r
 }
 
+// SetMaxWriteAllocBlocks is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetMaxWriteAllocBlocks(newValue int) *VolumeCreateRequest {
 	o.MaxWriteAllocBlocksPtr = &newValue
 	return o
 }
 
+// PercentageSnapshotReserve is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) PercentageSnapshotReserve() int {
 	r := *o.PercentageSnapshotReservePtr
 	return r
 }
 
+// SetPercentageSnapshotReserve is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetPercentageSnapshotReserve(newValue int) *VolumeCreateRequest {
 	o.PercentageSnapshotReservePtr = &newValue
 	return o
 }
 
+// QosPolicyGroupName is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) QosPolicyGroupName() string {
 	r := *o.QosPolicyGroupNamePtr
 	return r
 }
 
+// SetQosPolicyGroupName is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetQosPolicyGroupName(newValue string) *VolumeCreateRequest {
 	o.QosPolicyGroupNamePtr = &newValue
 	return o
 }
 
+// Size is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) Size() string {
 	r := *o.SizePtr
 	return r
 }
 
+// SetSize is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetSize(newValue string) *VolumeCreateRequest {
 	o.SizePtr = &newValue
 	return o
 }
 
+// SnapshotPolicy is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) SnapshotPolicy() string {
 	r := *o.SnapshotPolicyPtr
 	return r
 }
 
+// SetSnapshotPolicy is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetSnapshotPolicy(newValue string) *VolumeCreateRequest {
 	o.SnapshotPolicyPtr = &newValue
 	return o
 }
 
+// SpaceReserve is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) SpaceReserve() string {
 	r := *o.SpaceReservePtr
 	return r
 }
 
+// SetSpaceReserve is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetSpaceReserve(newValue string) *VolumeCreateRequest {
 	o.SpaceReservePtr = &newValue
 	return o
 }
 
+// StorageService is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) StorageService() string {
 	r := *o.StorageServicePtr
 	return r
 }
 
+// SetStorageService is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetStorageService(newValue string) *VolumeCreateRequest {
 	o.StorageServicePtr = &newValue
 	return o
 }
 
+// StripeAlgorithm is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) StripeAlgorithm() string {
 	r := *o.StripeAlgorithmPtr
 	return r
 }
 
+// SetStripeAlgorithm is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetStripeAlgorithm(newValue string) *VolumeCreateRequest {
 	o.StripeAlgorithmPtr = &newValue
 	return o
 }
 
+// StripeConcurrency is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) StripeConcurrency() string {
 	r := *o.StripeConcurrencyPtr
 	return r
 }
 
+// SetStripeConcurrency is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetStripeConcurrency(newValue string) *VolumeCreateRequest {
 	o.StripeConcurrencyPtr = &newValue
 	return o
 }
 
+// StripeConstituentVolumeCount is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) StripeConstituentVolumeCount() int {
 	r := *o.StripeConstituentVolumeCountPtr
 	return r
 }
 
+// SetStripeConstituentVolumeCount is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetStripeConstituentVolumeCount(newValue int) *VolumeCreateRequest {
 	o.StripeConstituentVolumeCountPtr = &newValue
 	return o
 }
 
+// StripeOptimize is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) StripeOptimize() string {
 	r := *o.StripeOptimizePtr
 	return r
 }
 
+// SetStripeOptimize is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetStripeOptimize(newValue string) *VolumeCreateRequest {
 	o.StripeOptimizePtr = &newValue
 	return o
 }
 
+// StripeWidth is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) StripeWidth() int {
 	r := *o.StripeWidthPtr
 	return r
 }
 
+// SetStripeWidth is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetStripeWidth(newValue int) *VolumeCreateRequest {
 	o.StripeWidthPtr = &newValue
 	return o
 }
 
+// TieringPolicy is a fluent style 'getter' method that can be chained
+func (o *VolumeCreateRequest) TieringPolicy() string {
+	r := *o.TieringPolicyPtr
+	return r
+}
+
+// SetTieringPolicy is a fluent style 'setter' method that can be chained
+func (o *VolumeCreateRequest) SetTieringPolicy(newValue string) *VolumeCreateRequest {
+	o.TieringPolicyPtr = &newValue
+	return o
+}
+
+// UnixPermissions is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) UnixPermissions() string {
 	r := *o.UnixPermissionsPtr
 	return r
 }
 
+// SetUnixPermissions is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetUnixPermissions(newValue string) *VolumeCreateRequest {
 	o.UnixPermissionsPtr = &newValue
 	return o
 }
 
+// UserId is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) UserId() int {
 	r := *o.UserIdPtr
 	return r
 }
 
+// SetUserId is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetUserId(newValue int) *VolumeCreateRequest {
 	o.UserIdPtr = &newValue
 	return o
 }
 
+// VmAlignSector is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) VmAlignSector() int {
 	r := *o.VmAlignSectorPtr
 	return r
 }
 
+// SetVmAlignSector is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVmAlignSector(newValue int) *VolumeCreateRequest {
 	o.VmAlignSectorPtr = &newValue
 	return o
 }
 
+// VmAlignSuffix is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) VmAlignSuffix() string {
 	r := *o.VmAlignSuffixPtr
 	return r
 }
 
+// SetVmAlignSuffix is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVmAlignSuffix(newValue string) *VolumeCreateRequest {
 	o.VmAlignSuffixPtr = &newValue
 	return o
 }
 
+// Volume is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) Volume() string {
 	r := *o.VolumePtr
 	return r
 }
 
+// SetVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVolume(newValue string) *VolumeCreateRequest {
 	o.VolumePtr = &newValue
 	return o
 }
 
+// VolumeComment is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) VolumeComment() string {
 	r := *o.VolumeCommentPtr
 	return r
 }
 
+// SetVolumeComment is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVolumeComment(newValue string) *VolumeCreateRequest {
 	o.VolumeCommentPtr = &newValue
 	return o
 }
 
+// VolumeSecurityStyle is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) VolumeSecurityStyle() string {
 	r := *o.VolumeSecurityStylePtr
 	return r
 }
 
+// SetVolumeSecurityStyle is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVolumeSecurityStyle(newValue string) *VolumeCreateRequest {
 	o.VolumeSecurityStylePtr = &newValue
 	return o
 }
 
+// VolumeState is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) VolumeState() string {
 	r := *o.VolumeStatePtr
 	return r
 }
 
+// SetVolumeState is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVolumeState(newValue string) *VolumeCreateRequest {
 	o.VolumeStatePtr = &newValue
 	return o
 }
 
+// VolumeType is a fluent style 'getter' method that can be chained
 func (o *VolumeCreateRequest) VolumeType() string {
 	r := *o.VolumeTypePtr
 	return r
 }
 
+// SetVolumeType is a fluent style 'setter' method that can be chained
 func (o *VolumeCreateRequest) SetVolumeType(newValue string) *VolumeCreateRequest {
 	o.VolumeTypePtr = &newValue
 	return o
 }
 
+// VolumeCreateResponse is a structure to represent a volume-create ZAPI response object
 type VolumeCreateResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -648,6 +766,7 @@ type VolumeCreateResponse struct {
 	Result VolumeCreateResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeCreateResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -656,6 +775,7 @@ func (o VolumeCreateResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeCreateResponseResult is a structure to represent a volume-create ZAPI object's result
 type VolumeCreateResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -664,6 +784,7 @@ type VolumeCreateResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeCreateResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -672,8 +793,10 @@ func (o *VolumeCreateResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeCreateResponse is a factory method for creating new instances of VolumeCreateResponse objects
 func NewVolumeCreateResponse() *VolumeCreateResponse { return &VolumeCreateResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeCreateResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
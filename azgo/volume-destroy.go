@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeDestroyRequest is a structure to represent a volume-destroy ZAPI request object
 type VolumeDestroyRequest struct {
 	XMLName xml.Name `xml:"volume-destroy"`
 
@@ -18,6 +21,7 @@ type VolumeDestroyRequest struct {
 	UnmountAndOfflinePtr *bool   `xml:"unmount-and-offline"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeDestroyRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -26,10 +30,12 @@ func (o *VolumeDestroyRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeDestroyRequest is a factory method for creating new instances of VolumeDestroyRequest objects
 func NewVolumeDestroyRequest() *VolumeDestroyRequest { return &VolumeDestroyRequest{} }
 
-func (r *VolumeDestroyRequest) ExecuteUsing(zr *ZapiRunner) (VolumeDestroyResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeDestroyRequest) ExecuteUsing(zr *ZapiRunner) (VolumeDestroyResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -44,6 +50,7 @@ func (r *VolumeDestroyRequest) ExecuteUsing(zr *ZapiRunner) (VolumeDestroyRespon
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeDestroyRequest) String() string {
 	var buffer bytes.Buffer
 	if o.NamePtr != nil {
@@ -59,26 +66,31 @@ func (o VolumeDestroyRequest) String() string {
 	return buffer.String()
 }
 
+// Name is a fluent style 'getter' method that can be chained
 func (o *VolumeDestroyRequest) Name() string {
 	r := *o.NamePtr
 	return r
 }
 
+// SetName is a fluent style 'setter' method that can be chained
 func (o *VolumeDestroyRequest) SetName(newValue string) *VolumeDestroyRequest {
 	o.NamePtr = &newValue
 	return o
 }
 
+// UnmountAndOffline is a fluent style 'getter' method that can be chained
 func (o *VolumeDestroyRequest) UnmountAndOffline() bool {
 	r := *o.UnmountAndOfflinePtr
 	return r
 }
 
+// SetUnmountAndOffline is a fluent style 'setter' method that can be chained
 func (o *VolumeDestroyRequest) SetUnmountAndOffline(newValue bool) *VolumeDestroyRequest {
 	o.UnmountAndOfflinePtr = &newValue
 	return o
 }
 
+// VolumeDestroyResponse is a structure to represent a volume-destroy ZAPI response object
 type VolumeDestroyResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -88,6 +100,7 @@ type VolumeDestroyResponse struct {
 	Result VolumeDestroyResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeDestroyResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -96,6 +109,7 @@ func (o VolumeDestroyResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeDestroyResponseResult is a structure to represent a volume-destroy ZAPI object's result
 type VolumeDestroyResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -104,6 +118,7 @@ type VolumeDestroyResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeDestroyResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -112,8 +127,10 @@ func (o *VolumeDestroyResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeDestroyResponse is a factory method for creating new instances of VolumeDestroyResponse objects
 func NewVolumeDestroyResponse() *VolumeDestroyResponse { return &VolumeDestroyResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeDestroyResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeModifyIterRequest is a structure to represent a volume-modify-iter ZAPI request object
 type VolumeModifyIterRequest struct {
 	XMLName xml.Name `xml:"volume-modify-iter"`
 
@@ -24,6 +27,7 @@ type VolumeModifyIterRequest struct {
 	TagPtr               *string               `xml:"tag"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeModifyIterRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -32,10 +36,12 @@ func (o *VolumeModifyIterRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeModifyIterRequest is a factory method for creating new instances of VolumeModifyIterRequest objects
 func NewVolumeModifyIterRequest() *VolumeModifyIterRequest { return &VolumeModifyIterRequest{} }
 
-func (r *VolumeModifyIterRequest) ExecuteUsing(zr *ZapiRunner) (VolumeModifyIterResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeModifyIterRequest) ExecuteUsing(zr *ZapiRunner) (VolumeModifyIterResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -50,6 +56,7 @@ func (r *VolumeModifyIterRequest) ExecuteUsing(zr *ZapiRunner) (VolumeModifyIter
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeModifyIterRequest) String() string {
 	var buffer bytes.Buffer
 	if o.AttributesPtr != nil {
@@ -95,86 +102,103 @@ func (o VolumeModifyIterRequest) String() string {
 	return buffer.String()
 }
 
+// Attributes is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) Attributes() VolumeAttributesType {
 	r := *o.AttributesPtr
 	return r
 }
 
+// SetAttributes is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetAttributes(newValue VolumeAttributesType) *VolumeModifyIterRequest {
 	o.AttributesPtr = &newValue
 	return o
 }
 
+// ContinueOnFailure is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) ContinueOnFailure() bool {
 	r := *o.ContinueOnFailurePtr
 	return r
 }
 
+// SetContinueOnFailure is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetContinueOnFailure(newValue bool) *VolumeModifyIterRequest {
 	o.ContinueOnFailurePtr = &newValue
 	return o
 }
 
+// MaxFailureCount is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) MaxFailureCount() int {
 	r := *o.MaxFailureCountPtr
 	return r
 }
 
+// SetMaxFailureCount is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetMaxFailureCount(newValue int) *VolumeModifyIterRequest {
 	o.MaxFailureCountPtr = &newValue
 	return o
 }
 
+// MaxRecords is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) MaxRecords() int {
 	r := *o.MaxRecordsPtr
 	return r
 }
 
+// SetMaxRecords is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetMaxRecords(newValue int) *VolumeModifyIterRequest {
 	o.MaxRecordsPtr = &newValue
 	return o
 }
 
+// Query is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) Query() VolumeAttributesType {
 	r := *o.QueryPtr
 	return r
 }
 
+// SetQuery is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetQuery(newValue VolumeAttributesType) *VolumeModifyIterRequest {
 	o.QueryPtr = &newValue
 	return o
 }
 
+// ReturnFailureList is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) ReturnFailureList() bool {
 	r := *o.ReturnFailureListPtr
 	return r
 }
 
+// SetReturnFailureList is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetReturnFailureList(newValue bool) *VolumeModifyIterRequest {
 	o.ReturnFailureListPtr = &newValue
 	return o
 }
 
+// ReturnSuccessList is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) ReturnSuccessList() bool {
 	r := *o.ReturnSuccessListPtr
 	return r
 }
 
+// SetReturnSuccessList is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetReturnSuccessList(newValue bool) *VolumeModifyIterRequest {
 	o.ReturnSuccessListPtr = &newValue
 	return o
 }
 
+// Tag is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterRequest) Tag() string {
 	r := *o.TagPtr
 	return r
 }
 
+// SetTag is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterRequest) SetTag(newValue string) *VolumeModifyIterRequest {
 	o.TagPtr = &newValue
 	return o
 }
 
+// VolumeModifyIterResponse is a structure to represent a volume-modify-iter ZAPI response object
 type VolumeModifyIterResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -184,6 +208,7 @@ type VolumeModifyIterResponse struct {
 	Result VolumeModifyIterResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeModifyIterResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -192,6 +217,7 @@ func (o VolumeModifyIterResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeModifyIterResponseResult is a structure to represent a volume-modify-iter ZAPI object's result
 type VolumeModifyIterResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -205,6 +231,7 @@ type VolumeModifyIterResponseResult struct {
 	SuccessListPtr   []VolumeModifyIterInfoType `xml:"success-list>volume-modify-iter-info"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeModifyIterResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -213,8 +240,10 @@ func (o *VolumeModifyIterResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeModifyIterResponse is a factory method for creating new instances of VolumeModifyIterResponse objects
 func NewVolumeModifyIterResponse() *VolumeModifyIterResponse { return &VolumeModifyIterResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeModifyIterResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -248,11 +277,13 @@ func (o VolumeModifyIterResponseResult) String() string {
 	return buffer.String()
 }
 
+// FailureList is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterResponseResult) FailureList() []VolumeModifyIterInfoType {
 	r := o.FailureListPtr
 	return r
 }
 
+// SetFailureList is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterResponseResult) SetFailureList(newValue []VolumeModifyIterInfoType) *VolumeModifyIterResponseResult {
 	newSlice := make([]VolumeModifyIterInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -260,41 +291,49 @@ func (o *VolumeModifyIterResponseResult) SetFailureList(newValue []VolumeModifyI
 	return o
 }
 
+// NextTag is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterResponseResult) NextTag() string {
 	r := *o.NextTagPtr
 	return r
 }
 
+// SetNextTag is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterResponseResult) SetNextTag(newValue string) *VolumeModifyIterResponseResult {
 	o.NextTagPtr = &newValue
 	return o
 }
 
+// NumFailed is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterResponseResult) NumFailed() int {
 	r := *o.NumFailedPtr
 	return r
 }
 
+// SetNumFailed is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterResponseResult) SetNumFailed(newValue int) *VolumeModifyIterResponseResult {
 	o.NumFailedPtr = &newValue
 	return o
 }
 
+// NumSucceeded is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterResponseResult) NumSucceeded() int {
 	r := *o.NumSucceededPtr
 	return r
 }
 
+// SetNumSucceeded is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterResponseResult) SetNumSucceeded(newValue int) *VolumeModifyIterResponseResult {
 	o.NumSucceededPtr = &newValue
 	return o
 }
 
+// SuccessList is a fluent style 'getter' method that can be chained
 func (o *VolumeModifyIterResponseResult) SuccessList() []VolumeModifyIterInfoType {
 	r := o.SuccessListPtr
 	return r
 }
 
+// SetSuccessList is a fluent style 'setter' method that can be chained
 func (o *VolumeModifyIterResponseResult) SetSuccessList(newValue []VolumeModifyIterInfoType) *VolumeModifyIterResponseResult {
 	newSlice := make([]VolumeModifyIterInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeMountRequest is a structure to represent a volume-mount ZAPI request object
 type VolumeMountRequest struct {
 	XMLName xml.Name `xml:"volume-mount"`
 
@@ -20,6 +23,7 @@ type VolumeMountRequest struct {
 	VolumeNamePtr           *string `xml:"volume-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeMountRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -28,10 +32,12 @@ func (o *VolumeMountRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeMountRequest is a factory method for creating new instances of VolumeMountRequest objects
 func NewVolumeMountRequest() *VolumeMountRequest { return &VolumeMountRequest{} }
 
-func (r *VolumeMountRequest) ExecuteUsing(zr *ZapiRunner) (VolumeMountResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeMountRequest) ExecuteUsing(zr *ZapiRunner) (VolumeMountResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -46,6 +52,7 @@ func (r *VolumeMountRequest) ExecuteUsing(zr *ZapiRunner) (VolumeMountResponse,
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeMountRequest) String() string {
 	var buffer bytes.Buffer
 	if o.ActivateJunctionPtr != nil {
@@ -71,46 +78,55 @@ func (o VolumeMountRequest) String() string {
 	return buffer.String()
 }
 
+// ActivateJunction is a fluent style 'getter' method that can be chained
 func (o *VolumeMountRequest) ActivateJunction() bool {
 	r := *o.ActivateJunctionPtr
 	return r
 }
 
+// SetActivateJunction is a fluent style 'setter' method that can be chained
 func (o *VolumeMountRequest) SetActivateJunction(newValue bool) *VolumeMountRequest {
 	o.ActivateJunctionPtr = &newValue
 	return o
 }
 
+// ExportPolicyOverride is a fluent style 'getter' method that can be chained
 func (o *VolumeMountRequest) ExportPolicyOverride() bool {
 	r := *o.ExportPolicyOverridePtr
 	return r
 }
 
+// SetExportPolicyOverride is a fluent style 'setter' method that can be chained
 func (o *VolumeMountRequest) SetExportPolicyOverride(newValue bool) *VolumeMountRequest {
 	o.ExportPolicyOverridePtr = &newValue
 	return o
 }
 
+// JunctionPath is a fluent style 'getter' method that can be chained
 func (o *VolumeMountRequest) JunctionPath() string {
 	r := *o.JunctionPathPtr
 	return r
 }
 
+// SetJunctionPath is a fluent style 'setter' method that can be chained
 func (o *VolumeMountRequest) SetJunctionPath(newValue string) *VolumeMountRequest {
 	o.JunctionPathPtr = &newValue
 	return o
 }
 
+// VolumeName is a fluent style 'getter' method that can be chained
 func (o *VolumeMountRequest) VolumeName() string {
 	r := *o.VolumeNamePtr
 	return r
 }
 
+// SetVolumeName is a fluent style 'setter' method that can be chained
 func (o *VolumeMountRequest) SetVolumeName(newValue string) *VolumeMountRequest {
 	o.VolumeNamePtr = &newValue
 	return o
 }
 
+// VolumeMountResponse is a structure to represent a volume-mount ZAPI response object
 type VolumeMountResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -120,6 +136,7 @@ type VolumeMountResponse struct {
 	Result VolumeMountResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeMountResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -128,6 +145,7 @@ func (o VolumeMountResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeMountResponseResult is a structure to represent a volume-mount ZAPI object's result
 type VolumeMountResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -136,6 +154,7 @@ type VolumeMountResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeMountResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -144,8 +163,10 @@ func (o *VolumeMountResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeMountResponse is a factory method for creating new instances of VolumeMountResponse objects
 func NewVolumeMountResponse() *VolumeMountResponse { return &VolumeMountResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeMountResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,12 +13,14 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeOfflineRequest is a structure to represent a volume-offline ZAPI request object
 type VolumeOfflineRequest struct {
 	XMLName xml.Name `xml:"volume-offline"`
 
 	NamePtr *string `xml:"name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeOfflineRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -25,10 +29,12 @@ func (o *VolumeOfflineRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeOfflineRequest is a factory method for creating new instances of VolumeOfflineRequest objects
 func NewVolumeOfflineRequest() *VolumeOfflineRequest { return &VolumeOfflineRequest{} }
 
-func (r *VolumeOfflineRequest) ExecuteUsing(zr *ZapiRunner) (VolumeOfflineResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeOfflineRequest) ExecuteUsing(zr *ZapiRunner) (VolumeOfflineResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -43,6 +49,7 @@ func (r *VolumeOfflineRequest) ExecuteUsing(zr *ZapiRunner) (VolumeOfflineRespon
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeOfflineRequest) String() string {
 	var buffer bytes.Buffer
 	if o.NamePtr != nil {
@@ -53,16 +60,19 @@ func (o VolumeOfflineRequest) String() string {
 	return buffer.String()
 }
 
+// Name is a fluent style 'getter' method that can be chained
 func (o *VolumeOfflineRequest) Name() string {
 	r := *o.NamePtr
 	return r
 }
 
+// SetName is a fluent style 'setter' method that can be chained
 func (o *VolumeOfflineRequest) SetName(newValue string) *VolumeOfflineRequest {
 	o.NamePtr = &newValue
 	return o
 }
 
+// VolumeOfflineResponse is a structure to represent a volume-offline ZAPI response object
 type VolumeOfflineResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -72,6 +82,7 @@ type VolumeOfflineResponse struct {
 	Result VolumeOfflineResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeOfflineResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -80,6 +91,7 @@ func (o VolumeOfflineResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeOfflineResponseResult is a structure to represent a volume-offline ZAPI object's result
 type VolumeOfflineResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -88,6 +100,7 @@ type VolumeOfflineResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeOfflineResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -96,8 +109,10 @@ func (o *VolumeOfflineResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeOfflineResponse is a factory method for creating new instances of VolumeOfflineResponse objects
 func NewVolumeOfflineResponse() *VolumeOfflineResponse { return &VolumeOfflineResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeOfflineResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeSizeRequest is a structure to represent a volume-size ZAPI request object
 type VolumeSizeRequest struct {
 	XMLName xml.Name `xml:"volume-size"`
 
@@ -18,6 +21,7 @@ type VolumeSizeRequest struct {
 	VolumePtr  *string `xml:"volume"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSizeRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -26,10 +30,12 @@ func (o *VolumeSizeRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSizeRequest is a factory method for creating new instances of VolumeSizeRequest objects
 func NewVolumeSizeRequest() *VolumeSizeRequest { return &VolumeSizeRequest{} }
 
-func (r *VolumeSizeRequest) ExecuteUsing(zr *ZapiRunner) (VolumeSizeResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeSizeRequest) ExecuteUsing(zr *ZapiRunner) (VolumeSizeResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -44,6 +50,7 @@ func (r *VolumeSizeRequest) ExecuteUsing(zr *ZapiRunner) (VolumeSizeResponse, er
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSizeRequest) String() string {
 	var buffer bytes.Buffer
 	if o.NewSizePtr != nil {
@@ -59,26 +66,31 @@ func (o VolumeSizeRequest) String() string {
 	return buffer.String()
 }
 
+// NewSize is a fluent style 'getter' method that can be chained
 func (o *VolumeSizeRequest) NewSize() string {
 	r := *o.NewSizePtr
 	return r
 }
 
+// SetNewSize is a fluent style 'setter' method that can be chained
 func (o *VolumeSizeRequest) SetNewSize(newValue string) *VolumeSizeRequest {
 	o.NewSizePtr = &newValue
 	return o
 }
 
+// Volume is a fluent style 'getter' method that can be chained
 func (o *VolumeSizeRequest) Volume() string {
 	r := *o.VolumePtr
 	return r
 }
 
+// SetVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeSizeRequest) SetVolume(newValue string) *VolumeSizeRequest {
 	o.VolumePtr = &newValue
 	return o
 }
 
+// VolumeSizeResponse is a structure to represent a volume-size ZAPI response object
 type VolumeSizeResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -88,6 +100,7 @@ type VolumeSizeResponse struct {
 	Result VolumeSizeResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSizeResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -96,6 +109,7 @@ func (o VolumeSizeResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeSizeResponseResult is a structure to represent a volume-size ZAPI object's result
 type VolumeSizeResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -108,6 +122,7 @@ type VolumeSizeResponseResult struct {
 	VolumeSizePtr            *string `xml:"volume-size"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeSizeResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -116,8 +131,10 @@ func (o *VolumeSizeResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeSizeResponse is a factory method for creating new instances of VolumeSizeResponse objects
 func NewVolumeSizeResponse() *VolumeSizeResponse { return &VolumeSizeResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeSizeResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -146,41 +163,49 @@ func (o VolumeSizeResponseResult) String() string {
 	return buffer.String()
 }
 
+// IsFixedSizeFlexVolume is a fluent style 'getter' method that can be chained
 func (o *VolumeSizeResponseResult) IsFixedSizeFlexVolume() bool {
 	r := *o.IsFixedSizeFlexVolumePtr
 	return r
 }
 
+// SetIsFixedSizeFlexVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeSizeResponseResult) SetIsFixedSizeFlexVolume(newValue bool) *VolumeSizeResponseResult {
 	o.IsFixedSizeFlexVolumePtr = &newValue
 	return o
 }
 
+// IsReadonlyFlexVolume is a fluent style 'getter' method that can be chained
 func (o *VolumeSizeResponseResult) IsReadonlyFlexVolume() bool {
 	r := *o.IsReadonlyFlexVolumePtr
 	return r
 }
 
+// SetIsReadonlyFlexVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeSizeResponseResult) SetIsReadonlyFlexVolume(newValue bool) *VolumeSizeResponseResult {
 	o.IsReadonlyFlexVolumePtr = &newValue
 	return o
 }
 
+// IsReplicaFlexVolume is a fluent style 'getter' method that can be chained
 func (o *VolumeSizeResponseResult) IsReplicaFlexVolume() bool {
 	r := *o.IsReplicaFlexVolumePtr
 	return r
 }
 
+// SetIsReplicaFlexVolume is a fluent style 'setter' method that can be chained
 func (o *VolumeSizeResponseResult) SetIsReplicaFlexVolume(newValue bool) *VolumeSizeResponseResult {
 	o.IsReplicaFlexVolumePtr = &newValue
 	return o
 }
 
+// VolumeSize is a fluent style 'getter' method that can be chained
 func (o *VolumeSizeResponseResult) VolumeSize() string {
 	r := *o.VolumeSizePtr
 	return r
 }
 
+// SetVolumeSize is a fluent style 'setter' method that can be chained
 func (o *VolumeSizeResponseResult) SetVolumeSize(newValue string) *VolumeSizeResponseResult {
 	o.VolumeSizePtr = &newValue
 	return o
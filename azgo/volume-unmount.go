@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VolumeUnmountRequest is a structure to represent a volume-unmount ZAPI request object
 type VolumeUnmountRequest struct {
 	XMLName xml.Name `xml:"volume-unmount"`
 
@@ -18,6 +21,7 @@ type VolumeUnmountRequest struct {
 	VolumeNamePtr *string `xml:"volume-name"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeUnmountRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -26,10 +30,12 @@ func (o *VolumeUnmountRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeUnmountRequest is a factory method for creating new instances of VolumeUnmountRequest objects
 func NewVolumeUnmountRequest() *VolumeUnmountRequest { return &VolumeUnmountRequest{} }
 
-func (r *VolumeUnmountRequest) ExecuteUsing(zr *ZapiRunner) (VolumeUnmountResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VolumeUnmountRequest) ExecuteUsing(zr *ZapiRunner) (VolumeUnmountResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -44,6 +50,7 @@ func (r *VolumeUnmountRequest) ExecuteUsing(zr *ZapiRunner) (VolumeUnmountRespon
 	return n, err
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeUnmountRequest) String() string {
 	var buffer bytes.Buffer
 	if o.ForcePtr != nil {
@@ -59,26 +66,31 @@ func (o VolumeUnmountRequest) String() string {
 	return buffer.String()
 }
 
+// Force is a fluent style 'getter' method that can be chained
 func (o *VolumeUnmountRequest) Force() bool {
 	r := *o.ForcePtr
 	return r
 }
 
+// SetForce is a fluent style 'setter' method that can be chained
 func (o *VolumeUnmountRequest) SetForce(newValue bool) *VolumeUnmountRequest {
 	o.ForcePtr = &newValue
 	return o
 }
 
+// VolumeName is a fluent style 'getter' method that can be chained
 func (o *VolumeUnmountRequest) VolumeName() string {
 	r := *o.VolumeNamePtr
 	return r
 }
 
+// SetVolumeName is a fluent style 'setter' method that can be chained
 func (o *VolumeUnmountRequest) SetVolumeName(newValue string) *VolumeUnmountRequest {
 	o.VolumeNamePtr = &newValue
 	return o
 }
 
+// VolumeUnmountResponse is a structure to represent a volume-unmount ZAPI response object
 type VolumeUnmountResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -88,6 +100,7 @@ type VolumeUnmountResponse struct {
 	Result VolumeUnmountResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeUnmountResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -96,6 +109,7 @@ func (o VolumeUnmountResponse) String() string {
 	return buffer.String()
 }
 
+// VolumeUnmountResponseResult is a structure to represent a volume-unmount ZAPI object's result
 type VolumeUnmountResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -104,6 +118,7 @@ type VolumeUnmountResponseResult struct {
 	ResultErrnoAttr  string `xml:"errno,attr"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VolumeUnmountResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -112,8 +127,10 @@ func (o *VolumeUnmountResponse) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVolumeUnmountResponse is a factory method for creating new instances of VolumeUnmountResponse objects
 func NewVolumeUnmountResponse() *VolumeUnmountResponse { return &VolumeUnmountResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VolumeUnmountResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -1,5 +1,7 @@
 // Copyright 2016 NetApp, Inc. All Rights Reserved.
 
+// Code generated by azgo/generator; DO NOT EDIT.
+
 package azgo
 
 import (
@@ -11,6 +13,7 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// VserverGetIterRequest is a structure to represent a vserver-get-iter ZAPI request object
 type VserverGetIterRequest struct {
 	XMLName xml.Name `xml:"vserver-get-iter"`
 
@@ -20,6 +23,7 @@ type VserverGetIterRequest struct {
 	TagPtr               *string          `xml:"tag"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VserverGetIterRequest) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
@@ -28,10 +32,12 @@ func (o *VserverGetIterRequest) ToXML() (string, error) {
 	return string(output), err
 }
 
+// NewVserverGetIterRequest is a factory method for creating new instances of VserverGetIterRequest objects
 func NewVserverGetIterRequest() *VserverGetIterRequest { return &VserverGetIterRequest{} }
 
-func (r *VserverGetIterRequest) ExecuteUsing(zr *ZapiRunner) (VserverGetIterResponse, error) {
-	resp, err := zr.SendZapi(r)
+// ExecuteUsing converts this object to a ZAPI XML representation and uses the supplied ZapiRunner to send to a filer
+func (o *VserverGetIterRequest) ExecuteUsing(zr *ZapiRunner) (VserverGetIterResponse, error) {
+	resp, err := zr.SendZapi(o)
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
 	log.Debugf("response Body:\n%s", string(body))
@@ -46,6 +52,34 @@ func (r *VserverGetIterRequest) ExecuteUsing(zr *ZapiRunner) (VserverGetIterResp
 	return n, err
 }
 
+// ExecuteAllUsing sends this request page by page using the supplied ZapiRunner, following next-tag, and returns a
+// response holding the records of every page; maxRecords is the page size, or DefaultMaxRecords if not positive
+func (o *VserverGetIterRequest) ExecuteAllUsing(zr *ZapiRunner, maxRecords int) (VserverGetIterResponse, error) {
+	var response VserverGetIterResponse
+	request := *o
+	records := make([]VserverInfoType, 0)
+	err := IterateGetIter(maxRecords, func(tag string, maxRecords int) (*string, error) {
+		request.SetMaxRecords(maxRecords)
+		if tag != "" {
+			request.SetTag(tag)
+		}
+
+		var err error
+		response, err = request.ExecuteUsing(zr)
+		if err != nil || response.Result.ResultStatusAttr != "passed" {
+			return nil, err
+		}
+		records = append(records, response.Result.AttributesList()...)
+		return response.Result.NextTagPtr, nil
+	})
+	if err == nil && response.Result.ResultStatusAttr == "passed" {
+		response.Result.SetAttributesList(records).SetNumRecords(len(records))
+		response.Result.NextTagPtr = nil
+	}
+	return response, err
+}
+
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VserverGetIterRequest) String() string {
 	var buffer bytes.Buffer
 	if o.DesiredAttributesPtr != nil {
@@ -71,46 +105,55 @@ func (o VserverGetIterRequest) String() string {
 	return buffer.String()
 }
 
+// DesiredAttributes is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterRequest) DesiredAttributes() VserverInfoType {
 	r := *o.DesiredAttributesPtr
 	return r
 }
 
+// SetDesiredAttributes is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterRequest) SetDesiredAttributes(newValue VserverInfoType) *VserverGetIterRequest {
 	o.DesiredAttributesPtr = &newValue
 	return o
 }
 
+// MaxRecords is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterRequest) MaxRecords() int {
 	r := *o.MaxRecordsPtr
 	return r
 }
 
+// SetMaxRecords is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterRequest) SetMaxRecords(newValue int) *VserverGetIterRequest {
 	o.MaxRecordsPtr = &newValue
 	return o
 }
 
+// Query is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterRequest) Query() VserverInfoType {
 	r := *o.QueryPtr
 	return r
 }
 
+// SetQuery is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterRequest) SetQuery(newValue VserverInfoType) *VserverGetIterRequest {
 	o.QueryPtr = &newValue
 	return o
 }
 
+// Tag is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterRequest) Tag() string {
 	r := *o.TagPtr
 	return r
 }
 
+// SetTag is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterRequest) SetTag(newValue string) *VserverGetIterRequest {
 	o.TagPtr = &newValue
 	return o
 }
 
+// VserverGetIterResponse is a structure to represent a vserver-get-iter ZAPI response object
 type VserverGetIterResponse struct {
 	XMLName xml.Name `xml:"netapp"`
 
@@ -120,6 +163,7 @@ type VserverGetIterResponse struct {
 	Result VserverGetIterResponseResult `xml:"results"`
 }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VserverGetIterResponse) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "version", o.ResponseVersion))
@@ -128,6 +172,7 @@ func (o VserverGetIterResponse) String() string {
 	return buffer.String()
 }
 
+// VserverGetIterResponseResult is a structure to represent a vserver-get-iter ZAPI object's result
 type VserverGetIterResponseResult struct {
 	XMLName xml.Name `xml:"results"`
 
@@ -139,16 +184,19 @@ type VserverGetIterResponseResult struct {
 	NumRecordsPtr     *int              `xml:"num-records"`
 }
 
+// ToXML converts this object into an xml string representation
 func (o *VserverGetIterResponse) ToXML() (string, error) {
 	output, err := xml.MarshalIndent(o, " ", "    ")
 	if err != nil {
 		log.Debugf("error: %v", err)
-	} // TODO: handle better
+	}
 	return string(output), err
 }
 
+// NewVserverGetIterResponse is a factory method for creating new instances of VserverGetIterResponse objects
 func NewVserverGetIterResponse() *VserverGetIterResponse { return &VserverGetIterResponse{} }
 
+// String returns a string representation of this object's fields and implements the Stringer interface
 func (o VserverGetIterResponseResult) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s: %s\n", "resultStatusAttr", o.ResultStatusAttr))
@@ -172,11 +220,13 @@ func (o VserverGetIterResponseResult) String() string {
 	return buffer.String()
 }
 
+// AttributesList is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterResponseResult) AttributesList() []VserverInfoType {
 	r := o.AttributesListPtr
 	return r
 }
 
+// SetAttributesList is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterResponseResult) SetAttributesList(newValue []VserverInfoType) *VserverGetIterResponseResult {
 	newSlice := make([]VserverInfoType, len(newValue))
 	copy(newSlice, newValue)
@@ -184,21 +234,25 @@ func (o *VserverGetIterResponseResult) SetAttributesList(newValue []VserverInfoT
 	return o
 }
 
+// NextTag is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterResponseResult) NextTag() string {
 	r := *o.NextTagPtr
 	return r
 }
 
+// SetNextTag is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterResponseResult) SetNextTag(newValue string) *VserverGetIterResponseResult {
 	o.NextTagPtr = &newValue
 	return o
 }
 
+// NumRecords is a fluent style 'getter' method that can be chained
 func (o *VserverGetIterResponseResult) NumRecords() int {
 	r := *o.NumRecordsPtr
 	return r
 }
 
+// SetNumRecords is a fluent style 'setter' method that can be chained
 func (o *VserverGetIterResponseResult) SetNumRecords(newValue int) *VserverGetIterResponseResult {
 	o.NumRecordsPtr = &newValue
 	return o
@@ -139,6 +139,22 @@ func (d ndvpDriver) Get(r volume.Request) volume.Response {
 		"Snapshots": snaps,
 	}
 
+	// Report the options the volume was created with, as recorded on the storage
+	if opts, err := d.sd.GetVolumeOpts(target); err != nil {
+		log.Warnf("Problem reading options for volume %v: %v", target, err)
+	} else {
+		status["Options"] = opts
+	}
+
+	// Merge in whatever else the storage driver reports, such as clone lineage
+	if extra, err := d.sd.GetVolumeStatus(target); err != nil {
+		log.Warnf("Problem reading status for volume %v: %v", target, err)
+	} else {
+		for k, v := range extra {
+			status[k] = v
+		}
+	}
+
 	v2 := &volume.Volume{
 		Name:       r.Name,
 		Mountpoint: path,
@@ -22,25 +22,7 @@ type ndvpDriver struct {
 }
 
 func (d *ndvpDriver) volumePrefix() string {
-	defaultPrefix := d.sd.DefaultStoragePrefix()
-	prefixToUse := defaultPrefix
-	storagePrefixRaw := d.config.StoragePrefixRaw // this is a raw version of the json value, we will get quotes in it
-	if len(storagePrefixRaw) >= 2 {
-		s := string(storagePrefixRaw)
-		if s == "\"\"" || s == "" {
-			prefixToUse = ""
-			//log.Debugf("storagePrefix is specified as \"\", using no prefix")
-		} else {
-			// trim quotes from start and end of string
-			prefixToUse = s[1 : len(s)-1]
-			//log.Debugf("storagePrefix is specified, using prefix: %v", prefixToUse)
-		}
-	} else {
-		prefixToUse = defaultPrefix
-		//log.Debugf("storagePrefix is unspecified, using default prefix: %v", prefixToUse)
-	}
-
-	return prefixToUse
+	return d.config.StoragePrefix(d.sd.DefaultStoragePrefix())
 }
 
 func (d *ndvpDriver) volumeName(name string) string {
@@ -81,6 +81,11 @@ func main() {
 	log.SetOutput(os.Stderr)
 	log.SetLevel(log.InfoLevel)
 
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %v [flags] [command]\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprint(os.Stderr, storage_drivers.VolumeCommandUsage)
+	}
 	flag.Parse()
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
@@ -125,6 +130,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	// a command after the flags is a one-off operation on existing volumes, run instead of the plugin
+	if flag.NArg() > 0 {
+		if err := storage_drivers.RunVolumeCommand(storageDriver, *commonConfig, flag.Args()); err != nil {
+			log.Error(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	logFile := initLogging(*driverID)
 	defer logFile.Close() // don't forget to close it
 	log.Infof("Using storage driver: %v", commonConfig.StorageDriverName)
@@ -5,6 +5,7 @@ package storage_drivers
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
 
 	"strconv"
@@ -73,6 +74,8 @@ func (d *ESeriesStorageDriver) Initialize(configJSON string) error {
 		PasswordArray:     config.PasswordArray,
 		ArrayRegistered:   config.ArrayRegistered,
 		HostDataIP:        config.HostDataIP,
+		HostGroup:         config.HostGroup,
+		HostType:          config.HostType,
 		Protocol:          d.Protocol(),
 		DriverName:        config.CommonStorageDriverConfig.StorageDriverName,
 		Version:           config.CommonStorageDriverConfig.Version,
@@ -113,6 +116,12 @@ func (d *ESeriesStorageDriver) Validate() error {
 		return fmt.Errorf("HostDataIP is empty! You need to specify atleast one of the iSCSI interface IP addresses that is connected to the E-Series array.")
 	}
 
+	if d.Config.HostType != "" {
+		if _, ok := eseries.HostTypes[d.Config.HostType]; !ok {
+			return fmt.Errorf("Unsupported hostType: %v", d.Config.HostType)
+		}
+	}
+
 	//Make sure iSCSI is supported on system
 	isIscsiSupported := utils.IscsiSupported()
 	if !isIscsiSupported {
@@ -142,6 +151,11 @@ func (d *ESeriesStorageDriver) Create(name string, opts map[string]string) error
 	volumeSize := utils.GetV(opts, "size", "1g")
 	mediaType := utils.GetV(opts, "mediaType", "hdd")
 	//mediaSecure := utils.GetV(opts, "mediaSecure", "false")
+	fsType := utils.GetV(opts, "fstype", DefaultFileSystemType)
+
+	if err := ValidateFileSystemType(fsType); err != nil {
+		return err
+	}
 
 	volumeGroupRef, error := d.Storage.VerifyVolumePools(mediaType, volumeSize)
 	if error != nil {
@@ -150,8 +164,14 @@ func (d *ESeriesStorageDriver) Create(name string, opts map[string]string) error
 		log.Debugf("ESeriesStorageDriver#Create(%v) - volumeGroupRef=%s", name, volumeGroupRef)
 	}
 
+	//Remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	effectiveOpts["size"] = volumeSize
+	effectiveOpts["mediaType"] = mediaType
+	effectiveOpts["fstype"] = fsType
+
 	//Create the volume
-	volumeRef, error1 := d.Storage.CreateVolume(name, volumeGroupRef, volumeSize, mediaType)
+	volumeRef, error1 := d.Storage.CreateVolume(name, volumeGroupRef, volumeSize, mediaType, effectiveOpts)
 	if error1 != nil {
 		return error1
 	} else {
@@ -165,21 +185,10 @@ func (d *ESeriesStorageDriver) Create(name string, opts map[string]string) error
 func (d *ESeriesStorageDriver) Destroy(name string) error {
 	log.Debugf("ESeriesStorageDriver#Destroy(%v)", name)
 
-	//Grab the host IQN and verify array is aware of it
-	iqns, errIqn := utils.GetInitiatorIqns()
-	if errIqn != nil {
-		return fmt.Errorf("Problem determining host initiator iqns error: %v", errIqn)
-	}
-
-	//Going to assume a single IQN name for our host right now
-	var iqn string = iqns[0]
-
-	log.Debugf("ESeriesStorageDriver#Destroy(%v) - iqn=%s", name, iqn)
-
-	//We don't want to fail the operation if we can't find a host matching the IQN, but we want to log a warning
-	hostRef, verifyIqnErr := d.Storage.VerifyHostIQN(iqn)
+	//We don't want to fail the operation if we can't find a host matching the IQNs, but we want to log a warning
+	hostRef, verifyIqnErr := d.mappingTarget(false)
 	if verifyIqnErr != nil {
-		log.Warnf("Host IQN (%s) not found on target E-Series array! error=%s", iqn, verifyIqnErr)
+		log.Warnf("Host not found on target E-Series array! error=%s", verifyIqnErr)
 	}
 
 	log.Debugf("ESeriesStorageDriver#Destroy(%v) - HostRef=%s", name, hostRef)
@@ -190,6 +199,15 @@ func (d *ESeriesStorageDriver) Destroy(name string) error {
 		return fmt.Errorf("Error - volume with name %s doesn't exist on array! error1=%s", name, error1)
 	}
 
+	//Removing a volume would also remove the snapshot volumes cloned from it
+	clones, errClones := d.Storage.GetClones(name)
+	if errClones != nil {
+		return fmt.Errorf("Problem checking volume %s for clones: %v", name, errClones)
+	}
+	if len(clones) > 0 {
+		return fmt.Errorf("Volume %s has clones %v; remove them first", name, clones)
+	}
+
 	//Verify that volume is mapped to this host already
 	isMapped, _, error2 := d.Storage.IsVolumeAlreadyMappedToHost(name, hostRef)
 	if error2 != nil {
@@ -226,17 +244,10 @@ func (d *ESeriesStorageDriver) Destroy(name string) error {
 func (d *ESeriesStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
 	log.Debugf("ESeriesStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
 
-	//First lets get the IQN name for our host
-	iqns, errIqn := utils.GetInitiatorIqns()
-	if errIqn != nil {
-		return fmt.Errorf("Problem determining host initiator iqns error: %v", errIqn)
-	}
-
-	log.Debugf("ESeriesStorageDriver#Attach(%v, %v, %v) - iqn=%s", name, mountpoint, opts, iqns[0]) //Going to assume a single IQN name for our host right now
-
-	hostRef, error := d.Storage.VerifyHostIQN(iqns[0])
+	//Find the host, or its host group, that volumes are mapped to, defining them on the array if needed
+	hostRef, error := d.mappingTarget(true)
 	if error != nil {
-		return fmt.Errorf("Host IQN (%s) not found on target E-Series array! error=%s", errIqn, error)
+		return fmt.Errorf("Problem defining this host on the E-Series array! error=%s", error)
 	}
 
 	log.Debugf("ESeriesStorageDriver#Attach(%v, %v, %v) - HostRef=%s", name, mountpoint, opts, hostRef)
@@ -262,7 +273,7 @@ func (d *ESeriesStorageDriver) Attach(name, mountpoint string, opts map[string]s
 		//Now that we have verified that the host exists on the array we are ready to map the volume to the host only if the volume is not already mapped to host
 		tmpLunNumber, error3 := d.Storage.MapVolume(name, hostRef)
 		if error3 != nil {
-			return fmt.Errorf("Error while mapping volume to host! name=%s hostRef=%s iqn=%s error2=%s", name, hostRef, iqns[0], error3)
+			return fmt.Errorf("Error while mapping volume to host! name=%s hostRef=%s error2=%s", name, hostRef, error3)
 		}
 
 		//Set the volume LUN number to newly mapped LUN
@@ -313,7 +324,11 @@ func (d *ESeriesStorageDriver) Attach(name, mountpoint string, opts map[string]s
 	// put a filesystem on it if there isn't one already there
 	if deviceToUse.Filesystem == "" {
 		// format it
-		err := utils.FormatVolume(deviceRef, "ext4") // TODO externalize fsType
+		volumeOpts, optsErr := d.Storage.GetVolumeOpts(name)
+		if optsErr != nil {
+			return fmt.Errorf("Problem reading options for volume: %v error: %v", name, optsErr)
+		}
+		err := utils.FormatVolume(deviceRef, utils.GetV(volumeOpts, "fstype", DefaultFileSystemType))
 		if err != nil {
 			return fmt.Errorf("Problem formatting lun: %v device: %v error: %v", name, deviceToUse, err)
 		}
@@ -330,6 +345,25 @@ func (d *ESeriesStorageDriver) Attach(name, mountpoint string, opts map[string]s
 	return nil
 }
 
+// mappingTarget returns the reference of this host, or of the configured host group, on the array; if create is
+// set, they are created when missing
+func (d *ESeriesStorageDriver) mappingTarget(create bool) (string, error) {
+	iqns, err := utils.GetInitiatorIqns()
+	if err != nil {
+		return "", fmt.Errorf("Problem determining host initiator iqns error: %v", err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Warnf("problem while looking up hostname, error: %v", err)
+		hostname = "unknown"
+	}
+
+	log.Debugf("ESeriesStorageDriver#mappingTarget(%v) - hostname=%s iqns=%v", create, hostname, iqns)
+
+	return d.Storage.GetMappingTarget(hostname, iqns, create)
+}
+
 func (d *ESeriesStorageDriver) findDevice(volumeLunNumber int, sessionInfo utils.IscsiSessionInfo, devices []utils.ScsiDeviceInfo) *utils.ScsiDeviceInfo {
 	log.Debugf("ESeriesStorageDriver#findDevice(%v,...)", volumeLunNumber)
 	// look for the expected mapped lun
@@ -386,10 +420,85 @@ func (d *ESeriesStorageDriver) DefaultSnapshotPrefix() string {
 
 // Return the list of snapshots associated with the named volume
 func (d *ESeriesStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
-	return nil, nil
+	log.Debugf("ESeriesStorageDriver#SnapshotList(%v)", name)
+
+	images, err := d.Storage.GetSnapshotImages(name)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve snapshots for volume; name: %v error: %v", name, err)
+	}
+
+	log.Debugf("Returned %v snapshots", len(images))
+	var snapshots []CommonSnapshot
+
+	for _, image := range images {
+		snapshotName := eseries.SnapshotImageName(image)
+		created := eseries.SnapshotImageCreated(image)
+		log.Debugf("Snapshot name: %v, date: %v", snapshotName, created)
+		snapshots = append(snapshots, CommonSnapshot{snapshotName, created})
+	}
+
+	return snapshots, nil
+}
+
+// Return the options the named volume was created with
+func (d *ESeriesStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	log.Debugf("ESeriesStorageDriver#GetVolumeOpts(%v)", name)
+
+	opts, err := d.Storage.GetVolumeOpts(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshot volumes have no metadata of their own; they inherit the options of their source
+	source, snapshot, err := d.Storage.GetCloneSource(name)
+	if err != nil {
+		return nil, err
+	}
+	if source != "" {
+		return CloneVolumeOpts(opts, source, snapshot), nil
+	}
+
+	return opts, nil
+}
+
+// Return additional status of the named volume; E-Series reports none
+func (d *ESeriesStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
 }
 
-// Create a volume clone
+// Create a volume clone; the clone is a writable snapshot volume of a snapshot image of the source.  E-Series
+// snapshot images cannot be labeled, so they are named after the time they were taken and newSnapshotPrefix is
+// not used.
 func (d *ESeriesStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
-	return fmt.Errorf("Cloning with E-Series is not yet supported")
+	log.Debugf("ESeriesStorageDriver#CreateClone(%v, %v, %v, %v)", name, source, snapshot, newSnapshotPrefix)
+
+	// If the clone already exists, skip creation and call it a success
+	if err := d.Storage.VerifyVolumeExists(name); err == nil {
+		return nil
+	}
+
+	if err := d.Storage.VerifyVolumeExists(source); err != nil {
+		return fmt.Errorf("Failed to find source volume: error: %v", err)
+	}
+
+	// If a snapshot was specified, use that; otherwise take a new one
+	var image *eseries.SnapshotImage
+	var err error
+	if snapshot != "" {
+		image, err = d.Storage.FindSnapshotImage(source, snapshot)
+		if err != nil {
+			return fmt.Errorf("Failed to find snapshot specified: error: %v", err)
+		}
+	} else {
+		image, err = d.Storage.CreateSnapshotImage(source)
+		if err != nil {
+			return fmt.Errorf("Error creating snapshot: %v", err)
+		}
+	}
+
+	if err := d.Storage.CreateSnapshotVolume(name, source, *image); err != nil {
+		return fmt.Errorf("Failed to create clone: error: %v", err)
+	}
+
+	return nil
 }
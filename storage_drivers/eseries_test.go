@@ -0,0 +1,345 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package storage_drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/netapp/netappdvp/apis/eseries"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// fakeESeriesProxy answers the web services proxy calls the driver makes for a single array
+type fakeESeriesProxy struct {
+	*httptest.Server
+	m          sync.Mutex
+	nextID     int
+	volumes    []eseries.MsgVolumeExResponse
+	groups     []eseries.SnapshotGroup
+	images     []eseries.SnapshotImage
+	snapshots  []eseries.SnapshotVolume
+	hosts      []eseries.HostExResponse
+	hostGroups []eseries.HostGroup
+	mappings   []eseries.LUNMapping
+}
+
+func newFakeESeriesProxy() *fakeESeriesProxy {
+	f := &fakeESeriesProxy{}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	return f
+}
+
+func (f *fakeESeriesProxy) ref() string {
+	f.nextID++
+	return fmt.Sprintf("0200000060080E5000%022d", f.nextID)
+}
+
+func (f *fakeESeriesProxy) serve(w http.ResponseWriter, r *http.Request) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	reply := func(v interface{}) {
+		json.NewEncoder(w).Encode(v)
+	}
+
+	if r.URL.Path == "/devmgr/v2/storage-systems/" {
+		reply(eseries.MsgConnectResponse{ArrayID: "array1"})
+		return
+	}
+
+	resource := strings.TrimPrefix(r.URL.Path, "/devmgr/v2/storage-systems/array1")
+	switch r.Method + " " + resource {
+	case "GET /storage-pools":
+		reply([]eseries.VolumeGroupExResponse{
+			{VolumeGroupRef: "pool-hdd", VolumeLabel: "netappdvp_hdd", FreeSpace: "1099511627776"},
+			{VolumeGroupRef: "pool-ssd", VolumeLabel: "netappdvp_ssd", FreeSpace: "1099511627776"},
+		})
+	case "GET /volumes":
+		reply(f.volumes)
+	case "POST /volumes":
+		var req eseries.MsgVolumeEx
+		json.NewDecoder(r.Body).Decode(&req)
+		v := eseries.MsgVolumeExResponse{
+			Label:          req.Name,
+			VolumeSize:     strconv.Itoa(req.Size * 1024),
+			SegmentSize:    req.SegmentSize * 1024,
+			VolumeRef:      f.ref(),
+			VolumeGroupRef: req.VolumeGroupRef,
+			VolumeTags:     req.VolumeTags,
+		}
+		f.volumes = append(f.volumes, v)
+		reply(v)
+	case "GET /snapshot-groups":
+		reply(f.groups)
+	case "POST /snapshot-groups":
+		var req eseries.SnapshotGroupCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		g := eseries.SnapshotGroup{PitGroupRef: f.ref(), Label: req.Name, BaseVolume: req.BaseMappableObjectID}
+		f.groups = append(f.groups, g)
+		reply(g)
+	case "GET /snapshot-images":
+		reply(f.images)
+	case "POST /snapshot-images":
+		var req eseries.SnapshotImageCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		for _, g := range f.groups {
+			if g.PitGroupRef == req.GroupID {
+				i := eseries.SnapshotImage{
+					PitRef:            f.ref(),
+					PitGroupRef:       g.PitGroupRef,
+					BaseVolume:        g.BaseVolume,
+					PitTimestamp:      "1476000000", //as if every image were taken in the same second
+					PitSequenceNumber: strconv.Itoa(len(f.images) + 1),
+				}
+				f.images = append(f.images, i)
+				reply(i)
+				return
+			}
+		}
+		w.WriteHeader(eseries.GenericResponseNotFound)
+		reply(eseries.CallResponseError{ErrorMsg: "No such snapshot group"})
+	case "GET /snapshot-volumes":
+		reply(f.snapshots)
+	case "POST /snapshot-volumes":
+		var req eseries.SnapshotVolumeCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		for _, i := range f.images {
+			if i.PitRef == req.SnapshotImageID {
+				s := eseries.SnapshotVolume{ViewRef: f.ref(), Label: req.Name, BaseVolume: i.BaseVolume, BasePIT: i.PitRef}
+				f.snapshots = append(f.snapshots, s)
+				reply(s)
+				return
+			}
+		}
+		w.WriteHeader(eseries.GenericResponseNotFound)
+		reply(eseries.CallResponseError{ErrorMsg: "No such snapshot image"})
+	case "GET /host-types":
+		reply([]eseries.HostType{{Index: 7, Code: "LNX"}, {Index: 28, Code: "LnxALUA"}})
+	case "GET /hosts":
+		reply(f.hosts)
+	case "POST /hosts":
+		var req eseries.HostCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		h := eseries.HostExResponse{
+			HostRef:       f.ref(),
+			ClusterRef:    req.GroupID,
+			Label:         req.Name,
+			HostTypeIndex: req.HostType.Index,
+		}
+		if h.ClusterRef == "" {
+			h.ClusterRef = strings.Repeat("0", 40)
+		}
+		for _, port := range req.Ports {
+			h.Initiators = append(h.Initiators, eseries.HostExInitiator{
+				InitiatorRef: f.ref(),
+				NodeName:     eseries.HostExScsiNodeName{IoInterfaceType: port.Type, IscsiNodeName: port.Port},
+				Label:        port.Label,
+			})
+		}
+		f.hosts = append(f.hosts, h)
+		reply(h)
+	case "GET /host-groups":
+		reply(f.hostGroups)
+	case "POST /host-groups":
+		var req eseries.HostGroupCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		g := eseries.HostGroup{ClusterRef: f.ref(), Label: req.Name}
+		f.hostGroups = append(f.hostGroups, g)
+		reply(g)
+	case "GET /volume-mappings":
+		reply(f.mappings)
+	case "POST /volume-mappings":
+		var req eseries.VolumeMappingCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		m := eseries.LUNMapping{LunMappingRef: f.ref(), LunNumber: len(f.mappings) + 1, VolumeRef: req.MappableObjectId, HostRef: req.TargetID}
+		f.mappings = append(f.mappings, m)
+		reply(m)
+	default:
+		if r.Method == "POST" && strings.HasPrefix(resource, "/hosts/") {
+			var req eseries.HostUpdateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			for i, h := range f.hosts {
+				if h.HostRef == strings.TrimPrefix(resource, "/hosts/") {
+					f.hosts[i].ClusterRef = req.GroupID
+					reply(f.hosts[i])
+					return
+				}
+			}
+		}
+		if r.Method == "DELETE" && strings.HasPrefix(resource, "/snapshot-volumes/") {
+			ref := strings.TrimPrefix(resource, "/snapshot-volumes/")
+			for i, s := range f.snapshots {
+				if s.ViewRef == ref {
+					f.snapshots = append(f.snapshots[:i], f.snapshots[i+1:]...)
+					w.WriteHeader(eseries.GenericResponseNoContent)
+					return
+				}
+			}
+		}
+		w.WriteHeader(eseries.GenericResponseNotFound)
+		reply(eseries.CallResponseError{ErrorMsg: "Unknown resource " + resource})
+	}
+}
+
+// newFakeESeriesDriver returns a driver connected to the fake proxy
+func newFakeESeriesDriver(t *testing.T, f *fakeESeriesProxy, hostGroup, hostType string) *ESeriesStorageDriver {
+	u, _ := url.Parse(f.URL)
+	storage := eseries.NewDriver(eseries.DriverConfig{
+		WebProxyHostname: u.Hostname(),
+		WebProxyPort:     u.Port(),
+		WebProxyUseHTTP:  true,
+		HostGroup:        hostGroup,
+		HostType:         hostType,
+		Protocol:         "iscsi",
+		DriverName:       "eseries-iscsi",
+		Version:          1,
+	})
+	if _, err := storage.Connect(); err != nil {
+		t.Fatalf("Unexpected error connecting to the fake proxy: %v", err)
+	}
+	return &ESeriesStorageDriver{Initialized: true, Storage: storage}
+}
+
+func TestESeries_Clones(t *testing.T) {
+	log.Debug("Running storage_drivers.TestESeries_Clones...")
+
+	f := newFakeESeriesProxy()
+	defer f.Close()
+	d := newFakeESeriesDriver(t, f, "", "")
+
+	if err := d.Create("netappdvp_vol1", map[string]string{"size": "2g", "fstype": "xfs"}); err != nil {
+		t.Fatalf("Unexpected error creating a volume: %v", err)
+	}
+	if snapshots, err := d.SnapshotList("netappdvp_vol1"); err != nil || len(snapshots) != 0 {
+		t.Errorf("Expected no snapshots, got %v %v", snapshots, err)
+	}
+
+	if err := d.CreateClone("netappdvp_clone1", "netappdvp_vol1", "", "netappdvp_"); err != nil {
+		t.Fatalf("Unexpected error creating a clone: %v", err)
+	}
+	snapshots, err := d.SnapshotList("netappdvp_vol1")
+	if err != nil || len(snapshots) != 1 {
+		t.Fatalf("Expected one snapshot, got %v %v", snapshots, err)
+	}
+	if expected := (CommonSnapshot{"20161009T080000Z_1", "2016-10-09T08:00:00Z"}); snapshots[0] != expected {
+		t.Errorf("Expected snapshot %+v, got %+v", expected, snapshots[0])
+	}
+
+	// a named snapshot is reused rather than taken again
+	if err := d.CreateClone("netappdvp_clone2", "netappdvp_vol1", snapshots[0].Name, "netappdvp_"); err != nil {
+		t.Fatalf("Unexpected error creating a clone from a snapshot: %v", err)
+	}
+	if len(f.groups) != 1 || len(f.images) != 1 || len(f.snapshots) != 2 {
+		t.Errorf("Expected one snapshot group, one image and two snapshot volumes, got %v, %v and %v",
+			len(f.groups), len(f.images), len(f.snapshots))
+	}
+	if err := d.CreateClone("netappdvp_clone3", "netappdvp_vol1", "20000101T000000Z", "netappdvp_"); err == nil {
+		t.Error("Expected an error cloning from an unknown snapshot")
+	}
+	if err := d.CreateClone("netappdvp_clone3", "netappdvp_clone1", "", "netappdvp_"); err == nil {
+		t.Error("Expected an error cloning a snapshot volume")
+	}
+
+	// a restarted driver finds the clone and its source on the array
+	d2 := newFakeESeriesDriver(t, f, "", "")
+	opts, err := d2.GetVolumeOpts("netappdvp_clone2")
+	if err != nil {
+		t.Fatalf("Unexpected error reading clone options: %v", err)
+	}
+	if opts["fstype"] != "xfs" || opts[CloneSourceOpt] != "netappdvp_vol1" || opts[CloneSnapshotOpt] != snapshots[0].Name {
+		t.Errorf("Unexpected clone options %v", opts)
+	}
+	if clones, err := d2.Storage.GetClones("netappdvp_vol1"); err != nil || len(clones) != 2 {
+		t.Errorf("Expected two clones, got %v %v", clones, err)
+	}
+
+	if err := d2.Storage.DestroyVolume("netappdvp_clone2"); err != nil {
+		t.Fatalf("Unexpected error destroying a clone: %v", err)
+	}
+	if len(f.snapshots) != 1 || f.snapshots[0].Label != "netappdvp_clone1" {
+		t.Errorf("Expected only netappdvp_clone1 to remain, got %+v", f.snapshots)
+	}
+
+	// snapshots taken within the same second still have names of their own
+	if err := d2.CreateClone("netappdvp_clone3", "netappdvp_vol1", "", "netappdvp_"); err != nil {
+		t.Fatalf("Unexpected error creating a clone: %v", err)
+	}
+	snapshots, err = d2.SnapshotList("netappdvp_vol1")
+	if err != nil || len(snapshots) != 2 || snapshots[0].Name == snapshots[1].Name {
+		t.Errorf("Expected two snapshots with different names, got %v %v", snapshots, err)
+	}
+}
+
+func TestESeries_Hosts(t *testing.T) {
+	log.Debug("Running storage_drivers.TestESeries_Hosts...")
+
+	f := newFakeESeriesProxy()
+	defer f.Close()
+
+	// hosts are created with a port for each IQN, in the host group
+	node1 := newFakeESeriesDriver(t, f, "docker", "")
+	node1IQNs := []string{"iqn.1994-05.com.redhat:node1a", "iqn.1994-05.com.redhat:node1b"}
+	if _, err := node1.Storage.GetMappingTarget("node1", node1IQNs, false); err == nil {
+		t.Error("Expected an error for a missing host group")
+	}
+	groupRef, err := node1.Storage.GetMappingTarget("node1", node1IQNs, true)
+	if err != nil {
+		t.Fatalf("Unexpected error creating the host: %v", err)
+	}
+	if len(f.hostGroups) != 1 || f.hostGroups[0].Label != "docker" || groupRef != f.hostGroups[0].ClusterRef {
+		t.Fatalf("Expected volumes to be mapped to host group docker, got %v and %+v", groupRef, f.hostGroups)
+	}
+	if len(f.hosts) != 1 || f.hosts[0].Label != "node1" || f.hosts[0].ClusterRef != groupRef ||
+		f.hosts[0].HostTypeIndex != 28 || len(f.hosts[0].Initiators) != 2 {
+		t.Fatalf("Unexpected hosts %+v", f.hosts)
+	}
+	if ref, err := node1.Storage.GetMappingTarget("node1", node1IQNs[1:], true); err != nil || ref != groupRef || len(f.hosts) != 1 {
+		t.Errorf("Expected the existing host to be found, got %v %v and %v hosts", ref, err, len(f.hosts))
+	}
+
+	// a volume mapped to the host group is mapped to every host in it
+	if err := node1.Create("netappdvp_vol1", map[string]string{}); err != nil {
+		t.Fatalf("Unexpected error creating a volume: %v", err)
+	}
+	if _, err := node1.Storage.MapVolume("netappdvp_vol1", groupRef); err != nil {
+		t.Fatalf("Unexpected error mapping a volume: %v", err)
+	}
+	node2 := newFakeESeriesDriver(t, f, "docker", "")
+	ref, err := node2.Storage.GetMappingTarget("node2", []string{"iqn.1994-05.com.redhat:node2"}, true)
+	if err != nil || ref != groupRef || len(f.hosts) != 2 {
+		t.Fatalf("Expected node2 to join the host group, got %v %v and %v hosts", ref, err, len(f.hosts))
+	}
+	if err := node2.Storage.VerifyVolumeExists("netappdvp_vol1"); err != nil {
+		t.Fatalf("Unexpected error finding the volume: %v", err)
+	}
+	if isMapped, _, err := node2.Storage.IsVolumeAlreadyMappedToHost("netappdvp_vol1", ref); !isMapped || err != nil {
+		t.Errorf("Expected the volume to be mapped to node2 through the host group, got %v %v", isMapped, err)
+	}
+
+	// without a host group volumes are mapped to the host, created with the configured host type
+	node3 := newFakeESeriesDriver(t, f, "", "linux_mpp_rdac")
+	hostRef, err := node3.Storage.GetMappingTarget("node3", []string{"iqn.1994-05.com.redhat:node3"}, true)
+	if err != nil || len(f.hosts) != 3 || hostRef != f.hosts[2].HostRef || f.hosts[2].HostTypeIndex != 7 {
+		t.Fatalf("Expected host node3 to be created, got %v %v and %+v", hostRef, err, f.hosts)
+	}
+
+	// an existing host joins the host group
+	node3 = newFakeESeriesDriver(t, f, "docker", "")
+	if ref, err := node3.Storage.GetMappingTarget("node3", []string{"iqn.1994-05.com.redhat:node3"}, true); err != nil ||
+		ref != groupRef || f.hosts[2].ClusterRef != groupRef {
+		t.Errorf("Expected node3 to join the host group, got %v %v and %+v", ref, err, f.hosts[2])
+	}
+	other := newFakeESeriesDriver(t, f, "other", "")
+	if _, err := other.Storage.GetMappingTarget("node3", []string{"iqn.1994-05.com.redhat:node3"}, true); err == nil {
+		t.Error("Expected an error for a host in a different host group")
+	}
+}
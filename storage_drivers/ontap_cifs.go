@@ -0,0 +1,392 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package storage_drivers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/netapp/netappdvp/apis/ontap"
+	"github.com/netapp/netappdvp/utils"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+func init() {
+	cifs := &OntapCIFSStorageDriver{}
+	cifs.Initialized = false
+	Drivers[cifs.Name()] = cifs
+	log.Debugf("Registered driver '%v'", cifs.Name())
+}
+
+// OntapCIFSStorageDriverName is the constant name for this Ontap CIFS storage driver
+const OntapCIFSStorageDriverName = "ontap-cifs"
+
+// OntapCIFSStorageDriver is for SMB storage provisioning; each volume is shared under its own name
+type OntapCIFSStorageDriver struct {
+	Initialized  bool
+	Config       OntapStorageDriverConfig
+	API          ontap.API
+	Capabilities ontap.Capabilities // features of the ONTAPI version negotiated by Validate
+
+	nextAggregate int       // position in the aggregate list for the roundRobin placement policy
+	ems           *OntapEms // EMS events about volume operations
+}
+
+// ValidateOntapCIFSSecurityStyle checks that a CIFS volume's security style is ntfs or mixed, returning
+// ntfs when none is set
+func ValidateOntapCIFSSecurityStyle(style string) (string, error) {
+	switch style {
+	case "":
+		return "ntfs", nil
+	case "ntfs", "mixed":
+		return style, nil
+	}
+	return "", fmt.Errorf("Invalid securityStyle for %v: %v, expected ntfs or mixed", OntapCIFSStorageDriverName, style)
+}
+
+// Name is for returning the name of this driver
+func (d *OntapCIFSStorageDriver) Name() string {
+	log.Debugf("OntapCIFSStorageDriver#Name()")
+	return OntapCIFSStorageDriverName
+}
+
+// Initialize from the provided config
+func (d *OntapCIFSStorageDriver) Initialize(configJSON string) error {
+	log.Debugf("OntapCIFSStorageDriver#Initialize(...)")
+
+	config := &OntapStorageDriverConfig{}
+
+	// decode configJSON into OntapStorageDriverConfig object
+	err := json.Unmarshal([]byte(configJSON), &config)
+	if err != nil {
+		return fmt.Errorf("Cannot decode json configuration error: %v", err)
+	}
+
+	log.WithFields(log.Fields{
+		"Version":           config.Version,
+		"StorageDriverName": config.StorageDriverName,
+		"Debug":             config.Debug,
+		"DisableDelete":     config.DisableDelete,
+		"StoragePrefixRaw":  string(config.StoragePrefixRaw),
+		"SnapshotPrefixRaw": string(config.SnapshotPrefixRaw),
+	}).Debugf("Reparsed into ontapConfig")
+
+	d.Config = *config
+	d.API, err = InitializeOntapDriver(d.Config)
+	if err != nil {
+		return fmt.Errorf("Problem while initializing, error: %v", err)
+	}
+
+	validationErr := d.Validate()
+	if validationErr != nil {
+		return fmt.Errorf("Problem validating OntapCIFSStorageDriver error: %v", validationErr)
+	}
+
+	// log an informational message when this plugin starts, and the volume count from then on
+	prefix := d.Config.StoragePrefix(d.DefaultStoragePrefix())
+	d.ems = NewOntapEms(d.Name(), d.API, prefix, OntapFlexvolCounter(d.API, prefix))
+	d.ems.Initialized()
+	heartbeatInterval, _ := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval) // checked by Validate
+	d.ems.StartHeartbeat(heartbeatInterval)
+
+	d.Initialized = true
+	log.Infof("Successfully initialized Ontap CIFS Docker driver version %v", DriverVersion)
+	return nil
+}
+
+// Validate the driver configuration and execution environment
+func (d *OntapCIFSStorageDriver) Validate() error {
+	log.Debugf("OntapCIFSStorageDriver#Validate()")
+
+	if d.Config.CIFSUsername == "" {
+		return fmt.Errorf("Missing cifsUsername, the user that mounts the shares")
+	}
+	if _, err := ValidateOntapCIFSSecurityStyle(d.Config.SecurityStyle); err != nil {
+		return err
+	}
+	if err := ValidateCloneDestroyPolicy(d.Config.CloneDestroyPolicy); err != nil {
+		return err
+	}
+	if _, err := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval); err != nil {
+		return err
+	}
+
+	r0, err0 := d.API.SystemGetVersion()
+	if err0 != nil {
+		return fmt.Errorf("Could not validate credentials for %v@%v, error: %v", d.Config.Username, d.Config.SVM, err0)
+	}
+
+	systemVersion := r0.Result
+	if systemVersion.VersionPtr == nil {
+		return fmt.Errorf("Could not determine system version for %v@%v", d.Config.Username, d.Config.SVM)
+	}
+
+	// requests use the newest ONTAPI version both sides speak, which decides the features we can offer
+	capabilities, err := NegotiateOntapCapabilities(d.Config, d.API, systemVersion.Version())
+	if err != nil {
+		return err
+	}
+	d.Capabilities = capabilities
+
+	if err := ValidateOntapDataLIF(&d.Config, d.API, "cifs"); err != nil {
+		return err
+	}
+
+	if err := ValidateOntapAggregates(d.Config, d.API); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createShare shares the volume, mounted at its junction, under the volume's name
+func (d *OntapCIFSStorageDriver) createShare(name string) error {
+	response, err := d.API.CifsShareCreate(name, "/"+name, "")
+	if err != nil {
+		if !ontap.IsAlreadyExists(err) {
+			return fmt.Errorf("Error creating CIFS share: %v\n%verror: %v", name, response.Result, err)
+		}
+		log.Debugf("CIFS share %v already exists", name)
+	}
+	return nil
+}
+
+// deleteShare removes the volume's share, which may already be gone
+func (d *OntapCIFSStorageDriver) deleteShare(name string) error {
+	response, err := d.API.CifsShareDelete(name)
+	if err != nil {
+		if !ontap.IsNotFound(err) {
+			return fmt.Errorf("Error deleting CIFS share: %v\n%verror: %v", name, response.Result, err)
+		}
+		log.Warnf("CIFS share already deleted while destroying volume: %v", name)
+	}
+	return nil
+}
+
+// Create a volume with the specified options
+func (d *OntapCIFSStorageDriver) Create(name string, opts map[string]string) error {
+	log.Debugf("OntapCIFSStorageDriver#Create(%v)", name)
+
+	if _, err := d.API.VolumeSize(name); err == nil {
+		if IsOntapVolumeDeletePending(name, d.API) {
+			return fmt.Errorf("Volume %v has been removed and is pending deletion until its clones are destroyed", name)
+		}
+		log.Debugf("%v already exists, skipping volume create...", name)
+		return nil
+	}
+
+	// get options with default values if not specified in config file
+	volumeSize := utils.GetV(opts, "size", "1g")
+	spaceReserve := utils.GetV(opts, "spaceReserve", "none")
+	snapshotPolicy := utils.GetV(opts, "snapshotPolicy", "none")
+	snapshotDir := utils.GetV(opts, "snapshotDir", "true")
+	exportPolicy := utils.GetV(opts, "exportPolicy", "default")
+	aggregate := utils.GetV(opts, "aggregate", "")
+	if aggregate == "" {
+		var err error
+		if aggregate, err = SelectOntapAggregate(d.Config, d.API, &d.nextAggregate); err != nil {
+			return fmt.Errorf("Problem selecting aggregate for volume %v: %v", name, err)
+		}
+	}
+
+	if err := ValidateOntapQosOpts(opts, d.API); err != nil {
+		return err
+	}
+
+	props, err := GetOntapVolumeProperties(opts, d.Config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return err
+	}
+	if props.SecurityStyle, err = ValidateOntapCIFSSecurityStyle(props.SecurityStyle); err != nil {
+		return err
+	}
+	if err := ValidateOntapVolumeCapabilities(props, d.Capabilities); err != nil {
+		return err
+	}
+
+	// remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	effectiveOpts["size"] = volumeSize
+	effectiveOpts["spaceReserve"] = spaceReserve
+	effectiveOpts["snapshotPolicy"] = snapshotPolicy
+	effectiveOpts["snapshotDir"] = snapshotDir
+	effectiveOpts["exportPolicy"] = exportPolicy
+	effectiveOpts["aggregate"] = aggregate
+	props.AddTo(effectiveOpts)
+
+	comment, err := EncodeOntapVolumeOpts(name, effectiveOpts)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"name":           name,
+		"volumeSize":     volumeSize,
+		"spaceReserve":   spaceReserve,
+		"snapshotPolicy": snapshotPolicy,
+		"exportPolicy":   exportPolicy,
+		"aggregate":      aggregate,
+		"properties":     props,
+	}).Debug("Creating volume with values")
+
+	// create the volume; unix permissions do not apply to ntfs or mixed security style
+	response1, error1 := d.API.VolumeCreate(name, aggregate, volumeSize, spaceReserve, snapshotPolicy, "", exportPolicy,
+		props.SecurityStyle, props.TieringPolicy, props.SnapshotReserve, props.Encryption)
+	if error1 != nil {
+		if !ontap.IsJobExists(error1) {
+			return fmt.Errorf("Error creating volume\n%verror: %v", response1.Result, error1)
+		}
+		// another host is creating the same volume; don't report success until its job is done
+		log.Warnf("%v volume create job already exists, waiting for it to complete...", name)
+		return WaitForOntapVolumeJobs(name, d.API)
+	}
+
+	// from here on, a failure destroys the volume, so that a retried create doesn't find it half set up
+	complete := false
+	defer func() {
+		if !complete {
+			DestroyFailedOntapVolume(name, d.API)
+		}
+	}()
+
+	if snapshotDir != "true" {
+		response2, error2 := d.API.VolumeDisableSnapshotDirectoryAccess(name)
+		if error2 != nil {
+			return fmt.Errorf("Error disabling snapshot directory access\n%verror: %v", response2.Result, error2)
+		}
+	}
+
+	// mount the volume at the specified junction, which the share points at
+	response3, error3 := d.API.VolumeMount(name, "/"+name)
+	if error3 != nil {
+		return fmt.Errorf("Error mounting volume to junction\n%verror: %v", response3.Result, error3)
+	}
+
+	// set autosize and storage efficiency, which volume-create does not take
+	if err := ApplyOntapVolumeProperties(name, props, d.API); err != nil {
+		return err
+	}
+
+	// apply the requested QoS policy group, creating one for maxIops/maxThroughput
+	if err := ApplyOntapQosPolicy(name, opts, d.API); err != nil {
+		return err
+	}
+
+	if err := d.createShare(name); err != nil {
+		return err
+	}
+
+	// the comment comes last, as other hosts creating the same volume wait for it
+	if err := SetOntapVolumeComment(name, comment, d.API); err != nil {
+		return err
+	}
+
+	complete = true
+	d.ems.Created(name)
+	return nil
+}
+
+// Create a volume clone
+func (d *OntapCIFSStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
+	if err := CreateOntapClone(name, source, snapshot, newSnapshotPrefix, d.API); err != nil {
+		return err
+	}
+
+	// the clone is mounted at its own junction, but the share of the source does not cover it
+	if err := d.createShare(name); err != nil {
+		return err
+	}
+
+	d.ems.Cloned(name, source)
+	return nil
+}
+
+// Destroy the volume
+func (d *OntapCIFSStorageDriver) Destroy(name string) error {
+	log.Debugf("OntapCIFSStorageDriver#Destroy(%v)", name)
+
+	// If this is the parent of one or more clones, the configured policy decides whether to
+	// refuse, split the clones off (a full copy of each), or wait until the clones are gone
+	destroyNow, err := ApplyOntapCloneDestroyPolicy(name, d.Config.CloneDestroyPolicy, d.API)
+	if err != nil {
+		return err
+	}
+
+	// a removed volume is no longer shared, even while its deletion is deferred
+	if err := d.deleteShare(name); err != nil {
+		return err
+	}
+	if !destroyNow {
+		return nil
+	}
+
+	// remember the parent, which may be waiting on this clone to be destroyed
+	parent, _, err := GetOntapCloneParent(name, d.API)
+	if err != nil {
+		log.Warnf("Could not determine the parent of volume %v: %v", name, err)
+	}
+
+	response, error := d.API.VolumeDestroy(name, true)
+	if error != nil {
+		if !ontap.IsNotFound(error) {
+			return fmt.Errorf("Error destroying volume: %v\n%verror: %v", name, response.Result, error)
+		} else {
+			log.Warnf("Volume already deleted while destroying volume: %v\n%verror: %v", name, response.Result, error)
+		}
+	}
+
+	DeleteOntapAutoQosPolicy(name, d.API)
+	DestroyDeferredOntapParent(parent, d.API)
+	d.ems.Destroyed(name)
+	return nil
+}
+
+// Attach the volume
+func (d *OntapCIFSStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
+	log.Debugf("OntapCIFSStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
+
+	if err := MountOntapCIFSShare(name, mountpoint, d.Config); err != nil {
+		return err
+	}
+
+	d.ems.Attached(name)
+	return nil
+}
+
+// Detach the volume
+func (d *OntapCIFSStorageDriver) Detach(name, mountpoint string) error {
+	log.Debugf("OntapCIFSStorageDriver#Detach(%v, %v)", name, mountpoint)
+
+	if err := UnmountOntapVolume(name, mountpoint); err != nil {
+		return err
+	}
+
+	d.ems.Detached(name)
+	return nil
+}
+
+// DefaultStoragePrefix is the driver specific prefix for created storage, can be overridden in the config file
+func (d *OntapCIFSStorageDriver) DefaultStoragePrefix() string {
+	return "netappdvp_"
+}
+
+// DefaultSnapshotPrefix is the driver specific prefix for created snapshots, can be overridden in the config file
+func (d *OntapCIFSStorageDriver) DefaultSnapshotPrefix() string {
+	return "netappdvp_"
+}
+
+// Return the list of snapshots associated with the named volume
+func (d *OntapCIFSStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
+	return GetSnapshotList(name, d.API)
+}
+
+// Return the options the named volume was created with
+func (d *OntapCIFSStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	return GetOntapVolumeOpts(name, d.API)
+}
+
+// Return the clone parent and children of the named volume
+func (d *OntapCIFSStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	return GetOntapVolumeStatus(name, d.API, d.Capabilities)
+}
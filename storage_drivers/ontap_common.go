@@ -5,23 +5,49 @@ package storage_drivers
 import (
 	"fmt"
 	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/netapp/netappdvp/apis/ontap"
-	"github.com/netapp/netappdvp/azgo"
+	"github.com/netapp/netappdvp/utils"
 
 	log "github.com/Sirupsen/logrus"
 )
 
-// InitializeOntapDriver will attempt to derive the SVM to use if not provided
-func InitializeOntapDriver(config OntapStorageDriverConfig) (*ontap.Driver, error) {
-	api := ontap.NewDriver(ontap.DriverConfig{
+// ParseOntapMaxRecords reads the maxRecords config file setting; empty means ontap.DefaultMaxRecords
+func ParseOntapMaxRecords(maxRecords string) (int, error) {
+	if maxRecords == "" {
+		return ontap.DefaultMaxRecords, nil
+	}
+	records, err := strconv.Atoi(maxRecords)
+	if err != nil || records <= 0 {
+		return 0, fmt.Errorf("Invalid maxRecords: %v, expected a positive number", maxRecords)
+	}
+	return records, nil
+}
+
+// InitializeOntapDriver returns the API for the configured transport and will attempt to derive the SVM to use
+// if not provided
+func InitializeOntapDriver(config OntapStorageDriverConfig) (ontap.API, error) {
+	maxRecords, err := ParseOntapMaxRecords(config.MaxRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := ontap.NewAPI(config.APITransport, ontap.DriverConfig{
 		ManagementLIF: config.ManagementLIF,
 		SVM:           config.SVM,
 		Username:      config.Username,
 		Password:      config.Password,
+		MaxRecords:    maxRecords,
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	if config.SVM != "" {
 		log.Debugf("Using specified SVM: %v", config.SVM)
@@ -30,8 +56,8 @@ func InitializeOntapDriver(config OntapStorageDriverConfig) (*ontap.Driver, erro
 
 	// use VserverGetIterRequest to populate config.SVM if it wasn't specified and we can derive it
 	response1, err := api.VserverGetIterRequest()
-	if !isPassed(response1.Result.ResultStatusAttr) || err != nil {
-		return nil, fmt.Errorf("Error enumerating SVMs:  status: %v error: %v", response1.Result.ResultStatusAttr, err)
+	if err != nil {
+		return nil, fmt.Errorf("Error enumerating SVMs: %v", err)
 	}
 	if response1.Result.NumRecords() != 1 {
 		return nil, fmt.Errorf("Cannot derive SVM to use, please specify SVM in config file.")
@@ -39,82 +65,895 @@ func InitializeOntapDriver(config OntapStorageDriverConfig) (*ontap.Driver, erro
 
 	// update everything to use our derived svm
 	config.SVM = response1.Result.AttributesList()[0].VserverName()
-	api = ontap.NewDriver(ontap.DriverConfig{
+	api, err = ontap.NewAPI(config.APITransport, ontap.DriverConfig{
 		ManagementLIF: config.ManagementLIF,
 		SVM:           config.SVM,
 		Username:      config.Username,
 		Password:      config.Password,
+		MaxRecords:    maxRecords,
 	})
+	if err != nil {
+		return nil, err
+	}
 	log.Debugf("Using derived SVM: %v", config.SVM)
 	return api, nil
 }
 
-// EmsInitialized logs an ASUP message that this docker volume plugin has been initialized
-// view them via filer::> event log show
-func EmsInitialized(driverName string, api *ontap.Driver) {
+// ontapCreatePollInterval is how often a volume that another host is creating is checked for its options
+var ontapCreatePollInterval = 2 * time.Second
+
+// ontapCreateTimeout is how long to wait for another host to finish a volume once its create job has completed
+var ontapCreateTimeout = ontap.DefaultJobTimeout
 
-	// log an informational message when this plugin starts
-	myHostname, hostlookupErr := os.Hostname()
-	if hostlookupErr != nil {
-		log.Warnf("problem while looking up hostname, error: %v", hostlookupErr)
-		myHostname = "unknown"
+// WaitForOntapVolumeJobs waits for the jobs another host started for the named volume, such as Create vol1,
+// then for that host to finish creating the volume.  ONTAP answers "Job exists" when a create is repeated while
+// the job of the first one is running, which happens when several hosts create the same volume at once.  The
+// first host goes on to mount the volume, apply QoS and so on once its job completes, and records the volume's
+// options in its comment as the last step, so the volume is ready once it has a comment.
+func WaitForOntapVolumeJobs(name string, api ontap.API) error {
+	if err := ontap.WaitForJobsByDescription(api, "* "+name, ontap.DefaultJobTimeout); err != nil {
+		return fmt.Errorf("Error waiting for the jobs of volume %v: %v", name, err)
 	}
 
-	_, emsErr := api.EmsAutosupportLog(strconv.Itoa(CurrentDriverVersion), false, "initialized", myHostname, driverName+" docker volume plugin initialized, version "+DriverVersion, 1, "netappdvp", 6)
-	if emsErr != nil {
-		log.Warnf("problem while logging ems message, error: %v", emsErr)
+	deadline := time.Now().Add(ontapCreateTimeout)
+	for {
+		response, err := api.VolumeGet(name)
+		if err != nil {
+			return fmt.Errorf("Error getting volume %v: %v", name, err)
+		}
+		if len(response.Result.AttributesList()) == 0 {
+			return fmt.Errorf("Volume %v does not exist after its jobs completed", name)
+		}
+		idAttrs := response.Result.AttributesList()[0].VolumeIdAttributesPtr
+		if idAttrs != nil && idAttrs.CommentPtr != nil && idAttrs.Comment() != "" {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Timed out waiting for another host to finish creating volume %v", name)
+		}
+		log.Debugf("Waiting for another host to finish creating volume %v", name)
+		time.Sleep(ontapCreatePollInterval)
 	}
 }
 
 // Create a volume clone
-func CreateOntapClone(name, source, snapshot, newSnapshotPrefix string, api *ontap.Driver) error {
+func CreateOntapClone(name, source, snapshot, newSnapshotPrefix string, api ontap.API) error {
 	log.Debugf("OntapCommon#CreateOntapClone(%v, %v, %v, %v)", name, source, snapshot, newSnapshotPrefix)
 
 	// If the specified volume already exists, skip creation and call it a success
-	response, err := api.VolumeSize(name)
-	if err != nil {
+	_, err := api.VolumeSize(name)
+	if err == nil {
+		return nil
+	}
+	if !ontap.IsNotFound(err) {
 		return fmt.Errorf("Error searching for existing volume: error: %v", err)
 	}
-	if isPassed(response.Result.ResultStatusAttr) {
-		return nil
+
+	// Don't hand out new clones of a volume that has already been removed
+	if IsOntapVolumeDeletePending(source, api) {
+		return fmt.Errorf("Volume %v has been removed and is pending deletion", source)
 	}
 
 	// If no specific snapshot was requested, create one
-	if snapshot == "" {
+	newSnapshot := snapshot == ""
+	if newSnapshot {
 		// This is golang being stupid: https://golang.org/pkg/time/#Time.Format
 		snapshot = newSnapshotPrefix + time.Now().UTC().Format("20060102T150405Z")
-		response, err := api.SnapshotCreate(snapshot, source)
-		if !isPassed(response.Result.ResultStatusAttr) || err != nil {
-			return fmt.Errorf("Error creating snapshot: status: %v error: %v", response.Result.ResultStatusAttr, err)
+	}
+
+	// Record where the clone came from, along with the options inherited from its source
+	sourceOpts, err := GetOntapVolumeOpts(source, api)
+	if err != nil {
+		log.Warnf("Could not read options of clone source %v: %v", source, err)
+	}
+	comment, err := EncodeOntapVolumeOpts(name, CloneVolumeOpts(sourceOpts, source, snapshot))
+	if err != nil {
+		return err
+	}
+	if err := ValidateOntapQosOpts(sourceOpts, api); err != nil {
+		return err
+	}
+
+	if newSnapshot {
+		_, err := api.SnapshotCreate(snapshot, source)
+		if err != nil {
+			return fmt.Errorf("Error creating snapshot: %v", err)
 		}
 	}
 
 	// Create the clone based on a snapshot
-	response2, err2 := api.VolumeCloneCreate(name, source, snapshot)
-	if !isPassed(response2.Result.ResultStatusAttr) || err2 != nil {
-		if response2.Result.ResultErrnoAttr == azgo.EOBJECTNOTFOUND {
+	_, err2 := api.VolumeCloneCreate(name, source, snapshot)
+	if err2 != nil {
+		if ontap.IsNotFound(err2) {
 			return fmt.Errorf("Snapshot does not exist in volume")
+		} else if ontap.IsJobExists(err2) {
+			log.Warnf("%v volume clone job already exists, waiting for it to complete...", name)
+			return WaitForOntapVolumeJobs(name, api)
 		} else {
-			return fmt.Errorf("Error creating clone: status: %v error: %v", response2.Result.ResultStatusAttr, err2)
+			return fmt.Errorf("Error creating clone: %v", err2)
 		}
 	}
 
+	// From here on, a failure destroys the clone, so that a retried create doesn't find it half set up
+	complete := false
+	defer func() {
+		if !complete {
+			DestroyFailedOntapVolume(name, api)
+		}
+	}()
+
 	// Mount the new volume
-	response3, err3 := api.VolumeMount(name, "/"+name)
-	if !isPassed(response3.Result.ResultStatusAttr) || err3 != nil {
-		return fmt.Errorf("Error mounting volume to junction: status: %v error: %v", response3.Result.ResultStatusAttr, err3)
+	_, err3 := api.VolumeMount(name, "/"+name)
+	if err3 != nil {
+		return fmt.Errorf("Error mounting volume to junction: %v", err3)
+	}
+
+	// A clone must not share its source's QoS policy group, which is removed along with the source
+	if err := ApplyOntapQosPolicy(name, sourceOpts, api); err != nil {
+		return err
+	}
+
+	// The comment comes last, as other hosts creating the same clone wait for it
+	if err := SetOntapVolumeComment(name, comment, api); err != nil {
+		return err
+	}
+
+	complete = true
+	return nil
+}
+
+// ontapMaxCommentLength is the longest volume comment ONTAP will accept
+const ontapMaxCommentLength = 1023
+
+// EncodeOntapVolumeOpts serializes the options a volume is created with into a volume comment.  Drivers call it
+// before creating the volume, so that options too long for a comment are refused without leaving a volume behind.
+func EncodeOntapVolumeOpts(name string, opts map[string]string) (string, error) {
+	comment, err := EncodeVolumeOpts(opts)
+	if err != nil {
+		return "", err
+	}
+	if len(comment) > ontapMaxCommentLength {
+		return "", fmt.Errorf("Options for volume %v exceed the maximum comment length of %v characters", name, ontapMaxCommentLength)
+	}
+	return comment, nil
+}
+
+// SetOntapVolumeComment stores a comment made by EncodeOntapVolumeOpts on the volume
+func SetOntapVolumeComment(name, comment string, api ontap.API) error {
+	_, err := api.VolumeSetComment(name, comment)
+	if err != nil {
+		return fmt.Errorf("Error setting volume comment: %v", err)
+	}
+	return nil
+}
+
+// SetOntapVolumeOpts stores the options a volume was created with in the volume's comment
+func SetOntapVolumeOpts(name string, opts map[string]string, api ontap.API) error {
+	comment, err := EncodeOntapVolumeOpts(name, opts)
+	if err != nil {
+		return err
+	}
+	return SetOntapVolumeComment(name, comment, api)
+}
+
+// GetOntapVolumeOpts returns the options a volume was created with, as recorded in the volume's comment.
+// Volumes that were created without options, or whose comment was changed by an administrator, report none.
+func GetOntapVolumeOpts(name string, api ontap.API) (map[string]string, error) {
+	response, err := api.VolumeGet(name)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting volume %v: %v", name, err)
+	}
+	if len(response.Result.AttributesList()) == 0 {
+		return nil, fmt.Errorf("Volume %v not found", name)
+	}
+
+	idAttrs := response.Result.AttributesList()[0].VolumeIdAttributesPtr
+	if idAttrs == nil || idAttrs.CommentPtr == nil {
+		return make(map[string]string), nil
+	}
+
+	opts, err := DecodeVolumeOpts(idAttrs.Comment())
+	if err != nil {
+		log.Warnf("Ignoring comment of volume %v: %v", name, err)
+	}
+	return opts, nil
+}
+
+// Aggregate placement policies, set with aggregatePolicy in the config file
+const (
+	AggregatePolicyMostFree   = "mostFree"   // the aggregate with the most available space (default)
+	AggregatePolicyRoundRobin = "roundRobin" // each aggregate in turn
+)
+
+// ValidateAggregatePolicy checks the aggregatePolicy config file setting; empty means mostFree
+func ValidateAggregatePolicy(policy string) error {
+	switch policy {
+	case "", AggregatePolicyMostFree, AggregatePolicyRoundRobin:
+		return nil
+	}
+	return fmt.Errorf("Unsupported aggregatePolicy: %v, expected %v or %v",
+		policy, AggregatePolicyMostFree, AggregatePolicyRoundRobin)
+}
+
+// OntapAggregates returns the aggregates named in the config file, aggregate first, without duplicates
+func OntapAggregates(config OntapStorageDriverConfig) []string {
+	aggregates := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, aggr := range append([]string{config.Aggregate}, config.Aggregates...) {
+		if aggr != "" && !seen[aggr] {
+			seen[aggr] = true
+			aggregates = append(aggregates, aggr)
+		}
+	}
+	return aggregates
+}
+
+// GetOntapSVMAggregates returns the aggregates assigned to the SVM, in the order ONTAP lists them, and the
+// space available on each
+func GetOntapSVMAggregates(api ontap.API) ([]string, map[string]int, error) {
+	response, err := api.VserverShowAggrGetIter()
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error listing aggregates assigned to SVM: %v", err)
+	}
+
+	names := make([]string, 0)
+	available := make(map[string]int)
+	for _, aggr := range response.Result.AttributesList() {
+		if aggr.AggregateNamePtr == nil {
+			continue
+		}
+		name := string(aggr.AggregateName())
+		names = append(names, name)
+		if aggr.AvailableSizePtr != nil {
+			available[name] = int(aggr.AvailableSize())
+		}
+	}
+	return names, available, nil
+}
+
+// ValidateOntapAggregates checks that the configured aggregates exist and are assigned to the SVM
+func ValidateOntapAggregates(config OntapStorageDriverConfig, api ontap.API) error {
+	if err := ValidateAggregatePolicy(config.AggregatePolicy); err != nil {
+		return err
+	}
+
+	configured := OntapAggregates(config)
+	assigned, _, err := GetOntapSVMAggregates(api)
+	if err != nil {
+		if len(configured) == 0 {
+			return fmt.Errorf("No aggregate configured and the aggregates of the SVM are unknown: %v", err)
+		}
+		log.Warnf("Could not verify the configured aggregates: %v", err)
+		return nil
+	}
+
+	if len(configured) == 0 {
+		if len(assigned) == 0 {
+			return fmt.Errorf("No aggregate configured and none assigned to SVM %v", config.SVM)
+		}
+		log.Debugf("Using the aggregates assigned to the SVM: %v", assigned)
+		return nil
+	}
+
+	if len(assigned) == 0 {
+		// only cluster administrators may provision on an SVM without assigned aggregates
+		log.Warnf("SVM %v has no assigned aggregates; not checking aggregates %v", config.SVM, configured)
+		return nil
+	}
+
+	isAssigned := make(map[string]bool)
+	for _, aggr := range assigned {
+		isAssigned[aggr] = true
+	}
+
+	for _, aggr := range configured {
+		if isAssigned[aggr] {
+			continue
+		}
+
+		// aggr-get-iter needs cluster credentials, so tell the two cases apart only when we can
+		response, err := api.AggrGetIter()
+		if err == nil {
+			exists := false
+			for _, attrs := range response.Result.AttributesList() {
+				if attrs.AggregateNamePtr != nil && attrs.AggregateName() == aggr {
+					exists = true
+				}
+			}
+			if !exists {
+				return fmt.Errorf("Aggregate %v does not exist", aggr)
+			}
+		}
+		return fmt.Errorf("Aggregate %v is not assigned to SVM %v; assigned aggregates are %v", aggr, config.SVM, assigned)
+	}
+
+	return nil
+}
+
+// SelectOntapAggregate picks the aggregate for a new volume from the configured aggregates, or from the
+// aggregates assigned to the SVM if none are configured.  next tracks the position for roundRobin.
+func SelectOntapAggregate(config OntapStorageDriverConfig, api ontap.API, next *int) (string, error) {
+	candidates := OntapAggregates(config)
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+
+	assigned, available, err := GetOntapSVMAggregates(api)
+	if err != nil {
+		if len(candidates) == 0 {
+			return "", err
+		}
+		log.Warnf("Could not read aggregate space, falling back to round robin: %v", err)
+		return pickOntapAggregate(candidates, nil, AggregatePolicyRoundRobin, next), nil
+	}
+	if len(candidates) == 0 {
+		candidates = assigned
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("No aggregate configured and none assigned to SVM %v", config.SVM)
+	}
+
+	aggregate := pickOntapAggregate(candidates, available, config.AggregatePolicy, next)
+	log.Debugf("Selected aggregate %v from %v", aggregate, candidates)
+	return aggregate, nil
+}
+
+// pickOntapAggregate applies the placement policy to a non-empty list of candidate aggregates
+func pickOntapAggregate(candidates []string, available map[string]int, policy string, next *int) string {
+	if policy == AggregatePolicyRoundRobin {
+		aggregate := candidates[*next%len(candidates)]
+		*next++
+		return aggregate
+	}
+
+	best := candidates[0]
+	for _, aggr := range candidates[1:] {
+		if available[aggr] > available[best] {
+			best = aggr
+		}
+	}
+	return best
+}
+
+// OntapVolumeProperties holds the efficiency, encryption, tiering, snapshot reserve, security style and
+// autosize settings for a new volume
+type OntapVolumeProperties struct {
+	Deduplication       bool
+	Compression         string // none, background or inline
+	Encryption          bool
+	TieringPolicy       string // empty leaves the ONTAP default
+	SnapshotReserve     int    // negative leaves the ONTAP default
+	SecurityStyle       string // empty leaves the ONTAP default
+	AutosizeMode        string // empty leaves the ONTAP default
+	AutosizeMaximumSize string // in bytes; empty leaves the ONTAP default
+}
+
+// GetOntapVolumeProperties reads the volume properties from the create options, falling back to the config
+// file defaults, and validates them
+func GetOntapVolumeProperties(opts map[string]string, defaults OntapStorageDriverConfigDefaults) (OntapVolumeProperties, error) {
+	props := OntapVolumeProperties{SnapshotReserve: -1}
+	var err error
+
+	deduplication := utils.GetV(opts, "deduplication", defaults.Deduplication)
+	if deduplication != "" {
+		if props.Deduplication, err = strconv.ParseBool(deduplication); err != nil {
+			return props, fmt.Errorf("Invalid deduplication: %v, expected true or false", deduplication)
+		}
+	}
+
+	props.Compression = utils.GetV(opts, "compression", defaults.Compression)
+	switch props.Compression {
+	case "":
+		props.Compression = "none"
+	case "none", "background", "inline":
+	default:
+		return props, fmt.Errorf("Invalid compression: %v, expected none, background or inline", props.Compression)
+	}
+
+	encryption := utils.GetV(opts, "encryption", defaults.Encryption)
+	if encryption != "" {
+		if props.Encryption, err = strconv.ParseBool(encryption); err != nil {
+			return props, fmt.Errorf("Invalid encryption: %v, expected true or false", encryption)
+		}
+	}
+
+	props.TieringPolicy = utils.GetV(opts, "tieringPolicy", defaults.TieringPolicy)
+	switch props.TieringPolicy {
+	case "", "none", "snapshot-only", "auto", "backup":
+	default:
+		return props, fmt.Errorf("Invalid tieringPolicy: %v, expected none, snapshot-only, auto or backup", props.TieringPolicy)
+	}
+
+	snapshotReserve := utils.GetV(opts, "snapshotReserve", defaults.SnapshotReserve)
+	if snapshotReserve != "" {
+		props.SnapshotReserve, err = strconv.Atoi(snapshotReserve)
+		if err != nil || props.SnapshotReserve < 0 || props.SnapshotReserve > 90 {
+			return props, fmt.Errorf("Invalid snapshotReserve: %v, expected a percentage from 0 to 90", snapshotReserve)
+		}
+	}
+
+	props.SecurityStyle = utils.GetV(opts, "securityStyle", defaults.SecurityStyle)
+	switch props.SecurityStyle {
+	case "", "unix", "ntfs", "mixed":
+	default:
+		return props, fmt.Errorf("Invalid securityStyle: %v, expected unix, ntfs or mixed", props.SecurityStyle)
+	}
+
+	props.AutosizeMode = utils.GetV(opts, "autosizeMode", defaults.AutosizeMode)
+	switch props.AutosizeMode {
+	case "", "off", "grow", "grow_shrink":
+	default:
+		return props, fmt.Errorf("Invalid autosizeMode: %v, expected off, grow or grow_shrink", props.AutosizeMode)
+	}
+
+	autosizeMaximumSize := utils.GetV(opts, "autosizeMaximumSize", defaults.AutosizeMaximumSize)
+	if autosizeMaximumSize != "" {
+		if props.AutosizeMaximumSize, err = utils.ConvertSizeToBytes(autosizeMaximumSize); err != nil {
+			return props, fmt.Errorf("Invalid autosizeMaximumSize: %v error: %v", autosizeMaximumSize, err)
+		}
+		if props.AutosizeMode == "" {
+			props.AutosizeMode = "grow"
+		}
+	}
+
+	return props, nil
+}
+
+// AddTo records the volume properties in a volume's effective create options
+func (p OntapVolumeProperties) AddTo(opts map[string]string) {
+	opts["deduplication"] = strconv.FormatBool(p.Deduplication)
+	opts["compression"] = p.Compression
+	opts["encryption"] = strconv.FormatBool(p.Encryption)
+	if p.TieringPolicy != "" {
+		opts["tieringPolicy"] = p.TieringPolicy
+	}
+	if p.SnapshotReserve >= 0 {
+		opts["snapshotReserve"] = strconv.Itoa(p.SnapshotReserve)
+	}
+	if p.SecurityStyle != "" {
+		opts["securityStyle"] = p.SecurityStyle
+	}
+	if p.AutosizeMode != "" {
+		opts["autosizeMode"] = p.AutosizeMode
+	}
+	if p.AutosizeMaximumSize != "" {
+		opts["autosizeMaximumSize"] = p.AutosizeMaximumSize
+	}
+}
+
+// ValidateOntapVolumeCapabilities rejects volume properties the negotiated ONTAPI version cannot apply
+func ValidateOntapVolumeCapabilities(props OntapVolumeProperties, capabilities ontap.Capabilities) error {
+	if props.Encryption {
+		if err := capabilities.Require(ontap.FeatureVolumeEncryption, "encryption"); err != nil {
+			return err
+		}
+	}
+	if props.TieringPolicy != "" {
+		if err := capabilities.Require(ontap.FeatureTieringPolicy, "tieringPolicy"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NegotiateOntapCapabilities negotiates the ONTAPI version with the storage system and checks that the
+// configured transport and volume defaults are available with it
+func NegotiateOntapCapabilities(config OntapStorageDriverConfig, api ontap.API, systemVersion string) (ontap.Capabilities, error) {
+	capabilities, err := api.NegotiateOntapiVersion()
+	if err != nil {
+		return capabilities, fmt.Errorf("Could not negotiate ONTAPI version for %v@%v, error: %v", config.Username, config.SVM, err)
+	}
+	log.Infof("Storage system runs %v, using ONTAPI %v", systemVersion, capabilities.Version)
+
+	if config.APITransport == ontap.TransportREST {
+		if err := capabilities.Require(ontap.FeatureRestAPI, "apiTransport rest"); err != nil {
+			return capabilities, err
+		}
+	}
+
+	props, err := GetOntapVolumeProperties(map[string]string{}, config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return capabilities, err
+	}
+	if err := ValidateOntapVolumeCapabilities(props, capabilities); err != nil {
+		return capabilities, fmt.Errorf("Unsupported default in config file: %v", err)
+	}
+	return capabilities, nil
+}
+
+// ApplyOntapVolumeProperties sets the volume properties that cannot be given to volume-create
+func ApplyOntapVolumeProperties(name string, props OntapVolumeProperties, api ontap.API) error {
+	if props.AutosizeMode != "" {
+		response, err := api.VolumeSetAutosize(name, props.AutosizeMode, props.AutosizeMaximumSize)
+		if err != nil {
+			return fmt.Errorf("Error setting autosize on volume: %v\n%verror: %v", name, response.Result, err)
+		}
+	}
+
+	// compression needs efficiency enabled on the volume, just like deduplication
+	if props.Deduplication || props.Compression != "none" {
+		response, err := api.SisEnable(name)
+		if err != nil {
+			return fmt.Errorf("Error enabling storage efficiency on volume: %v\n%verror: %v", name, response.Result, err)
+		}
+	}
+
+	if props.Compression != "none" {
+		response, err := api.SisSetConfig(name, true, props.Compression == "inline")
+		if err != nil {
+			return fmt.Errorf("Error enabling compression on volume: %v\n%verror: %v", name, response.Result, err)
+		}
 	}
 
 	return nil
 }
 
+// ontapAutoQosPolicyName is the QoS policy group created for a volume from its maxIops and maxThroughput options
+func ontapAutoQosPolicyName(name string) string {
+	return name + "_qos"
+}
+
+// ontapThroughputRegexp matches the throughput units ONTAP accepts, such as 100MB/s
+var ontapThroughputRegexp = regexp.MustCompile(`^[0-9]+(B|KB|MB|GB|TB)/s$`)
+
+// OntapMaxThroughput converts the maxIops and maxThroughput options into an ONTAP throughput ceiling,
+// such as "1000iops,100MB/s".  A bare maxThroughput number is taken as MB/s.
+func OntapMaxThroughput(opts map[string]string) (string, error) {
+	limits := make([]string, 0)
+
+	if maxIops := utils.GetV(opts, "maxIops", ""); maxIops != "" {
+		iops, err := strconv.ParseUint(maxIops, 10, 64)
+		if err != nil || iops == 0 {
+			return "", fmt.Errorf("Invalid maxIops: %v, expected a positive number", maxIops)
+		}
+		limits = append(limits, strconv.FormatUint(iops, 10)+"iops")
+	}
+
+	if maxThroughput := utils.GetV(opts, "maxThroughput", ""); maxThroughput != "" {
+		throughput := strings.ToUpper(maxThroughput)
+		if _, err := strconv.ParseUint(throughput, 10, 64); err == nil {
+			throughput += "MB/S"
+		}
+		throughput = strings.TrimSuffix(throughput, "/S") + "/s"
+		if !ontapThroughputRegexp.MatchString(throughput) {
+			return "", fmt.Errorf("Invalid maxThroughput: %v, expected a size per second such as 100MB/s", maxThroughput)
+		}
+		limits = append(limits, throughput)
+	}
+
+	return strings.Join(limits, ","), nil
+}
+
+// ValidateOntapQosOpts checks the qosPolicy, maxIops and maxThroughput create options, including that a named
+// QoS policy group exists, so that a mistyped name is refused before the volume is created
+func ValidateOntapQosOpts(opts map[string]string, api ontap.API) error {
+	maxThroughput, err := OntapMaxThroughput(opts)
+	if err != nil {
+		return err
+	}
+	policy := utils.GetV(opts, "qosPolicy", "")
+	if maxThroughput != "" && policy != "" {
+		return fmt.Errorf("Specify either qosPolicy or maxIops/maxThroughput, not both")
+	}
+	if policy != "" {
+		exists, err := ontapQosPolicyExists(policy, api)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("QoS policy group %v does not exist", policy)
+		}
+	}
+	return nil
+}
+
+// ApplyOntapQosPolicy assigns the named volume to the QoS policy group named by the qosPolicy option, or to a
+// policy group created for the volume from the maxIops and maxThroughput options
+func ApplyOntapQosPolicy(name string, opts map[string]string, api ontap.API) error {
+	if err := ValidateOntapQosOpts(opts, api); err != nil {
+		return err
+	}
+	maxThroughput, _ := OntapMaxThroughput(opts)
+	policy := utils.GetV(opts, "qosPolicy", "")
+
+	if maxThroughput != "" {
+		policy = ontapAutoQosPolicyName(name)
+		exists, err := ontapQosPolicyExists(policy, api)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			response, err := api.QosPolicyGroupCreate(policy, maxThroughput)
+			if err != nil {
+				return fmt.Errorf("Error creating QoS policy group: %v\n%verror: %v", policy, response.Result, err)
+			}
+		}
+	} else if policy == "" {
+		return nil
+	}
+
+	response, err := api.VolumeSetQosPolicyGroupName(name, policy)
+	if err != nil {
+		return fmt.Errorf("Error assigning volume %v to QoS policy group: %v\n%verror: %v", name, policy, response.Result, err)
+	}
+	return nil
+}
+
+// DeleteOntapAutoQosPolicy removes the QoS policy group created for the named volume, if there is one.
+// Problems are logged rather than returned, as the volume itself is already gone.
+func DeleteOntapAutoQosPolicy(name string, api ontap.API) {
+	policy := ontapAutoQosPolicyName(name)
+	exists, err := ontapQosPolicyExists(policy, api)
+	if err != nil {
+		log.Warnf("Could not look up QoS policy group %v: %v", policy, err)
+		return
+	}
+	if !exists {
+		return
+	}
+
+	// force, as the destroyed volume may still count as a workload while it is in the recovery queue
+	response, err := api.QosPolicyGroupDelete(policy, true)
+	if err != nil {
+		log.Warnf("Error deleting QoS policy group: %v\n%verror: %v", policy, response.Result, err)
+	}
+}
+
+// DestroyFailedOntapVolume removes a volume whose create did not complete, along with any QoS policy group created
+// for it, so that a retried create starts over instead of finding a half-configured volume and calling it a success.
+// Problems are logged rather than returned, as the error that led here is the one to report.
+func DestroyFailedOntapVolume(name string, api ontap.API) {
+	response, err := api.VolumeDestroy(name, true)
+	if err != nil && !ontap.IsNotFound(err) {
+		log.Warnf("Error destroying incomplete volume: %v\n%verror: %v", name, response.Result, err)
+	}
+	DeleteOntapAutoQosPolicy(name, api)
+}
+
+// ontapQosPolicyExists reports whether the named QoS policy group exists
+func ontapQosPolicyExists(policy string, api ontap.API) (bool, error) {
+	response, err := api.QosPolicyGroupGet(policy)
+	if err != nil {
+		return false, fmt.Errorf("Error looking up QoS policy group %v: %v", policy, err)
+	}
+	return len(response.Result.AttributesList()) > 0, nil
+}
+
+// Policies for destroying a volume that is the parent of FlexClones, set with cloneDestroyPolicy in the config file
+const (
+	CloneDestroyPolicyRefuse = "refuse" // fail the destroy while clones exist
+	CloneDestroyPolicySplit  = "split"  // split the clones from the volume, then destroy it
+	CloneDestroyPolicyDefer  = "defer"  // destroy the volume once its last clone has been destroyed
+)
+
+// ontapDeletePendingOpt marks a volume whose destruction was deferred until its clones are gone
+const ontapDeletePendingOpt = "deletePending"
+
+// ontapCloneSplitTimeout is how long a destroy waits for each clone split to complete
+var ontapCloneSplitTimeout = 5 * time.Minute
+
+// ontapCloneSplitPollInterval is how often clones without a split job to wait on are checked
+var ontapCloneSplitPollInterval = 5 * time.Second
+
+// ValidateCloneDestroyPolicy checks the cloneDestroyPolicy config file setting; empty means refuse
+func ValidateCloneDestroyPolicy(policy string) error {
+	switch policy {
+	case "", CloneDestroyPolicyRefuse, CloneDestroyPolicySplit, CloneDestroyPolicyDefer:
+		return nil
+	}
+	return fmt.Errorf("Unsupported cloneDestroyPolicy: %v, expected one of %v, %v or %v",
+		policy, CloneDestroyPolicyRefuse, CloneDestroyPolicySplit, CloneDestroyPolicyDefer)
+}
+
+// GetOntapCloneParent returns the volume and snapshot the named volume was cloned from; both are empty
+// if the volume is not a clone
+func GetOntapCloneParent(name string, api ontap.API) (string, string, error) {
+	response, err := api.VolumeCloneGet(name)
+	if ontap.IsNotFound(err) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("Error getting clone information for volume %v: %v", name, err)
+	}
+	if response.Result.AttributesPtr == nil {
+		return "", "", nil
+	}
+
+	info := response.Result.Attributes()
+	parent, snapshot := "", ""
+	if info.ParentVolumePtr != nil {
+		parent = info.ParentVolume()
+	}
+	if info.ParentSnapshotPtr != nil {
+		snapshot = info.ParentSnapshot()
+	}
+	return parent, snapshot, nil
+}
+
+// GetOntapClones returns the names of the volumes cloned from the named volume
+func GetOntapClones(name string, api ontap.API) ([]string, error) {
+	response, err := api.VolumeListClones(name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing clones of volume %v: %v", name, err)
+	}
+
+	clones := make([]string, 0)
+	for _, attrs := range response.Result.AttributesList() {
+		if attrs.VolumeIdAttributesPtr != nil && attrs.VolumeIdAttributesPtr.NamePtr != nil {
+			clones = append(clones, string(attrs.VolumeIdAttributesPtr.Name()))
+		}
+	}
+	return clones, nil
+}
+
+// IsOntapVolumeDeletePending reports whether the named volume was removed with its destruction deferred
+func IsOntapVolumeDeletePending(name string, api ontap.API) bool {
+	opts, err := GetOntapVolumeOpts(name, api)
+	if err != nil {
+		log.Warnf("Could not read options of volume %v: %v", name, err)
+		return false
+	}
+	return opts[ontapDeletePendingOpt] == "true"
+}
+
+// ApplyOntapCloneDestroyPolicy prepares the named volume for destruction according to the policy.  It returns
+// false if the volume must not be destroyed yet because its destruction has been deferred.
+func ApplyOntapCloneDestroyPolicy(name, policy string, api ontap.API) (bool, error) {
+	log.Debugf("OntapCommon#ApplyOntapCloneDestroyPolicy(%v, %v)", name, policy)
+
+	clones, err := GetOntapClones(name, api)
+	if err != nil {
+		return false, err
+	}
+	if len(clones) == 0 {
+		return true, nil
+	}
+
+	switch policy {
+	case CloneDestroyPolicySplit:
+		if err := splitOntapClones(name, clones, api); err != nil {
+			return false, err
+		}
+		return true, nil
+
+	case CloneDestroyPolicyDefer:
+		opts, err := GetOntapVolumeOpts(name, api)
+		if err != nil {
+			return false, err
+		}
+		opts[ontapDeletePendingOpt] = "true"
+		if err := SetOntapVolumeOpts(name, opts, api); err != nil {
+			return false, err
+		}
+		log.Infof("Deferring destruction of volume %v until its clones %v are destroyed", name, clones)
+		return false, nil
+
+	default:
+		return false, fmt.Errorf("Volume %v has clones %v; destroy them first or change the cloneDestroyPolicy", name, clones)
+	}
+}
+
+// splitOntapClones splits each clone from the named parent volume and waits for the splits to finish
+func splitOntapClones(name string, clones []string, api ontap.API) error {
+	jobs := make(map[string]int)
+	for _, clone := range clones {
+		response, err := api.VolumeCloneSplitStart(clone)
+		if err != nil {
+			// a split may already be running from an earlier attempt, so keep waiting for it
+			log.Warnf("Problem starting split of clone %v from volume %v\n%verror: %v", clone, name, response.Result, err)
+		} else if response.Result.ResultJobidPtr != nil {
+			jobs[clone] = response.Result.ResultJobid()
+		}
+	}
+
+	// a split that fails ends its job, so report the job's state rather than waiting out the timeout; each job
+	// gets a timeout of its own, so that a long split does not use up the time of those after it
+	timedOut := false
+	for clone, jobID := range jobs {
+		err := ontap.WaitForJob(api, jobID, ontapCloneSplitTimeout)
+		if ontap.IsJobFailed(err) {
+			return fmt.Errorf("Error splitting clone %v from volume %v: %v", clone, name, err)
+		} else if err != nil {
+			log.Warnf("Problem waiting for split of clone %v from volume %v: %v", clone, name, err)
+			timedOut = true
+		}
+	}
+
+	// splits started by an earlier attempt have no job here, so wait for their clones to go, unless a job has
+	// already timed out
+	deadline := time.Now()
+	if !timedOut {
+		deadline = deadline.Add(ontapCloneSplitTimeout)
+	}
+	for {
+		remaining, err := GetOntapClones(name, api)
+		if err != nil {
+			return err
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Timed out waiting for clones %v of volume %v to split; the splits continue on the "+
+				"storage system, retry the destroy later", strings.Join(remaining, ", "), name)
+		}
+		for _, clone := range remaining {
+			response, err := api.VolumeCloneSplitStatus(clone)
+			if err == nil {
+				for _, detail := range response.Result.CloneSplitDetails() {
+					log.Debugf("Split of clone %v is %v%% complete", clone, detail.BlockPercentageComplete())
+				}
+			}
+		}
+		time.Sleep(ontapCloneSplitPollInterval)
+	}
+}
+
+// DestroyDeferredOntapParent destroys the named volume if its destruction was deferred and its last clone is
+// gone, then does the same for the volume it was cloned from.  Problems are logged rather than returned, as
+// the clone that triggered this has already been destroyed.
+func DestroyDeferredOntapParent(name string, api ontap.API) {
+	if name == "" || !IsOntapVolumeDeletePending(name, api) {
+		return
+	}
+
+	clones, err := GetOntapClones(name, api)
+	if err != nil {
+		log.Warnf("Could not list clones of volume %v pending deletion: %v", name, err)
+		return
+	}
+	if len(clones) > 0 {
+		log.Debugf("Volume %v pending deletion still has clones %v", name, clones)
+		return
+	}
+
+	parent, _, err := GetOntapCloneParent(name, api)
+	if err != nil {
+		log.Warnf("Could not determine the parent of volume %v: %v", name, err)
+	}
+
+	log.Infof("Destroying volume %v now that its last clone is gone", name)
+	response, err := api.VolumeDestroy(name, true)
+	if err != nil {
+		log.Warnf("Error destroying volume pending deletion: %v\n%verror: %v", name, response.Result, err)
+		return
+	}
+	DeleteOntapAutoQosPolicy(name, api)
+
+	DestroyDeferredOntapParent(parent, api)
+}
+
+// GetOntapVolumeStatus returns the clone lineage of the named volume and the ONTAPI version in use for
+// reporting in the volume status
+func GetOntapVolumeStatus(name string, api ontap.API, capabilities ontap.Capabilities) (map[string]interface{}, error) {
+	status := make(map[string]interface{})
+	status["OntapiVersion"] = capabilities.Version.String()
+
+	parent, snapshot, err := GetOntapCloneParent(name, api)
+	if err != nil {
+		return nil, err
+	}
+	if parent != "" {
+		status["Parent"] = parent
+		status["ParentSnapshot"] = snapshot
+	}
+
+	clones, err := GetOntapClones(name, api)
+	if err != nil {
+		return nil, err
+	}
+	status["Clones"] = clones
+
+	return status, nil
+}
+
 // Return the list of snapshots associated with the named volume
-func GetSnapshotList(name string, api *ontap.Driver) ([]CommonSnapshot, error) {
+func GetSnapshotList(name string, api ontap.API) ([]CommonSnapshot, error) {
 	log.Debugf("OntapCommon#GetSnapshotList(%v)", name)
 
 	response, err := api.SnapshotGetByVolume(name)
-	if !isPassed(response.Result.ResultStatusAttr) || err != nil {
-		return nil, fmt.Errorf("Error enumerating snapshots: status: %v error: %v", response.Result.ResultStatusAttr, err)
+	if err != nil {
+		return nil, fmt.Errorf("Error enumerating snapshots: %v", err)
 	}
 
 	log.Debugf("Returned %v snapshots", response.Result.NumRecords())
@@ -132,7 +971,97 @@ func GetSnapshotList(name string, api *ontap.Driver) ([]CommonSnapshot, error) {
 	return snapshots, nil
 }
 
-func isPassed(s string) bool {
-	const passed = "passed"
-	return s == passed
+// ValidateOntapDataLIF checks that the configured data LIF serves the protocol, nfs or cifs, or sets it to the
+// first LIF of the SVM that does if none is configured
+func ValidateOntapDataLIF(config *OntapStorageDriverConfig, api ontap.API, protocol string) error {
+	r1, err1 := api.NetInterfaceGet()
+	if err1 != nil {
+		return fmt.Errorf("Problem checking network interfaces error: %v", err1)
+	}
+
+	// if they didn't set a lif to use in the config, we'll set it to the first lif we happen to find
+	if config.DataLIF == "" {
+	loop:
+		for _, attrs := range r1.Result.AttributesList() {
+			for _, dataProtocol := range attrs.DataProtocols() {
+				if string(dataProtocol) == protocol {
+					log.Debugf("Setting %v protocol access to '%v'", protocol, attrs.Address())
+					config.DataLIF = string(attrs.Address())
+					break loop
+				}
+			}
+		}
+	}
+
+	found := false
+loop2:
+	for _, attrs := range r1.Result.AttributesList() {
+		for _, dataProtocol := range attrs.DataProtocols() {
+			if string(dataProtocol) == protocol {
+				log.Debugf("Comparing %v protocol access on : '%v' vs '%v'", protocol, attrs.Address(), config.DataLIF)
+				if string(attrs.Address()) == config.DataLIF {
+					found = true
+					break loop2
+				}
+			}
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("Could not find %v DataLIF", strings.ToUpper(protocol))
+	}
+	return nil
+}
+
+// MountOntapNFSVolume mounts the named volume, exported at its junction by the data LIF, at mountpoint
+func MountOntapNFSVolume(name, mountpoint, dataLIF string) error {
+	var cmd string
+	switch runtime.GOOS {
+	case utils.Linux:
+		cmd = fmt.Sprintf("mount -o nfsvers=3 %s:/%s %s", dataLIF, name, mountpoint)
+	case utils.Darwin:
+		cmd = fmt.Sprintf("mount -o rw -t nfs %s:/%s %s", dataLIF, name, mountpoint)
+	default:
+		return fmt.Errorf("Unsupported operating system: %v", runtime.GOOS)
+	}
+	log.Debugf("mount cmd==%s", cmd)
+
+	if out, err := exec.Command("sh", "-c", cmd).CombinedOutput(); err != nil {
+		log.Debugf("out==%v", string(out))
+		return fmt.Errorf("Problem mounting volume: %v mountpoint: %v error: %v", name, mountpoint, err)
+	}
+	return nil
+}
+
+// MountOntapCIFSShare mounts the named share, served by the data LIF, at mountpoint with the CIFS credentials
+// from the config.  The password is passed to mount.cifs in its environment so that it is never logged.
+func MountOntapCIFSShare(share, mountpoint string, config OntapStorageDriverConfig) error {
+	if runtime.GOOS != utils.Linux {
+		return fmt.Errorf("Unsupported operating system: %v", runtime.GOOS)
+	}
+
+	options := "username=" + config.CIFSUsername
+	if config.CIFSDomain != "" {
+		options += ",domain=" + config.CIFSDomain
+	}
+	args := []string{"-t", "cifs", fmt.Sprintf("//%s/%s", config.DataLIF, share), mountpoint, "-o", options}
+	log.Debugf("mount cmd==mount %s", strings.Join(args, " "))
+
+	cmd := exec.Command("mount", args...)
+	cmd.Env = append(os.Environ(), "PASSWD="+config.CIFSPassword)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Debugf("out==%v", string(out))
+		return fmt.Errorf("Problem mounting share: %v mountpoint: %v error: %v", share, mountpoint, err)
+	}
+	return nil
+}
+
+// UnmountOntapVolume unmounts the named volume from mountpoint
+func UnmountOntapVolume(name, mountpoint string) error {
+	log.Debugf("cmd==umount %s", mountpoint)
+	if out, err := exec.Command("umount", mountpoint).CombinedOutput(); err != nil {
+		log.Debugf("out==%v", string(out))
+		return fmt.Errorf("Problem unmounting docker volume: %v mountpoint: %v error: %v", name, mountpoint, err)
+	}
+	return nil
 }
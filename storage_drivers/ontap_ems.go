@@ -0,0 +1,204 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package storage_drivers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/netapp/netappdvp/apis/ontap"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// EMS event ids of the messages the ONTAP drivers log
+const (
+	ontapEmsInitialized = 1
+	ontapEmsHeartbeat   = 2
+	ontapEmsCreate      = 3
+	ontapEmsClone       = 4
+	ontapEmsDestroy     = 5
+	ontapEmsAttach      = 6
+	ontapEmsDetach      = 7
+)
+
+// At most ontapEmsEventLimit events are sent in any ontapEmsEventWindow; a burst of operations beyond that is
+// only logged locally, so that it cannot fill the event log of the storage system
+const (
+	ontapEmsEventLimit  = 10
+	ontapEmsEventWindow = time.Minute
+)
+
+// DefaultEmsHeartbeatInterval is how often the volume count is sent when emsHeartbeatInterval is not set
+const DefaultEmsHeartbeatInterval = 24 * time.Hour
+
+// OntapVolumeCounter returns the number of volumes the driver manages, for the heartbeat
+type OntapVolumeCounter func() (int, error)
+
+// OntapFlexvolCounter counts the FlexVols with the storage prefix, for drivers that create a FlexVol per volume
+func OntapFlexvolCounter(api ontap.API, prefix string) OntapVolumeCounter {
+	return func() (int, error) {
+		response, err := api.VolumeGet(prefix + "*")
+		if err != nil {
+			return 0, err
+		}
+		return len(response.Result.AttributesList()), nil
+	}
+}
+
+// OntapEms logs events about the plugin's volumes in the EMS event log of the storage system, so that storage
+// admins can see which Docker host created, cloned, destroyed, attached or detached a volume.
+// View them via filer::> event log show -source netappdvp
+type OntapEms struct {
+	driverName string
+	api        ontap.API
+	prefix     string // storage prefix, removed from volume names to give the Docker volume name
+	count      OntapVolumeCounter
+	hostname   string
+
+	m       sync.Mutex
+	sent    []time.Time // when the events of the current window were sent
+	dropped int         // events not sent since the last one that was
+}
+
+// NewOntapEms returns an OntapEms for the driver, whose heartbeat reports the volume count given by count
+func NewOntapEms(driverName string, api ontap.API, prefix string, count OntapVolumeCounter) *OntapEms {
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Warnf("problem while looking up hostname, error: %v", err)
+		hostname = "unknown"
+	}
+	return &OntapEms{driverName: driverName, api: api, prefix: prefix, count: count, hostname: hostname}
+}
+
+// ParseEmsHeartbeatInterval reads the emsHeartbeatInterval config file setting, a duration such as 12h;
+// empty means DefaultEmsHeartbeatInterval and 0 turns the heartbeat off
+func ParseEmsHeartbeatInterval(interval string) (time.Duration, error) {
+	if interval == "" {
+		return DefaultEmsHeartbeatInterval, nil
+	}
+	if interval == "0" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil || d < ontapEmsEventWindow {
+		return 0, fmt.Errorf("Invalid emsHeartbeatInterval: %v, expected a duration of at least %v such as 12h, or 0",
+			interval, ontapEmsEventWindow)
+	}
+	return d, nil
+}
+
+// allow reports whether an event may be sent at now without exceeding the rate limit
+func (e *OntapEms) allow(now time.Time) bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	recent := e.sent[:0]
+	for _, t := range e.sent {
+		if now.Sub(t) < ontapEmsEventWindow {
+			recent = append(recent, t)
+		}
+	}
+	e.sent = recent
+
+	if len(e.sent) >= ontapEmsEventLimit {
+		e.dropped++
+		return false
+	}
+	e.sent = append(e.sent, now)
+	return true
+}
+
+// event sends an informational EMS message, unless the rate limit has been reached
+func (e *OntapEms) event(category, description string, eventID int) {
+	if e == nil {
+		return
+	}
+	if !e.allow(time.Now()) {
+		log.Debugf("EMS rate limit reached, not sending: %v", description)
+		return
+	}
+
+	e.m.Lock()
+	if e.dropped > 0 {
+		description = fmt.Sprintf("%v (%v earlier events not sent)", description, e.dropped)
+		e.dropped = 0
+	}
+	e.m.Unlock()
+
+	_, err := e.api.EmsAutosupportLog(strconv.Itoa(CurrentDriverVersion), false, category, e.hostname, description,
+		eventID, "netappdvp", 6)
+	if err != nil {
+		log.Warnf("problem while logging ems message, error: %v", err)
+	}
+}
+
+// Initialized logs that this docker volume plugin has been initialized
+func (e *OntapEms) Initialized() {
+	if e == nil {
+		return
+	}
+	e.event("initialized", e.driverName+" docker volume plugin initialized, version "+DriverVersion, ontapEmsInitialized)
+}
+
+// volumeEvent logs an operation on a volume, with the Docker volume name and the host
+func (e *OntapEms) volumeEvent(category, action, name string, eventID int) {
+	if e == nil {
+		return
+	}
+	description := fmt.Sprintf("%v docker volume %v (%v) %v by host %v", e.driverName,
+		strings.TrimPrefix(name, e.prefix), name, action, e.hostname)
+	e.event(category, description, eventID)
+}
+
+// Created logs that the volume was created
+func (e *OntapEms) Created(name string) {
+	e.volumeEvent("create", "created", name, ontapEmsCreate)
+}
+
+// Cloned logs that the volume was created as a clone of source
+func (e *OntapEms) Cloned(name, source string) {
+	e.volumeEvent("clone", "cloned from "+source, name, ontapEmsClone)
+}
+
+// Destroyed logs that the volume was destroyed
+func (e *OntapEms) Destroyed(name string) {
+	e.volumeEvent("destroy", "destroyed", name, ontapEmsDestroy)
+}
+
+// Attached logs that the volume was attached
+func (e *OntapEms) Attached(name string) {
+	e.volumeEvent("attach", "attached", name, ontapEmsAttach)
+}
+
+// Detached logs that the volume was detached
+func (e *OntapEms) Detached(name string) {
+	e.volumeEvent("detach", "detached", name, ontapEmsDetach)
+}
+
+// heartbeat logs the number of volumes the driver manages
+func (e *OntapEms) heartbeat() {
+	count, err := e.count()
+	if err != nil {
+		log.Warnf("problem while counting volumes for ems heartbeat, error: %v", err)
+		return
+	}
+	e.event("heartbeat", fmt.Sprintf("%v docker volume plugin on host %v has %v volumes", e.driverName, e.hostname,
+		count), ontapEmsHeartbeat)
+}
+
+// StartHeartbeat logs the volume count every interval for the life of the plugin; 0 sends no heartbeat
+func (e *OntapEms) StartHeartbeat(interval time.Duration) {
+	if e == nil || interval <= 0 {
+		return
+	}
+	go func() {
+		for range time.Tick(interval) {
+			e.heartbeat()
+		}
+	}()
+}
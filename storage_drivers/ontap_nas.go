@@ -5,12 +5,8 @@ package storage_drivers
 import (
 	"encoding/json"
 	"fmt"
-	"os/exec"
-	"runtime"
-	"strings"
 
 	"github.com/netapp/netappdvp/apis/ontap"
-	"github.com/netapp/netappdvp/azgo"
 	"github.com/netapp/netappdvp/utils"
 
 	log "github.com/Sirupsen/logrus"
@@ -28,9 +24,13 @@ const OntapNASStorageDriverName = "ontap-nas"
 
 // OntapNASStorageDriver is for NFS storage provisioning
 type OntapNASStorageDriver struct {
-	Initialized bool
-	Config      OntapStorageDriverConfig
-	API         *ontap.Driver
+	Initialized  bool
+	Config       OntapStorageDriverConfig
+	API          ontap.API
+	Capabilities ontap.Capabilities // features of the ONTAPI version negotiated by Validate
+
+	nextAggregate int       // position in the aggregate list for the roundRobin placement policy
+	ems           *OntapEms // EMS events about volume operations
 }
 
 // Name is for returning the name of this driver
@@ -71,8 +71,12 @@ func (d *OntapNASStorageDriver) Initialize(configJSON string) error {
 		return fmt.Errorf("Problem validating OntapNASStorageDriver error: %v", validationErr)
 	}
 
-	// log an informational message when this plugin starts
-	EmsInitialized(d.Name(), d.API)
+	// log an informational message when this plugin starts, and the volume count from then on
+	prefix := d.Config.StoragePrefix(d.DefaultStoragePrefix())
+	d.ems = NewOntapEms(d.Name(), d.API, prefix, OntapFlexvolCounter(d.API, prefix))
+	d.ems.Initialized()
+	heartbeatInterval, _ := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval) // checked by Validate
+	d.ems.StartHeartbeat(heartbeatInterval)
 
 	d.Initialized = true
 	log.Infof("Successfully initialized Ontap NAS Docker driver version %v", DriverVersion)
@@ -83,60 +87,36 @@ func (d *OntapNASStorageDriver) Initialize(configJSON string) error {
 func (d *OntapNASStorageDriver) Validate() error {
 	log.Debugf("OntapNASStorageDriver#Validate()")
 
-	zr := &azgo.ZapiRunner{
-		ManagementLIF: d.Config.ManagementLIF,
-		SVM:           d.Config.SVM,
-		Username:      d.Config.Username,
-		Password:      d.Config.Password,
-		Secure:        true,
+	if err := ValidateCloneDestroyPolicy(d.Config.CloneDestroyPolicy); err != nil {
+		return err
+	}
+	if _, err := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval); err != nil {
+		return err
 	}
 
-	r0, err0 := azgo.NewSystemGetVersionRequest().ExecuteUsing(zr)
+	r0, err0 := d.API.SystemGetVersion()
 	if err0 != nil {
 		return fmt.Errorf("Could not validate credentials for %v@%v, error: %v", d.Config.Username, d.Config.SVM, err0)
 	}
 
-	// Add system version validation, if needed, this is a sanity check right now
 	systemVersion := r0.Result
 	if systemVersion.VersionPtr == nil {
 		return fmt.Errorf("Could not determine system version for %v@%v", d.Config.Username, d.Config.SVM)
 	}
 
-	r1, err1 := d.API.NetInterfaceGet()
-	if err1 != nil {
-		return fmt.Errorf("Problem checking network interfaces error: %v", err1)
-	}
-
-	// if they didn't set a lif to use in the config, we'll set it to the first nfs lif we happen to find
-	if d.Config.DataLIF == "" {
-	loop:
-		for _, attrs := range r1.Result.AttributesList() {
-			for _, protocol := range attrs.DataProtocols() {
-				if protocol == "nfs" {
-					log.Debugf("Setting NFS protocol access to '%v'", attrs.Address())
-					d.Config.DataLIF = string(attrs.Address())
-					break loop
-				}
-			}
-		}
+	// requests use the newest ONTAPI version both sides speak, which decides the features we can offer
+	capabilities, err := NegotiateOntapCapabilities(d.Config, d.API, systemVersion.Version())
+	if err != nil {
+		return err
 	}
+	d.Capabilities = capabilities
 
-	foundNfs := false
-loop2:
-	for _, attrs := range r1.Result.AttributesList() {
-		for _, protocol := range attrs.DataProtocols() {
-			if protocol == "nfs" {
-				log.Debugf("Comparing NFS protocol access on : '%v' vs '%v'", attrs.Address(), d.Config.DataLIF)
-				if string(attrs.Address()) == d.Config.DataLIF {
-					foundNfs = true
-					break loop2
-				}
-			}
-		}
+	if err := ValidateOntapDataLIF(&d.Config, d.API, "nfs"); err != nil {
+		return err
 	}
 
-	if !foundNfs {
-		return fmt.Errorf("Could not find NFS DataLIF")
+	if err := ValidateOntapAggregates(d.Config, d.API); err != nil {
+		return err
 	}
 
 	return nil
@@ -146,8 +126,10 @@ loop2:
 func (d *OntapNASStorageDriver) Create(name string, opts map[string]string) error {
 	log.Debugf("OntapNASStorageDriver#Create(%v)", name)
 
-	response, _ := d.API.VolumeSize(name)
-	if isPassed(response.Result.ResultStatusAttr) {
+	if _, err := d.API.VolumeSize(name); err == nil {
+		if IsOntapVolumeDeletePending(name, d.API) {
+			return fmt.Errorf("Volume %v has been removed and is pending deletion until its clones are destroyed", name)
+		}
 		log.Debugf("%v already exists, skipping volume create...", name)
 		return nil
 	}
@@ -160,7 +142,41 @@ func (d *OntapNASStorageDriver) Create(name string, opts map[string]string) erro
 	unixPermissions := utils.GetV(opts, "unixPermissions", "---rwxr-xr-x")
 	snapshotDir := utils.GetV(opts, "snapshotDir", "true")
 	exportPolicy := utils.GetV(opts, "exportPolicy", "default")
-	aggregate := utils.GetV(opts, "aggregate", d.Config.Aggregate)
+	aggregate := utils.GetV(opts, "aggregate", "")
+	if aggregate == "" {
+		var err error
+		if aggregate, err = SelectOntapAggregate(d.Config, d.API, &d.nextAggregate); err != nil {
+			return fmt.Errorf("Problem selecting aggregate for volume %v: %v", name, err)
+		}
+	}
+
+	if err := ValidateOntapQosOpts(opts, d.API); err != nil {
+		return err
+	}
+
+	props, err := GetOntapVolumeProperties(opts, d.Config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return err
+	}
+	if err := ValidateOntapVolumeCapabilities(props, d.Capabilities); err != nil {
+		return err
+	}
+
+	// remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	effectiveOpts["size"] = volumeSize
+	effectiveOpts["spaceReserve"] = spaceReserve
+	effectiveOpts["snapshotPolicy"] = snapshotPolicy
+	effectiveOpts["unixPermissions"] = unixPermissions
+	effectiveOpts["snapshotDir"] = snapshotDir
+	effectiveOpts["exportPolicy"] = exportPolicy
+	effectiveOpts["aggregate"] = aggregate
+	props.AddTo(effectiveOpts)
+
+	comment, err := EncodeOntapVolumeOpts(name, effectiveOpts)
+	if err != nil {
+		return err
+	}
 
 	log.WithFields(log.Fields{
 		"name":            name,
@@ -170,65 +186,106 @@ func (d *OntapNASStorageDriver) Create(name string, opts map[string]string) erro
 		"unixPermissions": unixPermissions,
 		"exportPolicy":    exportPolicy,
 		"aggregate":       aggregate,
+		"properties":      props,
 	}).Debug("Creating volume with values")
 
 	// create the volume
-	response1, error1 := d.API.VolumeCreate(name, aggregate, volumeSize, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy)
-	if !isPassed(response1.Result.ResultStatusAttr) || error1 != nil {
-		if response1.Result.ResultErrnoAttr != azgo.EAPIERROR {
+	response1, error1 := d.API.VolumeCreate(name, aggregate, volumeSize, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy,
+		props.SecurityStyle, props.TieringPolicy, props.SnapshotReserve, props.Encryption)
+	if error1 != nil {
+		if !ontap.IsJobExists(error1) {
 			return fmt.Errorf("Error creating volume\n%verror: %v", response1.Result, error1)
-		} else {
-			if !strings.HasSuffix(strings.TrimSpace(response1.Result.ResultReasonAttr), "Job exists") {
-				return fmt.Errorf("Error creating volume\n%verror: %v", response1.Result, error1)
-			} else {
-				log.Warnf("%v volume create job already exists, skipping volume create on this node...", name)
-				return nil
-			}
 		}
+		// another host is creating the same volume; don't report success until its job is done
+		log.Warnf("%v volume create job already exists, waiting for it to complete...", name)
+		return WaitForOntapVolumeJobs(name, d.API)
 	}
 
+	// from here on, a failure destroys the volume, so that a retried create doesn't find it half set up
+	complete := false
+	defer func() {
+		if !complete {
+			DestroyFailedOntapVolume(name, d.API)
+		}
+	}()
+
 	// disable '.snapshot' to allow official mysql container's chmod-in-init to work
 	if snapshotDir != "true" {
 		response2, error2 := d.API.VolumeDisableSnapshotDirectoryAccess(name)
-		if !isPassed(response2.Result.ResultStatusAttr) || error2 != nil {
+		if error2 != nil {
 			return fmt.Errorf("Error disabling snapshot directory access\n%verror: %v", response2.Result, error2)
 		}
 	}
 
 	// mount the volume at the specified junction
 	response3, error3 := d.API.VolumeMount(name, "/"+name)
-	if !isPassed(response3.Result.ResultStatusAttr) || error3 != nil {
+	if error3 != nil {
 		return fmt.Errorf("Error mounting volume to junction\n%verror: %v", response3.Result, error3)
 	}
 
+	// set autosize and storage efficiency, which volume-create does not take
+	if err := ApplyOntapVolumeProperties(name, props, d.API); err != nil {
+		return err
+	}
+
+	// apply the requested QoS policy group, creating one for maxIops/maxThroughput
+	if err := ApplyOntapQosPolicy(name, opts, d.API); err != nil {
+		return err
+	}
+
+	// the comment comes last, as other hosts creating the same volume wait for it
+	if err := SetOntapVolumeComment(name, comment, d.API); err != nil {
+		return err
+	}
+
+	complete = true
+	d.ems.Created(name)
 	return nil
 }
 
 // Create a volume clone
 func (d *OntapNASStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
-	return CreateOntapClone(name, source, snapshot, newSnapshotPrefix, d.API)
+	if err := CreateOntapClone(name, source, snapshot, newSnapshotPrefix, d.API); err != nil {
+		return err
+	}
+
+	d.ems.Cloned(name, source)
+	return nil
 }
 
 // Destroy the volume
 func (d *OntapNASStorageDriver) Destroy(name string) error {
 	log.Debugf("OntapNASStorageDriver#Destroy(%v)", name)
 
-	// TODO: If this is the parent of one or more clones, those clones have to split from this
-	// volume before it can be deleted, which means separate copies of those volumes.
-	// If there are a lot of clones on this volume, that could seriously balloon the amount of
-	// utilized space. Is that what we want? Or should we just deny the delete, and force the
-	// user to keep the volume around until all of the clones are gone? If we do that, need a
-	// way to list the clones. Maybe volume inspect.
+	// If this is the parent of one or more clones, the configured policy decides whether to
+	// refuse, split the clones off (a full copy of each), or wait until the clones are gone
+	destroyNow, err := ApplyOntapCloneDestroyPolicy(name, d.Config.CloneDestroyPolicy, d.API)
+	if err != nil {
+		return err
+	}
+	if !destroyNow {
+		return nil
+	}
+
+	// remember the parent, which may be waiting on this clone to be destroyed
+	parent, _, err := GetOntapCloneParent(name, d.API)
+	if err != nil {
+		log.Warnf("Could not determine the parent of volume %v: %v", name, err)
+	}
 
 	response, error := d.API.VolumeDestroy(name, true)
-	if !isPassed(response.Result.ResultStatusAttr) || error != nil {
-		if response.Result.ResultErrnoAttr != azgo.EVOLUMEDOESNOTEXIST {
+	if error != nil {
+		if !ontap.IsNotFound(error) {
 			return fmt.Errorf("Error destroying volume: %v\n%verror: %v", name, response.Result, error)
 		} else {
 			log.Warnf("Volume already deleted while destroying volume: %v\n%verror: %v", name, response.Result, error)
 		}
 
 	}
+
+	DeleteOntapAutoQosPolicy(name, d.API)
+	DestroyDeferredOntapParent(parent, d.API)
+	d.ems.Destroyed(name)
 	return nil
 }
 
@@ -236,24 +293,11 @@ func (d *OntapNASStorageDriver) Destroy(name string) error {
 func (d *OntapNASStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
 	log.Debugf("OntapNASStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
 
-	ip := d.Config.DataLIF
-
-	var cmd string
-	switch runtime.GOOS {
-	case utils.Linux:
-		cmd = fmt.Sprintf("mount -o nfsvers=3 %s:/%s %s", ip, name, mountpoint)
-	case utils.Darwin:
-		cmd = fmt.Sprintf("mount -o rw -t nfs %s:/%s %s", ip, name, mountpoint)
-	default:
-		return fmt.Errorf("Unsupported operating system: %v", runtime.GOOS)
-	}
-	log.Debugf("mount cmd==%s", cmd)
-
-	if out, err := exec.Command("sh", "-c", cmd).CombinedOutput(); err != nil {
-		log.Debugf("out==%v", string(out))
-		return fmt.Errorf("Problem mounting volume: %v mountpoint: %v error: %v", name, mountpoint, err)
+	if err := MountOntapNFSVolume(name, mountpoint, d.Config.DataLIF); err != nil {
+		return err
 	}
 
+	d.ems.Attached(name)
 	return nil
 }
 
@@ -261,13 +305,11 @@ func (d *OntapNASStorageDriver) Attach(name, mountpoint string, opts map[string]
 func (d *OntapNASStorageDriver) Detach(name, mountpoint string) error {
 	log.Debugf("OntapNASStorageDriver#Detach(%v, %v)", name, mountpoint)
 
-	cmd := fmt.Sprintf("umount %s", mountpoint)
-	log.Debugf("cmd==%s", cmd)
-	if out, err := exec.Command("sh", "-c", cmd).CombinedOutput(); err != nil {
-		log.Debugf("out==%v", string(out))
-		return fmt.Errorf("Problem unmounting docker volume: %v mountpoint: %v error: %v", name, mountpoint, err)
+	if err := UnmountOntapVolume(name, mountpoint); err != nil {
+		return err
 	}
 
+	d.ems.Detached(name)
 	return nil
 }
 
@@ -285,3 +327,13 @@ func (d *OntapNASStorageDriver) DefaultSnapshotPrefix() string {
 func (d *OntapNASStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
 	return GetSnapshotList(name, d.API)
 }
+
+// Return the options the named volume was created with
+func (d *OntapNASStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	return GetOntapVolumeOpts(name, d.API)
+}
+
+// Return the clone parent and children of the named volume
+func (d *OntapNASStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	return GetOntapVolumeStatus(name, d.API, d.Capabilities)
+}
@@ -0,0 +1,496 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package storage_drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/netapp/netappdvp/apis/ontap"
+	"github.com/netapp/netappdvp/azgo"
+	"github.com/netapp/netappdvp/utils"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+func init() {
+	flexgroup := &OntapNASFlexGroupStorageDriver{}
+	flexgroup.Initialized = false
+	Drivers[flexgroup.Name()] = flexgroup
+	log.Debugf("Registered driver '%v'", flexgroup.Name())
+}
+
+// OntapNASFlexGroupStorageDriverName is the constant name for this Ontap NAS FlexGroup storage driver
+const OntapNASFlexGroupStorageDriverName = "ontap-nas-flexgroup"
+
+// OntapNASFlexGroupStorageDriver is for NFS storage provisioning of FlexGroup volumes, which span several
+// aggregates and so can grow beyond what a single FlexVol can hold
+type OntapNASFlexGroupStorageDriver struct {
+	Initialized  bool
+	Config       OntapStorageDriverConfig
+	API          ontap.API          // FlexGroup operations are sent with the asynchronous calls, see ontapFlexGroupAPI
+	Capabilities ontap.Capabilities // features of the ONTAPI version negotiated by Validate
+
+	ems *OntapEms // EMS events about volume operations
+}
+
+// ontapFlexGroupAPI sends the volume operations that ONTAP only accepts for FlexGroup volumes in their
+// asynchronous form, such as volume-destroy-async for volume-destroy, and waits for their jobs, so that the
+// common ONTAP driver functions can be used for FlexGroup volumes as they are
+type ontapFlexGroupAPI struct {
+	ontap.API
+}
+
+// waitForModifyJobs waits for the jobs started by a volume-modify-iter-async call
+func (a ontapFlexGroupAPI) waitForModifyJobs(name string, response azgo.VolumeModifyIterAsyncResponse) error {
+	for _, failure := range response.Result.FailureList() {
+		if failure.ErrorMessagePtr != nil {
+			return fmt.Errorf("Error modifying volume %v: %v", name, failure.ErrorMessage())
+		}
+		return fmt.Errorf("Error modifying volume %v", name)
+	}
+	for _, success := range response.Result.SuccessList() {
+		err := ontap.WaitForAsyncJob(a.API, success.StatusPtr, success.JobidPtr, success.ErrorMessagePtr,
+			ontap.DefaultJobTimeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modifyResponse reports the outcome of a volume-modify-iter-async call as that of volume-modify-iter
+func (a ontapFlexGroupAPI) modifyResponse(name string, response azgo.VolumeModifyIterAsyncResponse,
+	err error) (azgo.VolumeModifyIterResponse, error) {
+	if err == nil {
+		err = a.waitForModifyJobs(name, response)
+	}
+	result := azgo.VolumeModifyIterResponse{}
+	if err == nil {
+		result.Result.ResultStatusAttr = "passed"
+	}
+	return result, err
+}
+
+// VolumeDisableSnapshotDirectoryAccess disables access to the ".snapshot" directory
+func (a ontapFlexGroupAPI) VolumeDisableSnapshotDirectoryAccess(name string) (azgo.VolumeModifyIterResponse, error) {
+	response, err := a.API.VolumeDisableSnapshotDirectoryAccessAsync(name)
+	return a.modifyResponse(name, response, err)
+}
+
+// VolumeSetQosPolicyGroupName assigns the specified volume to a QoS policy group
+func (a ontapFlexGroupAPI) VolumeSetQosPolicyGroupName(name, policyGroup string) (azgo.VolumeModifyIterResponse, error) {
+	response, err := a.API.VolumeSetQosPolicyGroupNameAsync(name, policyGroup)
+	return a.modifyResponse(name, response, err)
+}
+
+// VolumeSetComment sets the comment on the specified volume
+func (a ontapFlexGroupAPI) VolumeSetComment(name, comment string) (azgo.VolumeModifyIterResponse, error) {
+	response, err := a.API.VolumeSetCommentAsync(name, comment)
+	return a.modifyResponse(name, response, err)
+}
+
+// VolumeCloneCreate clones a volume from a snapshot and waits for the clone to be created
+func (a ontapFlexGroupAPI) VolumeCloneCreate(name, source, snapshot string) (azgo.VolumeCloneCreateResponse, error) {
+	result := azgo.VolumeCloneCreateResponse{}
+	response, err := a.API.VolumeCloneCreateAsync(name, source, snapshot)
+	if err == nil {
+		err = ontap.WaitForAsyncJob(a.API, response.Result.ResultStatusPtr, response.Result.ResultJobidPtr,
+			response.Result.ResultErrorMessagePtr, ontap.DefaultJobTimeout)
+	}
+	if err == nil {
+		result.Result.ResultStatusAttr = "passed"
+	}
+	return result, err
+}
+
+// VolumeDestroy destroys a volume and waits for it to be gone
+func (a ontapFlexGroupAPI) VolumeDestroy(name string, force bool) (azgo.VolumeDestroyResponse, error) {
+	result := azgo.VolumeDestroyResponse{}
+	response, err := a.API.VolumeDestroyAsync(name, force)
+	if err == nil {
+		err = ontap.WaitForAsyncJob(a.API, response.Result.ResultStatusPtr, response.Result.ResultJobidPtr,
+			response.Result.ResultErrorMessagePtr, ontap.DefaultJobTimeout)
+	}
+	if err == nil {
+		result.Result.ResultStatusAttr = "passed"
+	}
+	return result, err
+}
+
+// VolumeSize checks that the volume exists, failing with EVOLUMEDOESNOTEXIST if it does not; volume-size does
+// not take FlexGroup volumes, so the size is not reported
+func (a ontapFlexGroupAPI) VolumeSize(name string) (azgo.VolumeSizeResponse, error) {
+	result := azgo.VolumeSizeResponse{}
+	response, err := a.API.VolumeGet(name)
+	if err != nil {
+		return result, err
+	}
+	if response.Result.NumRecords() == 0 {
+		return result, &ontap.APIError{API: "volume-get-iter", Status: "failed", Errno: azgo.EVOLUMEDOESNOTEXIST,
+			Reason: fmt.Sprintf("volume %v does not exist", name)}
+	}
+	result.Result.ResultStatusAttr = "passed"
+	return result, nil
+}
+
+// ValidateOntapFlexGroupProperties rejects the volume properties ONTAP cannot apply to FlexGroup volumes
+func ValidateOntapFlexGroupProperties(props OntapVolumeProperties) error {
+	switch {
+	case props.Deduplication:
+		return fmt.Errorf("deduplication is not supported for FlexGroup volumes")
+	case props.Compression != "none":
+		return fmt.Errorf("compression is not supported for FlexGroup volumes")
+	case props.AutosizeMode != "":
+		return fmt.Errorf("autosizeMode is not supported for FlexGroup volumes")
+	}
+	return nil
+}
+
+// SelectOntapFlexGroupAggregates returns the aggregates for a new FlexGroup volume: those of the aggregate
+// option, a comma separated list, or else the configured aggregates, or else all those assigned to the SVM
+func SelectOntapFlexGroupAggregates(config OntapStorageDriverConfig, api ontap.API, aggregate string) ([]string, error) {
+	aggregates := make([]string, 0)
+	for _, aggr := range strings.Split(aggregate, ",") {
+		if aggr = strings.TrimSpace(aggr); aggr != "" {
+			aggregates = append(aggregates, aggr)
+		}
+	}
+	if len(aggregates) == 0 {
+		aggregates = OntapAggregates(config)
+	}
+	if len(aggregates) > 0 {
+		return aggregates, nil
+	}
+
+	assigned, _, err := GetOntapSVMAggregates(api)
+	if err != nil {
+		return nil, err
+	}
+	if len(assigned) == 0 {
+		return nil, fmt.Errorf("No aggregate configured and none assigned to SVM %v", config.SVM)
+	}
+	return assigned, nil
+}
+
+// Name is for returning the name of this driver
+func (d *OntapNASFlexGroupStorageDriver) Name() string {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Name()")
+	return OntapNASFlexGroupStorageDriverName
+}
+
+// Initialize from the provided config
+func (d *OntapNASFlexGroupStorageDriver) Initialize(configJSON string) error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Initialize(...)")
+
+	config := &OntapStorageDriverConfig{}
+
+	// decode configJSON into OntapStorageDriverConfig object
+	err := json.Unmarshal([]byte(configJSON), &config)
+	if err != nil {
+		return fmt.Errorf("Cannot decode json configuration error: %v", err)
+	}
+
+	log.WithFields(log.Fields{
+		"Version":           config.Version,
+		"StorageDriverName": config.StorageDriverName,
+		"Debug":             config.Debug,
+		"DisableDelete":     config.DisableDelete,
+		"StoragePrefixRaw":  string(config.StoragePrefixRaw),
+		"SnapshotPrefixRaw": string(config.SnapshotPrefixRaw),
+	}).Debugf("Reparsed into ontapConfig")
+
+	d.Config = *config
+	api, err := InitializeOntapDriver(d.Config)
+	if err != nil {
+		return fmt.Errorf("Problem while initializing, error: %v", err)
+	}
+	d.API = ontapFlexGroupAPI{api}
+
+	validationErr := d.Validate()
+	if validationErr != nil {
+		return fmt.Errorf("Problem validating OntapNASFlexGroupStorageDriver error: %v", validationErr)
+	}
+
+	// log an informational message when this plugin starts, and the volume count from then on
+	prefix := d.Config.StoragePrefix(d.DefaultStoragePrefix())
+	d.ems = NewOntapEms(d.Name(), d.API, prefix, OntapFlexvolCounter(d.API, prefix))
+	d.ems.Initialized()
+	heartbeatInterval, _ := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval) // checked by Validate
+	d.ems.StartHeartbeat(heartbeatInterval)
+
+	d.Initialized = true
+	log.Infof("Successfully initialized Ontap NAS FlexGroup Docker driver version %v", DriverVersion)
+	return nil
+}
+
+// Validate the driver configuration and execution environment
+func (d *OntapNASFlexGroupStorageDriver) Validate() error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Validate()")
+
+	if err := ValidateCloneDestroyPolicy(d.Config.CloneDestroyPolicy); err != nil {
+		return err
+	}
+	if _, err := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval); err != nil {
+		return err
+	}
+
+	r0, err0 := d.API.SystemGetVersion()
+	if err0 != nil {
+		return fmt.Errorf("Could not validate credentials for %v@%v, error: %v", d.Config.Username, d.Config.SVM, err0)
+	}
+
+	systemVersion := r0.Result
+	if systemVersion.VersionPtr == nil {
+		return fmt.Errorf("Could not determine system version for %v@%v", d.Config.Username, d.Config.SVM)
+	}
+
+	// requests use the newest ONTAPI version both sides speak, which decides the features we can offer
+	capabilities, err := NegotiateOntapCapabilities(d.Config, d.API, systemVersion.Version())
+	if err != nil {
+		return err
+	}
+	d.Capabilities = capabilities
+	if err := d.Capabilities.Require(ontap.FeatureFlexGroup, OntapNASFlexGroupStorageDriverName); err != nil {
+		return err
+	}
+
+	// the config file defaults apply to every volume, so reject those FlexGroups cannot have now
+	defaults, err := GetOntapVolumeProperties(map[string]string{}, d.Config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return err
+	}
+	if err := ValidateOntapFlexGroupProperties(defaults); err != nil {
+		return err
+	}
+
+	if err := ValidateOntapDataLIF(&d.Config, d.API, "nfs"); err != nil {
+		return err
+	}
+
+	if err := ValidateOntapAggregates(d.Config, d.API); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create a volume with the specified options
+func (d *OntapNASFlexGroupStorageDriver) Create(name string, opts map[string]string) error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Create(%v)", name)
+
+	if _, err := d.API.VolumeSize(name); err == nil {
+		if IsOntapVolumeDeletePending(name, d.API) {
+			return fmt.Errorf("Volume %v has been removed and is pending deletion until its clones are destroyed", name)
+		}
+		log.Debugf("%v already exists, skipping volume create...", name)
+		return nil
+	}
+
+	// get options with default values if not specified in config file
+	volumeSize := utils.GetV(opts, "size", "1g")
+	spaceReserve := utils.GetV(opts, "spaceReserve", "none")
+	snapshotPolicy := utils.GetV(opts, "snapshotPolicy", "none")
+	unixPermissions := utils.GetV(opts, "unixPermissions", "---rwxr-xr-x")
+	snapshotDir := utils.GetV(opts, "snapshotDir", "true")
+	exportPolicy := utils.GetV(opts, "exportPolicy", "default")
+	aggregates, err := SelectOntapFlexGroupAggregates(d.Config, d.API, utils.GetV(opts, "aggregate", ""))
+	if err != nil {
+		return fmt.Errorf("Problem selecting aggregates for volume %v: %v", name, err)
+	}
+
+	if err := ValidateOntapQosOpts(opts, d.API); err != nil {
+		return err
+	}
+
+	props, err := GetOntapVolumeProperties(opts, d.Config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return err
+	}
+	if err := ValidateOntapFlexGroupProperties(props); err != nil {
+		return err
+	}
+	if err := ValidateOntapVolumeCapabilities(props, d.Capabilities); err != nil {
+		return err
+	}
+
+	// remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	effectiveOpts["size"] = volumeSize
+	effectiveOpts["spaceReserve"] = spaceReserve
+	effectiveOpts["snapshotPolicy"] = snapshotPolicy
+	effectiveOpts["unixPermissions"] = unixPermissions
+	effectiveOpts["snapshotDir"] = snapshotDir
+	effectiveOpts["exportPolicy"] = exportPolicy
+	effectiveOpts["aggregate"] = strings.Join(aggregates, ",")
+	props.AddTo(effectiveOpts)
+
+	comment, err := EncodeOntapVolumeOpts(name, effectiveOpts)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"name":            name,
+		"volumeSize":      volumeSize,
+		"spaceReserve":    spaceReserve,
+		"snapshotPolicy":  snapshotPolicy,
+		"unixPermissions": unixPermissions,
+		"exportPolicy":    exportPolicy,
+		"aggregates":      aggregates,
+		"properties":      props,
+	}).Debug("Creating FlexGroup volume with values")
+
+	// create the volume, which ONTAP does in a job spread over the aggregates
+	response1, error1 := d.API.VolumeCreateAsync(name, aggregates, volumeSize, spaceReserve, snapshotPolicy,
+		unixPermissions, exportPolicy, props.SecurityStyle, props.TieringPolicy, props.SnapshotReserve, props.Encryption)
+	if error1 != nil {
+		if !ontap.IsJobExists(error1) {
+			return fmt.Errorf("Error creating volume\n%verror: %v", response1.Result, error1)
+		}
+		// another host is creating the same volume; don't report success until its job is done
+		log.Warnf("%v volume create job already exists, waiting for it to complete...", name)
+		return WaitForOntapVolumeJobs(name, d.API)
+	}
+
+	// from here on, a failure destroys the volume, so that a retried create doesn't find it half set up
+	complete := false
+	defer func() {
+		if !complete {
+			DestroyFailedOntapVolume(name, d.API)
+		}
+	}()
+
+	err = ontap.WaitForAsyncJob(d.API, response1.Result.ResultStatusPtr, response1.Result.ResultJobidPtr,
+		response1.Result.ResultErrorMessagePtr, ontap.DefaultJobTimeout)
+	if err != nil {
+		return fmt.Errorf("Error creating volume %v: %v", name, err)
+	}
+
+	// disable '.snapshot' to allow official mysql container's chmod-in-init to work
+	if snapshotDir != "true" {
+		response2, error2 := d.API.VolumeDisableSnapshotDirectoryAccess(name)
+		if error2 != nil {
+			return fmt.Errorf("Error disabling snapshot directory access\n%verror: %v", response2.Result, error2)
+		}
+	}
+
+	// mount the volume at the specified junction
+	response3, error3 := d.API.VolumeMount(name, "/"+name)
+	if error3 != nil {
+		return fmt.Errorf("Error mounting volume to junction\n%verror: %v", response3.Result, error3)
+	}
+
+	// apply the requested QoS policy group, creating one for maxIops/maxThroughput
+	if err := ApplyOntapQosPolicy(name, opts, d.API); err != nil {
+		return err
+	}
+
+	// the comment comes last, as other hosts creating the same volume wait for it
+	if err := SetOntapVolumeComment(name, comment, d.API); err != nil {
+		return err
+	}
+
+	complete = true
+	d.ems.Created(name)
+	return nil
+}
+
+// Create a volume clone
+func (d *OntapNASFlexGroupStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#CreateClone(%v, %v, %v)", name, source, snapshot)
+
+	if err := d.Capabilities.Require(ontap.FeatureFlexGroupClone, "from"); err != nil {
+		return err
+	}
+	if err := CreateOntapClone(name, source, snapshot, newSnapshotPrefix, d.API); err != nil {
+		return err
+	}
+
+	d.ems.Cloned(name, source)
+	return nil
+}
+
+// Destroy the volume
+func (d *OntapNASFlexGroupStorageDriver) Destroy(name string) error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Destroy(%v)", name)
+
+	// If this is the parent of one or more clones, the configured policy decides whether to
+	// refuse, split the clones off (a full copy of each), or wait until the clones are gone
+	destroyNow, err := ApplyOntapCloneDestroyPolicy(name, d.Config.CloneDestroyPolicy, d.API)
+	if err != nil {
+		return err
+	}
+	if !destroyNow {
+		return nil
+	}
+
+	// remember the parent, which may be waiting on this clone to be destroyed
+	parent, _, err := GetOntapCloneParent(name, d.API)
+	if err != nil {
+		log.Warnf("Could not determine the parent of volume %v: %v", name, err)
+	}
+
+	response, error := d.API.VolumeDestroy(name, true)
+	if error != nil {
+		if !ontap.IsNotFound(error) {
+			return fmt.Errorf("Error destroying volume: %v\n%verror: %v", name, response.Result, error)
+		}
+		log.Warnf("Volume already deleted while destroying volume: %v\n%verror: %v", name, response.Result, error)
+	}
+
+	DeleteOntapAutoQosPolicy(name, d.API)
+	DestroyDeferredOntapParent(parent, d.API)
+	d.ems.Destroyed(name)
+	return nil
+}
+
+// Attach the volume
+func (d *OntapNASFlexGroupStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
+
+	if err := MountOntapNFSVolume(name, mountpoint, d.Config.DataLIF); err != nil {
+		return err
+	}
+
+	d.ems.Attached(name)
+	return nil
+}
+
+// Detach the volume
+func (d *OntapNASFlexGroupStorageDriver) Detach(name, mountpoint string) error {
+	log.Debugf("OntapNASFlexGroupStorageDriver#Detach(%v, %v)", name, mountpoint)
+
+	if err := UnmountOntapVolume(name, mountpoint); err != nil {
+		return err
+	}
+
+	d.ems.Detached(name)
+	return nil
+}
+
+// DefaultStoragePrefix is the driver specific prefix for created storage, can be overridden in the config file
+func (d *OntapNASFlexGroupStorageDriver) DefaultStoragePrefix() string {
+	return "netappdvp_"
+}
+
+// DefaultSnapshotPrefix is the driver specific prefix for created snapshots, can be overridden in the config file
+func (d *OntapNASFlexGroupStorageDriver) DefaultSnapshotPrefix() string {
+	return "netappdvp_"
+}
+
+// Return the list of snapshots associated with the named volume
+func (d *OntapNASFlexGroupStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
+	return GetSnapshotList(name, d.API)
+}
+
+// GetVolumeOpts returns the options the volume was created with
+func (d *OntapNASFlexGroupStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	return GetOntapVolumeOpts(name, d.API)
+}
+
+// GetVolumeStatus returns the clone lineage of the volume and the ONTAPI version in use
+func (d *OntapNASFlexGroupStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	return GetOntapVolumeStatus(name, d.API, d.Capabilities)
+}
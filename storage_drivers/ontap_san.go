@@ -32,9 +32,13 @@ func lunName(name string) string {
 
 // OntapSANStorageDriver is for iSCSI storage provisioning
 type OntapSANStorageDriver struct {
-	Initialized bool
-	Config      OntapStorageDriverConfig
-	API         *ontap.Driver
+	Initialized  bool
+	Config       OntapStorageDriverConfig
+	API          ontap.API
+	Capabilities ontap.Capabilities // features of the ONTAPI version negotiated by Validate
+
+	nextAggregate int       // position in the aggregate list for the roundRobin placement policy
+	ems           *OntapEms // EMS events about volume operations
 }
 
 // Name is for returning the name of this driver
@@ -75,8 +79,12 @@ func (d *OntapSANStorageDriver) Initialize(configJSON string) error {
 		return fmt.Errorf("Problem validating OntapSANStorageDriver error: %v", validationErr)
 	}
 
-	// log an informational message when this plugin starts
-	EmsInitialized(d.Name(), d.API)
+	// log an informational message when this plugin starts, and the volume count from then on
+	prefix := d.Config.StoragePrefix(d.DefaultStoragePrefix())
+	d.ems = NewOntapEms(d.Name(), d.API, prefix, OntapFlexvolCounter(d.API, prefix))
+	d.ems.Initialized()
+	heartbeatInterval, _ := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval) // checked by Validate
+	d.ems.StartHeartbeat(heartbeatInterval)
 
 	d.Initialized = true
 	log.Infof("Successfully initialized Ontap SAN Docker driver version %v", DriverVersion)
@@ -87,78 +95,36 @@ func (d *OntapSANStorageDriver) Initialize(configJSON string) error {
 func (d *OntapSANStorageDriver) Validate() error {
 	log.Debugf("OntapSANStorageDriver#Validate()")
 
-	zr := &azgo.ZapiRunner{
-		ManagementLIF: d.Config.ManagementLIF,
-		SVM:           d.Config.SVM,
-		Username:      d.Config.Username,
-		Password:      d.Config.Password,
-		Secure:        true,
+	if err := ValidateCloneDestroyPolicy(d.Config.CloneDestroyPolicy); err != nil {
+		return err
+	}
+	if _, err := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval); err != nil {
+		return err
 	}
 
-	r0, err0 := azgo.NewSystemGetVersionRequest().ExecuteUsing(zr)
+	r0, err0 := d.API.SystemGetVersion()
 	if err0 != nil {
 		return fmt.Errorf("Could not validate credentials for %v@%v, error: %v", d.Config.Username, d.Config.SVM, err0)
 	}
 
-	// Add system version validation, if needed, this is a sanity check right now
 	systemVersion := r0.Result
 	if systemVersion.VersionPtr == nil {
 		return fmt.Errorf("Could not determine system version for %v@%v", d.Config.Username, d.Config.SVM)
 	}
 
-	r1, err1 := d.API.NetInterfaceGet()
-	if err1 != nil {
-		return fmt.Errorf("Problem checking network interfaces; error: %v", err1)
-	}
-
-	// if they didn't set a lif to use in the config, we'll set it to the first iscsi lif we happen to find
-	if d.Config.DataLIF == "" {
-		for _, attrs := range r1.Result.AttributesList() {
-			for _, protocol := range attrs.DataProtocols() {
-				if protocol == "iscsi" {
-					log.Debugf("Setting iSCSI protocol access to '%v'", attrs.Address())
-					d.Config.DataLIF = string(attrs.Address())
-				}
-			}
-		}
-	}
-
-	// now, we validate our settings
-	foundIscsi := false
-	iscsiLifCount := 0
-	for _, attrs := range r1.Result.AttributesList() {
-		for _, protocol := range attrs.DataProtocols() {
-			if protocol == "iscsi" {
-				log.Debugf("Comparing iSCSI protocol access on: '%v' vs: '%v'", attrs.Address(), d.Config.DataLIF)
-				if string(attrs.Address()) == d.Config.DataLIF {
-					foundIscsi = true
-					iscsiLifCount++
-				}
-			}
-		}
-	}
-
-	if iscsiLifCount > 1 {
-		log.Debugf("Found multiple iSCSI lifs")
-	}
-
-	if !foundIscsi {
-		return fmt.Errorf("Could not find iSCSI DataLIF")
+	// requests use the newest ONTAPI version both sides speak, which decides the features we can offer
+	capabilities, err := NegotiateOntapCapabilities(d.Config, d.API, systemVersion.Version())
+	if err != nil {
+		return err
 	}
+	d.Capabilities = capabilities
 
-	isIscsiSupported := utils.IscsiSupported()
-	if !isIscsiSupported {
-		return fmt.Errorf("iSCSI support not detected")
+	if err := ValidateOntapIscsi(&d.Config, d.API); err != nil {
+		return err
 	}
 
-	// error if no 'iscsi session' exsits for the specified iscsi portal
-	sessionExists, sessionExistsErr := utils.IscsiSessionExists(d.Config.DataLIF)
-	if sessionExistsErr != nil {
-		return fmt.Errorf("Unexpected iSCSI session error: %v", sessionExistsErr)
-	}
-	if !sessionExists {
-		// TODO automatically login for the user if no session detected?
-		return fmt.Errorf("Expected iSCSI session %v NOT found, please login to the iscsi portal", d.Config.DataLIF)
+	if err := ValidateOntapAggregates(d.Config, d.API); err != nil {
+		return err
 	}
 
 	return nil
@@ -168,8 +134,10 @@ func (d *OntapSANStorageDriver) Validate() error {
 func (d *OntapSANStorageDriver) Create(name string, opts map[string]string) error {
 	log.Debugf("OntapSANStorageDriver#Create(%v)", name)
 
-	response, _ := d.API.VolumeSize(name)
-	if isPassed(response.Result.ResultStatusAttr) {
+	if _, err := d.API.VolumeSize(name); err == nil {
+		if IsOntapVolumeDeletePending(name, d.API) {
+			return fmt.Errorf("Volume %v has been removed and is pending deletion until its clones are destroyed", name)
+		}
 		log.Debugf("%v already exists, skipping create...", name)
 		return nil
 	}
@@ -180,7 +148,46 @@ func (d *OntapSANStorageDriver) Create(name string, opts map[string]string) erro
 	snapshotPolicy := utils.GetV(opts, "snapshotPolicy", "none")
 	unixPermissions := utils.GetV(opts, "unixPermissions", "---rwxr-xr-x")
 	exportPolicy := utils.GetV(opts, "exportPolicy", "default")
-	aggregate := utils.GetV(opts, "aggregate", d.Config.Aggregate)
+	aggregate := utils.GetV(opts, "aggregate", "")
+	if aggregate == "" {
+		var err error
+		if aggregate, err = SelectOntapAggregate(d.Config, d.API, &d.nextAggregate); err != nil {
+			return fmt.Errorf("Problem selecting aggregate for volume %v: %v", name, err)
+		}
+	}
+
+	if err := ValidateOntapQosOpts(opts, d.API); err != nil {
+		return err
+	}
+
+	props, err := GetOntapVolumeProperties(opts, d.Config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return err
+	}
+	if err := ValidateOntapVolumeCapabilities(props, d.Capabilities); err != nil {
+		return err
+	}
+	fsType := utils.GetV(opts, "fstype", DefaultFileSystemType)
+
+	if err := ValidateFileSystemType(fsType); err != nil {
+		return err
+	}
+
+	// remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	effectiveOpts["size"] = volumeSize
+	effectiveOpts["spaceReserve"] = spaceReserve
+	effectiveOpts["snapshotPolicy"] = snapshotPolicy
+	effectiveOpts["unixPermissions"] = unixPermissions
+	effectiveOpts["exportPolicy"] = exportPolicy
+	effectiveOpts["aggregate"] = aggregate
+	props.AddTo(effectiveOpts)
+	effectiveOpts["fstype"] = fsType
+
+	comment, err := EncodeOntapVolumeOpts(name, effectiveOpts)
+	if err != nil {
+		return err
+	}
 
 	log.WithFields(log.Fields{
 		"name":            name,
@@ -190,23 +197,30 @@ func (d *OntapSANStorageDriver) Create(name string, opts map[string]string) erro
 		"unixPermissions": unixPermissions,
 		"exportPolicy":    exportPolicy,
 		"aggregate":       aggregate,
+		"properties":      props,
+		"fstype":          fsType,
 	}).Debug("Creating volume with values")
 
 	// create the volume
-	response1, error1 := d.API.VolumeCreate(name, aggregate, volumeSize, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy)
-	if !isPassed(response1.Result.ResultStatusAttr) || error1 != nil {
-		if response1.Result.ResultErrnoAttr != azgo.EAPIERROR {
+	response1, error1 := d.API.VolumeCreate(name, aggregate, volumeSize, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy,
+		props.SecurityStyle, props.TieringPolicy, props.SnapshotReserve, props.Encryption)
+	if error1 != nil {
+		if !ontap.IsJobExists(error1) {
 			return fmt.Errorf("Error creating volume\n%verror: %v", response1.Result, error1)
-		} else {
-			if !strings.HasSuffix(strings.TrimSpace(response1.Result.ResultReasonAttr), "Job exists") {
-				return fmt.Errorf("Error creating volume\n%verror: %v", response1.Result, error1)
-			} else {
-				log.Warnf("%v volume create job already exists, skipping volume create on this node...", name)
-				return nil
-			}
 		}
+		// another host is creating the same volume; don't report success until its job is done
+		log.Warnf("%v volume create job already exists, waiting for it to complete...", name)
+		return WaitForOntapVolumeJobs(name, d.API)
 	}
 
+	// from here on, a failure destroys the volume, so that a retried create doesn't find it half set up
+	complete := false
+	defer func() {
+		if !complete {
+			DestroyFailedOntapVolume(name, d.API)
+		}
+	}()
+
 	lunPath := lunName(name)
 	osType := "linux"
 	spaceReserved := false
@@ -223,16 +237,38 @@ func (d *OntapSANStorageDriver) Create(name string, opts map[string]string) erro
 
 	// create the lun
 	response2, err2 := d.API.LunCreate(lunPath, lunSize, osType, spaceReserved)
-	if !isPassed(response2.Result.ResultStatusAttr) || err2 != nil {
+	if err2 != nil {
 		return fmt.Errorf("Error creating LUN\n%verror: %v", response2.Result, err2)
 	}
 
+	// set autosize and storage efficiency, which volume-create does not take
+	if err := ApplyOntapVolumeProperties(name, props, d.API); err != nil {
+		return err
+	}
+
+	// apply the requested QoS policy group, creating one for maxIops/maxThroughput
+	if err := ApplyOntapQosPolicy(name, opts, d.API); err != nil {
+		return err
+	}
+
+	// the comment comes last, as other hosts creating the same volume wait for it
+	if err := SetOntapVolumeComment(name, comment, d.API); err != nil {
+		return err
+	}
+
+	complete = true
+	d.ems.Created(name)
 	return nil
 }
 
 // Create a volume clone
 func (d *OntapSANStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
-	return CreateOntapClone(name, source, snapshot, newSnapshotPrefix, d.API)
+	if err := CreateOntapClone(name, source, snapshot, newSnapshotPrefix, d.API); err != nil {
+		return err
+	}
+
+	d.ems.Cloned(name, source)
+	return nil
 }
 
 // Destroy the requested (volume,lun) storage tuple
@@ -242,21 +278,38 @@ func (d *OntapSANStorageDriver) Destroy(name string) error {
 	lunPath := lunName(name)
 
 	// validate LUN+volume exists before trying to destroy
-	response0, _ := d.API.VolumeSize(name)
-	if !isPassed(response0.Result.ResultStatusAttr) {
+	_, err := d.API.VolumeSize(name)
+	if ontap.IsNotFound(err) {
 		log.Debugf("%v already deleted, skipping destroy", name)
 		return nil
 	}
+	if err != nil {
+		return fmt.Errorf("Error searching for existing volume: %v error: %v", name, err)
+	}
+
+	// If this is the parent of one or more clones, the configured policy decides whether to
+	// refuse, split the clones off (a full copy of each), or wait until the clones are gone.
+	// A clone has its own copy of the LUN, so the LUN is destroyed even if the volume has to wait.
+	destroyNow, err := ApplyOntapCloneDestroyPolicy(name, d.Config.CloneDestroyPolicy, d.API)
+	if err != nil {
+		return err
+	}
+
+	// remember the parent, which may be waiting on this clone to be destroyed
+	parent, _, err := GetOntapCloneParent(name, d.API)
+	if err != nil {
+		log.Warnf("Could not determine the parent of volume %v: %v", name, err)
+	}
 
 	// lun offline
 	response, err := d.API.LunOffline(lunPath)
-	if !isPassed(response.Result.ResultStatusAttr) || err != nil {
+	if err != nil {
 		log.Warnf("Error attempting to offline lun: %v\n%verror: %v", lunPath, response.Result, err)
 	}
 
 	// lun destroy
 	response2, err2 := d.API.LunDestroy(lunPath)
-	if !isPassed(response2.Result.ResultStatusAttr) || err2 != nil {
+	if err2 != nil {
 		log.Warnf("Error destroying lun: %v\n%verror: %v", lunPath, response2.Result, err2)
 	}
 
@@ -264,15 +317,22 @@ func (d *OntapSANStorageDriver) Destroy(name string) error {
 	utils.MultipathFlush() // flush unused paths
 	utils.IscsiRescan()
 
+	if !destroyNow {
+		return nil
+	}
+
 	response3, error3 := d.API.VolumeDestroy(name, true)
-	if !isPassed(response3.Result.ResultStatusAttr) || error3 != nil {
-		if response3.Result.ResultErrnoAttr != azgo.EVOLUMEDOESNOTEXIST {
+	if error3 != nil {
+		if !ontap.IsNotFound(error3) {
 			return fmt.Errorf("Error destroying volume: %v\n%verror: %v", name, response3.Result, error3)
 		} else {
 			log.Warnf("Volume already deleted while destroying volume: %v\n%verror: %v", name, response3.Result, error3)
 		}
 	}
 
+	DeleteOntapAutoQosPolicy(name, d.API)
+	DestroyDeferredOntapParent(parent, d.API)
+	d.ems.Destroyed(name)
 	return nil
 }
 
@@ -280,13 +340,133 @@ func (d *OntapSANStorageDriver) Destroy(name string) error {
 func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
 	log.Debugf("OntapSANStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
 
-	igroupName := d.Config.IgroupName
 	lunPath := lunName(name)
 
+	// format with the filesystem requested at create time
+	volumeOpts, err := d.GetVolumeOpts(name)
+	if err != nil {
+		return fmt.Errorf("Problem reading options for volume: %v error: %v", name, err)
+	}
+	fsType := utils.GetV(volumeOpts, "fstype", DefaultFileSystemType)
+
+	if err := AttachOntapLun(name, lunPath, mountpoint, fsType, d.Config, d.API); err != nil {
+		return err
+	}
+
+	d.ems.Attached(name)
+	return nil
+}
+
+// Detach the volume
+func (d *OntapSANStorageDriver) Detach(name, mountpoint string) error {
+	log.Debugf("OntapSANStorageDriver#Detach(%v, %v)", name, mountpoint)
+
+	cmd := fmt.Sprintf("umount %s", mountpoint)
+	log.Debugf("cmd==%s", cmd)
+	if out, err := exec.Command("sh", "-c", cmd).CombinedOutput(); err != nil {
+		log.Debugf("out==%v", string(out))
+		return fmt.Errorf("Problem unmounting docker volume: %v mountpoint: %v error: %v", name, mountpoint, err)
+	}
+
+	d.ems.Detached(name)
+	return nil
+}
+
+// DefaultStoragePrefix is the driver specific prefix for created storage, can be overridden in the config file
+func (d *OntapSANStorageDriver) DefaultStoragePrefix() string {
+	return "netappdvp_"
+}
+
+// DefaultSnapshotPrefix is the driver specific prefix for created snapshots, can be overridden in the config file
+func (d *OntapSANStorageDriver) DefaultSnapshotPrefix() string {
+	return "netappdvp_"
+}
+
+// Return the list of snapshots associated with the named volume
+func (d *OntapSANStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
+	return GetSnapshotList(name, d.API)
+}
+
+// Return the options the named volume was created with
+func (d *OntapSANStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	return GetOntapVolumeOpts(name, d.API)
+}
+
+// Return the clone parent and children of the named volume
+func (d *OntapSANStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	return GetOntapVolumeStatus(name, d.API, d.Capabilities)
+}
+
+// ValidateOntapIscsi checks that the configured data LIF serves iSCSI, or sets it to the first LIF of the SVM
+// that does if none is configured, and that this host is logged in to it
+func ValidateOntapIscsi(config *OntapStorageDriverConfig, api ontap.API) error {
+	r1, err1 := api.NetInterfaceGet()
+	if err1 != nil {
+		return fmt.Errorf("Problem checking network interfaces; error: %v", err1)
+	}
+
+	// if they didn't set a lif to use in the config, we'll set it to the first iscsi lif we happen to find
+	if config.DataLIF == "" {
+		for _, attrs := range r1.Result.AttributesList() {
+			for _, protocol := range attrs.DataProtocols() {
+				if protocol == "iscsi" {
+					log.Debugf("Setting iSCSI protocol access to '%v'", attrs.Address())
+					config.DataLIF = string(attrs.Address())
+				}
+			}
+		}
+	}
+
+	// now, we validate our settings
+	foundIscsi := false
+	iscsiLifCount := 0
+	for _, attrs := range r1.Result.AttributesList() {
+		for _, protocol := range attrs.DataProtocols() {
+			if protocol == "iscsi" {
+				log.Debugf("Comparing iSCSI protocol access on: '%v' vs: '%v'", attrs.Address(), config.DataLIF)
+				if string(attrs.Address()) == config.DataLIF {
+					foundIscsi = true
+					iscsiLifCount++
+				}
+			}
+		}
+	}
+
+	if iscsiLifCount > 1 {
+		log.Debugf("Found multiple iSCSI lifs")
+	}
+
+	if !foundIscsi {
+		return fmt.Errorf("Could not find iSCSI DataLIF")
+	}
+
+	isIscsiSupported := utils.IscsiSupported()
+	if !isIscsiSupported {
+		return fmt.Errorf("iSCSI support not detected")
+	}
+
+	// error if no 'iscsi session' exsits for the specified iscsi portal
+	sessionExists, sessionExistsErr := utils.IscsiSessionExists(config.DataLIF)
+	if sessionExistsErr != nil {
+		return fmt.Errorf("Unexpected iSCSI session error: %v", sessionExistsErr)
+	}
+	if !sessionExists {
+		// TODO automatically login for the user if no session detected?
+		return fmt.Errorf("Expected iSCSI session %v NOT found, please login to the iscsi portal", config.DataLIF)
+	}
+
+	return nil
+}
+
+// AttachOntapLun maps the lun at lunPath to this host through the configured igroup, formats it with fsType if
+// it has no filesystem yet, and mounts it at mountpoint
+func AttachOntapLun(name, lunPath, mountpoint, fsType string, config OntapStorageDriverConfig, api ontap.API) error {
+	igroupName := config.IgroupName
+
 	// igroup create
-	response, err := d.API.IgroupCreate(igroupName, "iscsi", "linux")
-	if !isPassed(response.Result.ResultStatusAttr) {
-		if response.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_EXISTS {
+	response, err := api.IgroupCreate(igroupName, "iscsi", "linux")
+	if err != nil {
+		if !ontap.IsAlreadyExists(err) {
 			return fmt.Errorf("Problem creating igroup: %v\n%verror: %v", igroupName, response.Result, err)
 		}
 	}
@@ -299,9 +479,9 @@ func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]
 
 	// igroup add each iqn we found
 	for _, iqn := range iqns {
-		response2, err2 := d.API.IgroupAdd(igroupName, iqn)
-		if !isPassed(response2.Result.ResultStatusAttr) {
-			if response2.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_HAS_NODE {
+		response2, err2 := api.IgroupAdd(igroupName, iqn)
+		if err2 != nil {
+			if !ontap.IsAlreadyExists(err2) {
 				return fmt.Errorf("Problem adding iqn: %v to igroup: %v\n%verror: %v", iqn, igroupName, response2.Result, err2)
 			}
 		}
@@ -310,8 +490,8 @@ func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]
 	// check if already mapped, so we don't map again
 	lunID := 0
 	alreadyMapped := false
-	response5, _ := d.API.LunMapListInfo(lunPath)
-	if response5.Result.ResultStatusAttr == "passed" {
+	response5, err5 := api.LunMapListInfo(lunPath)
+	if err5 == nil {
 		if response5.Result.InitiatorGroups() != nil {
 			if len(response5.Result.InitiatorGroups()) > 0 {
 				lunID = response5.Result.InitiatorGroups()[0].LunId()
@@ -326,13 +506,14 @@ func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]
 		// spin until we get a lunId that works
 		// TODO find one directly instead of spinning-and-looking for one?
 		for i := 0; i < 4096; i++ {
-			response4, err4 := d.API.LunMap(igroupName, lunPath, i)
-			if response4.Result.ResultStatusAttr == "passed" {
+			_, err4 := api.LunMap(igroupName, lunPath, i)
+			if err4 == nil {
 				lunID = i
 				break
 			}
 
-			if response4.Result.ResultErrnoAttr != azgo.EVDISK_ERROR_INITGROUP_HAS_LUN {
+			// keep looking while the lun id is in use by another lun in the igroup
+			if !ontap.IsErrno(err4, azgo.EVDISK_ERROR_INITGROUP_HAS_LUN) {
 				return fmt.Errorf("Problem mapping lun: %v error: %v", lunPath, err4)
 			}
 		}
@@ -356,7 +537,7 @@ func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]
 
 	sessionInfoToUse := utils.IscsiSessionInfo{}
 	for i, e := range sessionInfo {
-		if e.PortalIP == d.Config.DataLIF {
+		if e.PortalIP == config.DataLIF {
 			sessionInfoToUse = sessionInfo[i]
 		}
 	}
@@ -397,7 +578,7 @@ func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]
 		// put a filesystem on it if there isn't one already there
 		if e.Filesystem == "" {
 			// format it
-			err := utils.FormatVolume(deviceToUse, "ext4") // TODO externalize fsType
+			err := utils.FormatVolume(deviceToUse, fsType)
 			if err != nil {
 				return fmt.Errorf("Problem formatting lun: %v device: %v error: %v", name, deviceToUse, err)
 			}
@@ -413,32 +594,3 @@ func (d *OntapSANStorageDriver) Attach(name, mountpoint string, opts map[string]
 
 	return nil
 }
-
-// Detach the volume
-func (d *OntapSANStorageDriver) Detach(name, mountpoint string) error {
-	log.Debugf("OntapSANStorageDriver#Detach(%v, %v)", name, mountpoint)
-
-	cmd := fmt.Sprintf("umount %s", mountpoint)
-	log.Debugf("cmd==%s", cmd)
-	if out, err := exec.Command("sh", "-c", cmd).CombinedOutput(); err != nil {
-		log.Debugf("out==%v", string(out))
-		return fmt.Errorf("Problem unmounting docker volume: %v mountpoint: %v error: %v", name, mountpoint, err)
-	}
-
-	return nil
-}
-
-// DefaultStoragePrefix is the driver specific prefix for created storage, can be overridden in the config file
-func (d *OntapSANStorageDriver) DefaultStoragePrefix() string {
-	return "netappdvp_"
-}
-
-// DefaultSnapshotPrefix is the driver specific prefix for created snapshots, can be overridden in the config file
-func (d *OntapSANStorageDriver) DefaultSnapshotPrefix() string {
-	return "netappdvp_"
-}
-
-// Return the list of snapshots associated with the named volume
-func (d *OntapSANStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
-	return GetSnapshotList(name, d.API)
-}
@@ -0,0 +1,779 @@
+// Copyright 2016 NetApp, Inc. All Rights Reserved.
+
+package storage_drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/netapp/netappdvp/apis/ontap"
+	"github.com/netapp/netappdvp/azgo"
+	"github.com/netapp/netappdvp/utils"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// OntapSANEconomyStorageDriverName is the constant name for this Ontap SAN economy storage driver
+const OntapSANEconomyStorageDriverName = "ontap-san-economy"
+
+func init() {
+	san := &OntapSANEconomyStorageDriver{}
+	san.Initialized = false
+	Drivers[san.Name()] = san
+	log.Debugf("Registered driver '%v'", san.Name())
+}
+
+// How many LUNs the driver places in each FlexVol, set with lunsPerFlexvol in the config file
+const (
+	DefaultLunsPerFlexvol = 100
+	MinLunsPerFlexvol     = 50
+	MaxLunsPerFlexvol     = 200
+)
+
+const (
+	ontapLunPoolInfix     = "lunpool_"       // follows the storage prefix in the names of the FlexVols holding LUNs
+	ontapLunSnapshotInfix = "_snapshot_"     // joins a LUN's name and the name of one of its snapshots
+	ontapLunOptsAttribute = "netappdvp-opts" // the LUN attribute holding the options the LUN was created with
+)
+
+// ontapMaxLunAttributeLength is the longest LUN attribute value ONTAP will accept
+const ontapMaxLunAttributeLength = 4096
+
+// ParseLunsPerFlexvol reads the lunsPerFlexvol config file setting; empty means DefaultLunsPerFlexvol
+func ParseLunsPerFlexvol(lunsPerFlexvol string) (int, error) {
+	if lunsPerFlexvol == "" {
+		return DefaultLunsPerFlexvol, nil
+	}
+	limit, err := strconv.Atoi(lunsPerFlexvol)
+	if err != nil || limit < MinLunsPerFlexvol || limit > MaxLunsPerFlexvol {
+		return 0, fmt.Errorf("Invalid lunsPerFlexvol: %v, expected a number from %v to %v",
+			lunsPerFlexvol, MinLunsPerFlexvol, MaxLunsPerFlexvol)
+	}
+	return limit, nil
+}
+
+// ontapDefaultSnapshotReserve is the percentage of a FlexVol ONTAP reserves for snapshots unless told otherwise
+const ontapDefaultSnapshotReserve = 5
+
+// OntapLunPoolGrowth is how much a FlexVol must grow to hold a LUN of the size, in bytes, given the percentage of
+// the FlexVol reserved for snapshots; a negative reserve is one left to ONTAP, which reserves 5% by default
+func OntapLunPoolGrowth(lunSize, snapshotReserve int) int {
+	if snapshotReserve < 0 {
+		snapshotReserve = ontapDefaultSnapshotReserve
+	}
+	if snapshotReserve == 0 {
+		return lunSize
+	}
+	return lunSize * 100 / (100 - snapshotReserve)
+}
+
+// ontapSnapshotReserve returns the percentage of the FlexVol reserved for snapshots, or -1 if it was not reported
+func ontapSnapshotReserve(attrs azgo.VolumeAttributesType) int {
+	if attrs.VolumeSpaceAttributesPtr == nil || attrs.VolumeSpaceAttributesPtr.PercentageSnapshotReservePtr == nil {
+		return -1
+	}
+	return attrs.VolumeSpaceAttributesPtr.PercentageSnapshotReserve()
+}
+
+// OntapLunSnapshotName is the name in the FlexVol of a snapshot taken for the named LUN
+func OntapLunSnapshotName(lun, snapshot string) string {
+	return lun + ontapLunSnapshotInfix + snapshot
+}
+
+// ontapLunPath is the path of the named LUN in a FlexVol
+func ontapLunPath(pool, name string) string {
+	return fmt.Sprintf("/vol/%v/%v", pool, name)
+}
+
+// OntapSANEconomyStorageDriver is for iSCSI storage provisioning of many LUNs, which share a small number of
+// FlexVols rather than having one each
+type OntapSANEconomyStorageDriver struct {
+	Initialized  bool
+	Config       OntapStorageDriverConfig
+	API          ontap.API
+	Capabilities ontap.Capabilities // features of the ONTAPI version negotiated by Validate
+
+	lunsPerFlexvol int       // LUNs placed in a FlexVol before another is created
+	nextAggregate  int       // position in the aggregate list for the roundRobin placement policy
+	ems            *OntapEms // EMS events about volume operations
+}
+
+// Name is for returning the name of this driver
+func (d *OntapSANEconomyStorageDriver) Name() string {
+	log.Debugf("OntapSANEconomyStorageDriver#Name()")
+	return OntapSANEconomyStorageDriverName
+}
+
+// Initialize from the provided config
+func (d *OntapSANEconomyStorageDriver) Initialize(configJSON string) error {
+	log.Debugf("OntapSANEconomyStorageDriver#Initialize(...)")
+
+	config := &OntapStorageDriverConfig{}
+	config.IgroupName = "netappdvp"
+
+	// decode configJSON into OntapStorageDriverConfig object
+	err := json.Unmarshal([]byte(configJSON), &config)
+	if err != nil {
+		return fmt.Errorf("Cannot decode json configuration error: %v", err)
+	}
+
+	log.WithFields(log.Fields{
+		"Version":           config.Version,
+		"StorageDriverName": config.StorageDriverName,
+		"Debug":             config.Debug,
+		"DisableDelete":     config.DisableDelete,
+		"StoragePrefixRaw":  string(config.StoragePrefixRaw),
+		"SnapshotPrefixRaw": string(config.SnapshotPrefixRaw),
+	}).Debugf("Reparsed into ontapConfig")
+
+	d.Config = *config
+	d.API, err = InitializeOntapDriver(d.Config)
+	if err != nil {
+		return fmt.Errorf("Problem while initializing, error: %v", err)
+	}
+
+	validationErr := d.Validate()
+	if validationErr != nil {
+		return fmt.Errorf("Problem validating OntapSANEconomyStorageDriver error: %v", validationErr)
+	}
+
+	// log an informational message when this plugin starts, and the volume count from then on
+	d.ems = NewOntapEms(d.Name(), d.API, d.Config.StoragePrefix(d.DefaultStoragePrefix()), d.lunTotal)
+	d.ems.Initialized()
+	heartbeatInterval, _ := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval) // checked by Validate
+	d.ems.StartHeartbeat(heartbeatInterval)
+
+	d.Initialized = true
+	log.Infof("Successfully initialized Ontap SAN economy Docker driver version %v", DriverVersion)
+	return nil
+}
+
+// Validate the driver configuration and execution environment
+func (d *OntapSANEconomyStorageDriver) Validate() error {
+	log.Debugf("OntapSANEconomyStorageDriver#Validate()")
+
+	lunsPerFlexvol, err := ParseLunsPerFlexvol(d.Config.LunsPerFlexvol)
+	if err != nil {
+		return err
+	}
+	d.lunsPerFlexvol = lunsPerFlexvol
+
+	if _, err := ParseEmsHeartbeatInterval(d.Config.EmsHeartbeatInterval); err != nil {
+		return err
+	}
+
+	r0, err0 := d.API.SystemGetVersion()
+	if err0 != nil {
+		return fmt.Errorf("Could not validate credentials for %v@%v, error: %v", d.Config.Username, d.Config.SVM, err0)
+	}
+
+	systemVersion := r0.Result
+	if systemVersion.VersionPtr == nil {
+		return fmt.Errorf("Could not determine system version for %v@%v", d.Config.Username, d.Config.SVM)
+	}
+
+	// requests use the newest ONTAPI version both sides speak, which decides the features we can offer
+	capabilities, err := NegotiateOntapCapabilities(d.Config, d.API, systemVersion.Version())
+	if err != nil {
+		return err
+	}
+	d.Capabilities = capabilities
+
+	if err := ValidateOntapIscsi(&d.Config, d.API); err != nil {
+		return err
+	}
+
+	if err := ValidateOntapAggregates(d.Config, d.API); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// poolPrefix is the start of the names of the FlexVols that hold this driver's LUNs
+func (d *OntapSANEconomyStorageDriver) poolPrefix() string {
+	return d.Config.StoragePrefix(d.DefaultStoragePrefix()) + ontapLunPoolInfix
+}
+
+// findLun returns the named LUN and the FlexVol that holds it; a LUN that does not exist returns nil
+func (d *OntapSANEconomyStorageDriver) findLun(name string) (*azgo.LunInfoType, string, error) {
+	response, err := d.API.LunGet(ontapLunPath(d.poolPrefix()+"*", name))
+	if err != nil {
+		return nil, "", fmt.Errorf("Error searching for LUN %v: %v", name, err)
+	}
+	luns := response.Result.AttributesList()
+	if len(luns) == 0 {
+		return nil, "", nil
+	}
+	if len(luns) > 1 {
+		log.Warnf("Found %v LUNs named %v, using %v", len(luns), name, luns[0].Path())
+	}
+	return &luns[0], luns[0].Volume(), nil
+}
+
+// lunCount returns the number of LUNs in the FlexVol
+func (d *OntapSANEconomyStorageDriver) lunCount(pool string) (int, error) {
+	response, err := d.API.LunGet(ontapLunPath(pool, "*"))
+	if err != nil {
+		return 0, fmt.Errorf("Error listing the LUNs of volume %v: %v", pool, err)
+	}
+	return len(response.Result.AttributesList()), nil
+}
+
+// lunTotal returns the number of LUNs in all the FlexVols of the driver, which are its volumes
+func (d *OntapSANEconomyStorageDriver) lunTotal() (int, error) {
+	response, err := d.API.LunGet(ontapLunPath(d.poolPrefix()+"*", "*"))
+	if err != nil {
+		return 0, fmt.Errorf("Error listing LUNs: %v", err)
+	}
+	return len(response.Result.AttributesList()), nil
+}
+
+// poolSnapshotReserve returns the percentage of the FlexVol reserved for snapshots, or -1 if it cannot be read
+func (d *OntapSANEconomyStorageDriver) poolSnapshotReserve(pool string) int {
+	response, err := d.API.VolumeGet(pool)
+	if err != nil || len(response.Result.AttributesList()) == 0 {
+		log.Warnf("Could not read the snapshot reserve of volume %v: %v", pool, err)
+		return -1
+	}
+	return ontapSnapshotReserve(response.Result.AttributesList()[0])
+}
+
+// selectPool returns a FlexVol with room for another LUN of lunSize bytes whose options match poolOpts, and the
+// bytes it was grown by to hold the LUN, or "" if there is none.  The aggregate must match too when one was
+// requested.
+func (d *OntapSANEconomyStorageDriver) selectPool(aggregate string, poolOpts map[string]string,
+	lunSize int) (string, int, error) {
+
+	response, err := d.API.VolumeGet(d.poolPrefix() + "*")
+	if err != nil {
+		return "", 0, fmt.Errorf("Error listing LUN volumes: %v", err)
+	}
+
+	for _, attrs := range response.Result.AttributesList() {
+		idAttrs := attrs.VolumeIdAttributesPtr
+		if idAttrs == nil || idAttrs.NamePtr == nil {
+			continue
+		}
+		pool := string(idAttrs.Name())
+
+		opts := make(map[string]string)
+		if idAttrs.CommentPtr != nil {
+			opts, _ = DecodeVolumeOpts(idAttrs.Comment())
+		}
+		if !OntapLunPoolMatches(opts, poolOpts, aggregate) || IsOntapVolumeDeletePending(pool, d.API) {
+			continue
+		}
+
+		count, err := d.lunCount(pool)
+		if err != nil {
+			return "", 0, err
+		}
+		if count >= d.lunsPerFlexvol {
+			log.Debugf("Volume %v already holds %v LUNs", pool, count)
+			continue
+		}
+
+		// a volume that cannot grow, say because its aggregate is full, is skipped
+		growth := OntapLunPoolGrowth(lunSize, ontapSnapshotReserve(attrs))
+		if response, err := d.API.VolumeSetSize(pool, "+"+strconv.Itoa(growth)); err != nil {
+			log.Warnf("Could not grow volume %v for a new LUN\n%verror: %v", pool, response.Result, err)
+			continue
+		}
+		return pool, growth, nil
+	}
+	return "", 0, nil
+}
+
+// OntapLunPoolMatches reports whether a FlexVol created with poolOpts can hold a LUN that needs wanted, and is on
+// the aggregate if one was requested
+func OntapLunPoolMatches(poolOpts, wanted map[string]string, aggregate string) bool {
+	for k, v := range wanted {
+		if poolOpts[k] != v {
+			return false
+		}
+	}
+	return aggregate == "" || poolOpts["aggregate"] == aggregate
+}
+
+// createPool creates a FlexVol for LUNs, recording poolOpts in its comment, sized to hold a LUN of lunSize bytes;
+// it returns the FlexVol and its size
+func (d *OntapSANEconomyStorageDriver) createPool(aggregate string, lunSize int, poolOpts map[string]string,
+	props OntapVolumeProperties) (string, int, error) {
+
+	if aggregate == "" {
+		var err error
+		if aggregate, err = SelectOntapAggregate(d.Config, d.API, &d.nextAggregate); err != nil {
+			return "", 0, fmt.Errorf("Problem selecting aggregate for LUN volume: %v", err)
+		}
+	}
+	pool := d.poolPrefix() + strconv.FormatInt(time.Now().UnixNano(), 10)
+	size := OntapLunPoolGrowth(lunSize, props.SnapshotReserve)
+
+	effectiveOpts := utils.CopyOpts(poolOpts)
+	effectiveOpts["aggregate"] = aggregate
+	comment, err := EncodeOntapVolumeOpts(pool, effectiveOpts)
+	if err != nil {
+		return "", 0, err
+	}
+
+	log.WithFields(log.Fields{
+		"name":       pool,
+		"size":       size,
+		"aggregate":  aggregate,
+		"options":    poolOpts,
+		"properties": props,
+	}).Debug("Creating LUN volume with values")
+
+	response, err := d.API.VolumeCreate(pool, aggregate, strconv.Itoa(size), poolOpts["spaceReserve"],
+		poolOpts["snapshotPolicy"], "", "default", props.SecurityStyle, props.TieringPolicy, props.SnapshotReserve,
+		props.Encryption)
+	if err != nil {
+		return "", 0, fmt.Errorf("Error creating LUN volume\n%verror: %v", response.Result, err)
+	}
+
+	// the snapshot reserve left to ONTAP may differ from its usual default
+	if props.SnapshotReserve < 0 {
+		if reserve := d.poolSnapshotReserve(pool); reserve >= 0 && OntapLunPoolGrowth(lunSize, reserve) != size {
+			size = OntapLunPoolGrowth(lunSize, reserve)
+			if response, err := d.API.VolumeSetSize(pool, strconv.Itoa(size)); err != nil {
+				d.releasePool(pool, size, true)
+				return "", 0, fmt.Errorf("Error sizing LUN volume\n%verror: %v", response.Result, err)
+			}
+		}
+	}
+
+	// set autosize and storage efficiency, which volume-create does not take
+	if err := ApplyOntapVolumeProperties(pool, props, d.API); err != nil {
+		d.releasePool(pool, size, true)
+		return "", 0, err
+	}
+
+	if err := SetOntapVolumeComment(pool, comment, d.API); err != nil {
+		d.releasePool(pool, size, true)
+		return "", 0, err
+	}
+	return pool, size, nil
+}
+
+// encodeLunOpts serializes the options a LUN is created with for its LUN attribute, checking that they fit
+func encodeLunOpts(name string, opts map[string]string) (string, error) {
+	value, err := EncodeVolumeOpts(opts)
+	if err != nil {
+		return "", err
+	}
+	if len(value) > ontapMaxLunAttributeLength {
+		return "", fmt.Errorf("Options for volume %v exceed the maximum LUN attribute length of %v characters",
+			name, ontapMaxLunAttributeLength)
+	}
+	return value, nil
+}
+
+// setLunOpts stores options encoded by encodeLunOpts in a LUN attribute
+func (d *OntapSANEconomyStorageDriver) setLunOpts(lunPath, value string) error {
+	response, err := d.API.LunSetAttribute(lunPath, ontapLunOptsAttribute, value)
+	if err != nil {
+		return fmt.Errorf("Error setting LUN attribute\n%verror: %v", response.Result, err)
+	}
+	return nil
+}
+
+// releasePool undoes the room made for a LUN that could not be created: a FlexVol created for it is destroyed,
+// unless another host has placed a LUN in it meanwhile, and an existing FlexVol is shrunk by growth bytes
+func (d *OntapSANEconomyStorageDriver) releasePool(pool string, growth int, created bool) {
+	if created {
+		if count, err := d.lunCount(pool); err != nil || count > 0 {
+			log.Warnf("Not destroying volume %v, which may hold other LUNs: %v LUNs, error: %v", pool, count, err)
+			return
+		}
+		if response, err := d.API.VolumeDestroy(pool, true); err != nil {
+			log.Warnf("Could not destroy volume %v\n%verror: %v", pool, response.Result, err)
+		}
+		return
+	}
+	if response, err := d.API.VolumeSetSize(pool, "-"+strconv.Itoa(growth)); err != nil {
+		log.Warnf("Could not shrink volume %v\n%verror: %v", pool, response.Result, err)
+	}
+}
+
+// destroyLun removes a LUN whose options could not be recorded, so that a retried create does not find it and
+// report success for a LUN that would be formatted without the requested options
+func (d *OntapSANEconomyStorageDriver) destroyLun(lunPath string) {
+	if response, err := d.API.LunDestroy(lunPath); err != nil && !ontap.IsNotFound(err) {
+		log.Warnf("Could not destroy LUN %v\n%verror: %v", lunPath, response.Result, err)
+	}
+}
+
+// Create a LUN with the specified options, in a FlexVol shared with other LUNs
+func (d *OntapSANEconomyStorageDriver) Create(name string, opts map[string]string) error {
+	log.Debugf("OntapSANEconomyStorageDriver#Create(%v)", name)
+
+	lun, _, err := d.findLun(name)
+	if err != nil {
+		return err
+	}
+	if lun != nil {
+		log.Debugf("%v already exists, skipping create...", name)
+		return nil
+	}
+
+	// get options with default values if not specified in config file
+	volumeSize := utils.GetV(opts, "size", "1g")
+	spaceReserve := utils.GetV(opts, "spaceReserve", "none")
+	snapshotPolicy := utils.GetV(opts, "snapshotPolicy", "none")
+	aggregate := utils.GetV(opts, "aggregate", "")
+
+	// QoS policy groups apply to whole FlexVols, which the LUNs share
+	for _, opt := range []string{"qosPolicy", "maxIops", "maxThroughput"} {
+		if utils.GetV(opts, opt, "") != "" {
+			return fmt.Errorf("The %v option is not supported by the %v driver", opt, OntapSANEconomyStorageDriverName)
+		}
+	}
+
+	props, err := GetOntapVolumeProperties(opts, d.Config.OntapStorageDriverConfigDefaults)
+	if err != nil {
+		return err
+	}
+	if err := ValidateOntapVolumeCapabilities(props, d.Capabilities); err != nil {
+		return err
+	}
+	fsType := utils.GetV(opts, "fstype", DefaultFileSystemType)
+
+	if err := ValidateFileSystemType(fsType); err != nil {
+		return err
+	}
+
+	// lunSize takes some effort; we must convert user friendly strings to total bytes; ex "4KB" -> 4096
+	convertedSize, convertErr := utils.ConvertSizeToBytes(volumeSize)
+	if convertErr != nil {
+		return fmt.Errorf("Cannot convert size to bytes: %v error: %v", volumeSize, convertErr)
+	}
+	lunSize, atoiErr := strconv.Atoi(convertedSize)
+	if atoiErr != nil {
+		return fmt.Errorf("Cannot convert size to bytes: %v error: %v", volumeSize, atoiErr)
+	}
+
+	// the LUN goes in a FlexVol created with the same FlexVol options, which grows to make room for it
+	poolOpts := map[string]string{"spaceReserve": spaceReserve, "snapshotPolicy": snapshotPolicy}
+	props.AddTo(poolOpts)
+
+	log.WithFields(log.Fields{
+		"name":       name,
+		"volumeSize": volumeSize,
+		"aggregate":  aggregate,
+		"poolOpts":   poolOpts,
+		"fstype":     fsType,
+	}).Debug("Creating LUN with values")
+
+	// remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	for k, v := range poolOpts {
+		effectiveOpts[k] = v
+	}
+	effectiveOpts["size"] = volumeSize
+	effectiveOpts["fstype"] = fsType
+	value, err := encodeLunOpts(name, effectiveOpts)
+	if err != nil {
+		return err
+	}
+
+	pool, growth, err := d.selectPool(aggregate, poolOpts, lunSize)
+	if err != nil {
+		return err
+	}
+	created := false
+	if pool == "" {
+		if pool, growth, err = d.createPool(aggregate, lunSize, poolOpts, props); err != nil {
+			return err
+		}
+		created = true
+	}
+
+	lunPath := ontapLunPath(pool, name)
+	response, err := d.API.LunCreate(lunPath, lunSize, "linux", false)
+	if err != nil {
+		d.releasePool(pool, growth, created)
+		return fmt.Errorf("Error creating LUN\n%verror: %v", response.Result, err)
+	}
+
+	if err := d.setLunOpts(lunPath, value); err != nil {
+		d.destroyLun(lunPath)
+		d.releasePool(pool, growth, created)
+		return err
+	}
+
+	d.ems.Created(name)
+	return nil
+}
+
+// Create a LUN clone, a file clone of the source LUN in the same FlexVol, from a snapshot of the source
+func (d *OntapSANEconomyStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
+	log.Debugf("OntapSANEconomyStorageDriver#CreateClone(%v, %v, %v, %v)", name, source, snapshot, newSnapshotPrefix)
+
+	// If the specified LUN already exists, skip creation and call it a success
+	lun, _, err := d.findLun(name)
+	if err != nil {
+		return err
+	}
+	if lun != nil {
+		return nil
+	}
+
+	sourceLun, pool, err := d.findLun(source)
+	if err != nil {
+		return err
+	}
+	if sourceLun == nil {
+		return fmt.Errorf("Volume %v does not exist", source)
+	}
+
+	// If no specific snapshot was requested, take one of the source's FlexVol for the source LUN
+	newSnapshot := snapshot == ""
+	if newSnapshot {
+		snapshot = newSnapshotPrefix + time.Now().UTC().Format("20060102T150405Z")
+	}
+
+	// Record where the clone came from, along with the options inherited from its source
+	sourceOpts, err := d.GetVolumeOpts(source)
+	if err != nil {
+		log.Warnf("Could not read options of clone source %v: %v", source, err)
+	}
+	value, err := encodeLunOpts(name, CloneVolumeOpts(sourceOpts, source, snapshot))
+	if err != nil {
+		return err
+	}
+
+	if newSnapshot {
+		if _, err := d.API.SnapshotCreate(OntapLunSnapshotName(source, snapshot), pool); err != nil {
+			return fmt.Errorf("Error creating snapshot: %v", err)
+		}
+	}
+
+	// the clone shares the source's FlexVol, which may hold more LUNs than lunsPerFlexvol as a result
+	growth := OntapLunPoolGrowth(sourceLun.Size(), d.poolSnapshotReserve(pool))
+	grown := true
+	if response, err := d.API.VolumeSetSize(pool, "+"+strconv.Itoa(growth)); err != nil {
+		log.Warnf("Could not grow volume %v for LUN clone %v\n%verror: %v", pool, name, response.Result, err)
+		grown = false
+	}
+
+	_, err = d.API.LunCloneCreate(pool, source, name, OntapLunSnapshotName(source, snapshot))
+	if err != nil {
+		if grown {
+			d.releasePool(pool, growth, false)
+		}
+		if ontap.IsNotFound(err) {
+			return fmt.Errorf("Snapshot %v of volume %v does not exist", snapshot, source)
+		}
+		return fmt.Errorf("Error creating clone: %v", err)
+	}
+
+	if err := d.setLunOpts(ontapLunPath(pool, name), value); err != nil {
+		d.destroyLun(ontapLunPath(pool, name))
+		if grown {
+			d.releasePool(pool, growth, false)
+		}
+		return err
+	}
+
+	d.ems.Cloned(name, source)
+	return nil
+}
+
+// Destroy the LUN, along with its snapshots, and the FlexVol that held it if no other LUNs remain
+func (d *OntapSANEconomyStorageDriver) Destroy(name string) error {
+	log.Debugf("OntapSANEconomyStorageDriver#Destroy(%v)", name)
+
+	lun, pool, err := d.findLun(name)
+	if err != nil {
+		return err
+	}
+	if lun == nil {
+		log.Debugf("%v already deleted, skipping destroy", name)
+		return nil
+	}
+	lunPath := lun.Path()
+
+	// lun offline
+	response, err := d.API.LunOffline(lunPath)
+	if err != nil {
+		log.Warnf("Error attempting to offline lun: %v\n%verror: %v", lunPath, response.Result, err)
+	}
+
+	// lun destroy
+	response2, err2 := d.API.LunDestroy(lunPath)
+	if err2 != nil && !ontap.IsNotFound(err2) {
+		return fmt.Errorf("Error destroying lun: %v\n%verror: %v", lunPath, response2.Result, err2)
+	}
+
+	// perform rediscovery to remove the deleted LUN
+	utils.MultipathFlush() // flush unused paths
+	utils.IscsiRescan()
+
+	// the snapshots taken for this LUN are of no use to the others in the FlexVol
+	if snapshots, err := d.API.SnapshotGetByVolume(pool); err != nil {
+		log.Warnf("Could not list the snapshots of volume %v: %v", pool, err)
+	} else {
+		for _, snapshot := range snapshots.Result.AttributesList() {
+			if !strings.HasPrefix(snapshot.Name(), name+ontapLunSnapshotInfix) {
+				continue
+			}
+			if response3, err3 := d.API.SnapshotDelete(snapshot.Name(), pool); err3 != nil {
+				log.Warnf("Error deleting snapshot: %v\n%verror: %v", snapshot.Name(), response3.Result, err3)
+			}
+		}
+	}
+
+	count, err := d.lunCount(pool)
+	if err != nil {
+		log.Warnf("Could not count the LUNs remaining in volume %v: %v", pool, err)
+	} else if count == 0 {
+		response4, err4 := d.API.VolumeDestroy(pool, true)
+		if err4 != nil && !ontap.IsNotFound(err4) {
+			return fmt.Errorf("Error destroying volume: %v\n%verror: %v", pool, response4.Result, err4)
+		}
+	} else {
+		shrink := "-" + strconv.Itoa(OntapLunPoolGrowth(lun.Size(), d.poolSnapshotReserve(pool)))
+		if response4, err4 := d.API.VolumeSetSize(pool, shrink); err4 != nil {
+			log.Warnf("Could not shrink volume %v after destroying LUN %v\n%verror: %v", pool, name, response4.Result, err4)
+		}
+	}
+
+	d.ems.Destroyed(name)
+	return nil
+}
+
+// Attach the lun
+func (d *OntapSANEconomyStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
+	log.Debugf("OntapSANEconomyStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
+
+	lun, _, err := d.findLun(name)
+	if err != nil {
+		return err
+	}
+	if lun == nil {
+		return fmt.Errorf("Volume %v does not exist", name)
+	}
+
+	// format with the filesystem requested at create time
+	volumeOpts, err := d.GetVolumeOpts(name)
+	if err != nil {
+		return fmt.Errorf("Problem reading options for volume: %v error: %v", name, err)
+	}
+	fsType := utils.GetV(volumeOpts, "fstype", DefaultFileSystemType)
+
+	if err := AttachOntapLun(name, lun.Path(), mountpoint, fsType, d.Config, d.API); err != nil {
+		return err
+	}
+
+	d.ems.Attached(name)
+	return nil
+}
+
+// Detach the volume
+func (d *OntapSANEconomyStorageDriver) Detach(name, mountpoint string) error {
+	log.Debugf("OntapSANEconomyStorageDriver#Detach(%v, %v)", name, mountpoint)
+
+	if err := UnmountOntapVolume(name, mountpoint); err != nil {
+		return err
+	}
+
+	d.ems.Detached(name)
+	return nil
+}
+
+// DefaultStoragePrefix is the driver specific prefix for created storage, can be overridden in the config file
+func (d *OntapSANEconomyStorageDriver) DefaultStoragePrefix() string {
+	return "netappdvp_"
+}
+
+// DefaultSnapshotPrefix is the driver specific prefix for created snapshots, can be overridden in the config file
+func (d *OntapSANEconomyStorageDriver) DefaultSnapshotPrefix() string {
+	return "netappdvp_"
+}
+
+// Return the list of snapshots taken for the named LUN
+func (d *OntapSANEconomyStorageDriver) SnapshotList(name string) ([]CommonSnapshot, error) {
+	log.Debugf("OntapSANEconomyStorageDriver#SnapshotList(%v)", name)
+
+	lun, pool, err := d.findLun(name)
+	if err != nil {
+		return nil, err
+	}
+	if lun == nil {
+		return nil, fmt.Errorf("Volume %v does not exist", name)
+	}
+
+	response, err := d.API.SnapshotGetByVolume(pool)
+	if err != nil {
+		return nil, fmt.Errorf("Error enumerating snapshots: %v", err)
+	}
+
+	var snapshots []CommonSnapshot
+	for _, sit := range response.Result.AttributesList() {
+		if !strings.HasPrefix(sit.Name(), name+ontapLunSnapshotInfix) {
+			continue
+		}
+		t := time.Unix(int64(sit.AccessTime()), 0)
+		// Time format: yyyy-mm-ddThh:mm:ssZ
+		tstr := t.UTC().Format("2006-01-02T15:04:05Z")
+		snapshots = append(snapshots, CommonSnapshot{strings.TrimPrefix(sit.Name(), name+ontapLunSnapshotInfix), tstr})
+	}
+
+	return snapshots, nil
+}
+
+// Return the options the named LUN was created with, as recorded in a LUN attribute
+func (d *OntapSANEconomyStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	lun, _, err := d.findLun(name)
+	if err != nil {
+		return nil, err
+	}
+	if lun == nil {
+		return nil, fmt.Errorf("Volume %v not found", name)
+	}
+
+	response, err := d.API.LunGetAttribute(lun.Path(), ontapLunOptsAttribute)
+	if err != nil {
+		log.Warnf("Could not read options of volume %v: %v", name, err)
+		return make(map[string]string), nil
+	}
+
+	if response.Result.ValuePtr == nil {
+		return make(map[string]string), nil
+	}
+	opts, err := DecodeVolumeOpts(response.Result.Value())
+	if err != nil {
+		log.Warnf("Ignoring attribute of volume %v: %v", name, err)
+	}
+	return opts, nil
+}
+
+// Return the FlexVol holding the named LUN and the LUN it was cloned from
+func (d *OntapSANEconomyStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	lun, pool, err := d.findLun(name)
+	if err != nil {
+		return nil, err
+	}
+	if lun == nil {
+		return nil, fmt.Errorf("Volume %v not found", name)
+	}
+
+	status := make(map[string]interface{})
+	status["OntapiVersion"] = d.Capabilities.Version.String()
+	status["LunVolume"] = pool
+
+	opts, err := d.GetVolumeOpts(name)
+	if err != nil {
+		return nil, err
+	}
+	if parent := opts[CloneSourceOpt]; parent != "" {
+		status["Parent"] = parent
+		status["ParentSnapshot"] = opts[CloneSnapshotOpt]
+	}
+
+	return status, nil
+}
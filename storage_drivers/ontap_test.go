@@ -5,8 +5,16 @@ package storage_drivers
 import (
 	"bytes"
 	"encoding/json"
+	"path"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/netapp/netappdvp/apis/ontap"
+	"github.com/netapp/netappdvp/azgo"
+	"github.com/netapp/netappdvp/utils"
 
 	log "github.com/Sirupsen/logrus"
 )
@@ -133,3 +141,927 @@ func TestOntapSan_Initialize(t *testing.T) {
 		t.Errorf("Exepcted an error for an empty JSON configuration object")
 	}
 }
+
+func TestOntap_ValidateCloneDestroyPolicy(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_ValidateCloneDestroyPolicy...")
+
+	for _, policy := range []string{"", CloneDestroyPolicyRefuse, CloneDestroyPolicySplit, CloneDestroyPolicyDefer} {
+		if err := ValidateCloneDestroyPolicy(policy); err != nil {
+			t.Errorf("Unexpected error for cloneDestroyPolicy %q: %v", policy, err)
+		}
+	}
+
+	if err := ValidateCloneDestroyPolicy("delete"); err == nil {
+		t.Error("Expected an error for an unsupported cloneDestroyPolicy")
+	}
+}
+
+func TestOntap_AggregatePlacement(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_AggregatePlacement...")
+
+	config := OntapStorageDriverConfig{}
+	config.Aggregate = "aggr1"
+	config.Aggregates = []string{"aggr2", "aggr1", "", "aggr3"}
+
+	aggregates := OntapAggregates(config)
+	if strings.Join(aggregates, ",") != "aggr1,aggr2,aggr3" {
+		t.Errorf("Expected aggr1,aggr2,aggr3, got %v", aggregates)
+	}
+
+	next := 0
+	available := map[string]int{"aggr1": 100, "aggr2": 300, "aggr3": 200}
+	if aggr := pickOntapAggregate(aggregates, available, AggregatePolicyMostFree, &next); aggr != "aggr2" {
+		t.Errorf("Expected mostFree to pick aggr2, got %v", aggr)
+	}
+	if aggr := pickOntapAggregate(aggregates, available, "", &next); aggr != "aggr2" {
+		t.Errorf("Expected the default policy to pick aggr2, got %v", aggr)
+	}
+
+	var picked []string
+	for i := 0; i < 4; i++ {
+		picked = append(picked, pickOntapAggregate(aggregates, available, AggregatePolicyRoundRobin, &next))
+	}
+	if strings.Join(picked, ",") != "aggr1,aggr2,aggr3,aggr1" {
+		t.Errorf("Expected roundRobin to cycle through the aggregates, got %v", picked)
+	}
+
+	if err := ValidateAggregatePolicy("random"); err == nil {
+		t.Error("Expected an error for an unsupported aggregatePolicy")
+	}
+}
+
+func TestOntap_MaxThroughput(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_MaxThroughput...")
+
+	tests := []struct {
+		opts     map[string]string
+		expected string
+	}{
+		{map[string]string{}, ""},
+		{map[string]string{"maxIops": "1000"}, "1000iops"},
+		{map[string]string{"maxThroughput": "100"}, "100MB/s"},
+		{map[string]string{"maxThroughput": "1gb/s"}, "1GB/s"},
+		{map[string]string{"maxIops": "500", "maxThroughput": "50MB/s"}, "500iops,50MB/s"},
+	}
+	for _, test := range tests {
+		maxThroughput, err := OntapMaxThroughput(test.opts)
+		if err != nil || maxThroughput != test.expected {
+			t.Errorf("Expected %q for %v, got %q, %v", test.expected, test.opts, maxThroughput, err)
+		}
+	}
+
+	for _, opts := range []map[string]string{
+		{"maxIops": "0"},
+		{"maxIops": "lots"},
+		{"maxThroughput": "fast"},
+		{"qosPolicy": "gold", "maxIops": "1000"},
+	} {
+		if err := ValidateOntapQosOpts(opts, newFakeOntapAPI()); err == nil {
+			t.Errorf("Expected an error for %v", opts)
+		}
+	}
+
+	// a named policy group must exist
+	api := newFakeOntapAPI()
+	if err := ValidateOntapQosOpts(map[string]string{"qosPolicy": "gold"}, api); err == nil {
+		t.Error("Expected an error for a QoS policy group that does not exist")
+	}
+	api.qos["gold"] = "1000iops"
+	if err := ValidateOntapQosOpts(map[string]string{"qosPolicy": "gold"}, api); err != nil {
+		t.Errorf("Unexpected error for an existing QoS policy group: %v", err)
+	}
+}
+
+func TestOntap_GetVolumeProperties(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_GetVolumeProperties...")
+
+	defaults := OntapStorageDriverConfigDefaults{
+		Deduplication:   "true",
+		Compression:     "background",
+		SnapshotReserve: "10",
+	}
+
+	props, err := GetOntapVolumeProperties(map[string]string{"compression": "inline", "autosizeMaximumSize": "1k"}, defaults)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !props.Deduplication || props.Compression != "inline" || props.SnapshotReserve != 10 {
+		t.Errorf("Expected options to override config defaults, got %+v", props)
+	}
+	if props.AutosizeMode != "grow" || props.AutosizeMaximumSize != "1024" {
+		t.Errorf("Expected autosize to grow to 1024 bytes, got %+v", props)
+	}
+
+	props, err = GetOntapVolumeProperties(map[string]string{}, OntapStorageDriverConfigDefaults{})
+	if err != nil || props.Compression != "none" || props.SnapshotReserve != -1 || props.SecurityStyle != "" {
+		t.Errorf("Expected ONTAP defaults when nothing is set, got %+v, %v", props, err)
+	}
+
+	for _, opts := range []map[string]string{
+		{"deduplication": "maybe"},
+		{"compression": "zip"},
+		{"encryption": "yes please"},
+		{"tieringPolicy": "all"},
+		{"snapshotReserve": "95"},
+		{"securityStyle": "windows"},
+		{"autosizeMode": "shrink"},
+		{"autosizeMaximumSize": "big"},
+	} {
+		if _, err := GetOntapVolumeProperties(opts, OntapStorageDriverConfigDefaults{}); err == nil {
+			t.Errorf("Expected an error for %v", opts)
+		}
+	}
+}
+
+func TestOntap_ValidateVolumeCapabilities(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_ValidateVolumeCapabilities...")
+
+	ontap91 := ontap.Capabilities{Version: ontap.OntapiVersion{Major: 1, Minor: 110}}
+	ontap83 := ontap.Capabilities{Version: ontap.OntapiVersion{Major: 1, Minor: 30}}
+
+	encrypted := OntapVolumeProperties{Encryption: true}
+	if err := ValidateOntapVolumeCapabilities(encrypted, ontap91); err != nil {
+		t.Errorf("Expected ONTAP 9.1 to support encryption: %v", err)
+	}
+	if err := ValidateOntapVolumeCapabilities(encrypted, ontap83); err == nil {
+		t.Error("Expected encryption to need ONTAP 9.1")
+	}
+
+	tiered := OntapVolumeProperties{TieringPolicy: "snapshot-only"}
+	if err := ValidateOntapVolumeCapabilities(tiered, ontap91); err == nil || !strings.Contains(err.Error(), "tieringPolicy") {
+		t.Errorf("Expected tieringPolicy to need a newer release, got %v", err)
+	}
+
+	if err := ValidateOntapVolumeCapabilities(OntapVolumeProperties{}, ontap83); err != nil {
+		t.Errorf("Expected default properties to be available everywhere: %v", err)
+	}
+}
+
+func TestOntap_EmsRateLimit(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_EmsRateLimit...")
+
+	e := &OntapEms{}
+	start := time.Now()
+	for i := 0; i < ontapEmsEventLimit; i++ {
+		if !e.allow(start.Add(time.Duration(i) * time.Second)) {
+			t.Fatalf("Expected event %v to be allowed", i)
+		}
+	}
+	if e.allow(start.Add(ontapEmsEventLimit * time.Second)) {
+		t.Error("Expected a burst beyond the limit to be dropped")
+	}
+	if e.dropped != 1 {
+		t.Errorf("Expected 1 dropped event, got %v", e.dropped)
+	}
+
+	// the first event has left the window, making room for one more
+	if !e.allow(start.Add(ontapEmsEventWindow)) {
+		t.Error("Expected an event to be allowed once the window has moved on")
+	}
+	if e.allow(start.Add(ontapEmsEventWindow)) {
+		t.Error("Expected the window to be full again")
+	}
+}
+
+func TestOntap_ParseEmsHeartbeatInterval(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_ParseEmsHeartbeatInterval...")
+
+	for interval, expected := range map[string]time.Duration{
+		"":    DefaultEmsHeartbeatInterval,
+		"0":   0,
+		"12h": 12 * time.Hour,
+	} {
+		if d, err := ParseEmsHeartbeatInterval(interval); err != nil || d != expected {
+			t.Errorf("Expected %v for %q, got %v, %v", expected, interval, d, err)
+		}
+	}
+
+	for _, interval := range []string{"daily", "10s", "-1h"} {
+		if _, err := ParseEmsHeartbeatInterval(interval); err == nil {
+			t.Errorf("Expected an error for %q", interval)
+		}
+	}
+}
+
+func TestOntap_StoragePrefix(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_StoragePrefix...")
+
+	for raw, expected := range map[string]string{
+		"":         "netappdvp_",
+		`""`:       "",
+		`"myapp_"`: "myapp_",
+	} {
+		c := CommonStorageDriverConfig{StoragePrefixRaw: json.RawMessage(raw)}
+		if prefix := c.StoragePrefix("netappdvp_"); prefix != expected {
+			t.Errorf("Expected prefix %q for %q, got %q", expected, raw, prefix)
+		}
+	}
+}
+
+func TestOntap_FlexGroupProperties(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_FlexGroupProperties...")
+
+	props, err := GetOntapVolumeProperties(map[string]string{"encryption": "true", "snapshotReserve": "5"},
+		OntapStorageDriverConfigDefaults{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateOntapFlexGroupProperties(props); err != nil {
+		t.Errorf("Expected FlexGroups to take %v: %v", props, err)
+	}
+
+	for _, opts := range []map[string]string{
+		{"deduplication": "true"},
+		{"compression": "inline"},
+		{"autosizeMode": "grow"},
+	} {
+		props, err := GetOntapVolumeProperties(opts, OntapStorageDriverConfigDefaults{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ValidateOntapFlexGroupProperties(props); err == nil {
+			t.Errorf("Expected an error for %v", opts)
+		}
+	}
+}
+
+func TestOntap_SelectFlexGroupAggregates(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_SelectFlexGroupAggregates...")
+
+	config := OntapStorageDriverConfig{Aggregate: "aggr1", Aggregates: []string{"aggr2", "aggr1"}}
+
+	aggregates, err := SelectOntapFlexGroupAggregates(config, nil, "")
+	if err != nil || strings.Join(aggregates, ",") != "aggr1,aggr2" {
+		t.Errorf("Expected the configured aggregates, got %v %v", aggregates, err)
+	}
+
+	aggregates, err = SelectOntapFlexGroupAggregates(config, nil, "aggr3, aggr4")
+	if err != nil || strings.Join(aggregates, ",") != "aggr3,aggr4" {
+		t.Errorf("Expected the aggregates of the option, got %v %v", aggregates, err)
+	}
+}
+
+func TestOntap_CIFSSecurityStyle(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_CIFSSecurityStyle...")
+
+	for style, expected := range map[string]string{"": "ntfs", "ntfs": "ntfs", "mixed": "mixed"} {
+		if got, err := ValidateOntapCIFSSecurityStyle(style); err != nil || got != expected {
+			t.Errorf("Expected %v for %q, got %v %v", expected, style, got, err)
+		}
+	}
+	if _, err := ValidateOntapCIFSSecurityStyle("unix"); err == nil {
+		t.Error("Expected an error for unix security style")
+	}
+}
+
+func TestOntap_ParseLunsPerFlexvol(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_ParseLunsPerFlexvol...")
+
+	for setting, expected := range map[string]int{"": DefaultLunsPerFlexvol, "50": 50, "200": 200} {
+		if limit, err := ParseLunsPerFlexvol(setting); err != nil || limit != expected {
+			t.Errorf("Expected %v for %q, got %v %v", expected, setting, limit, err)
+		}
+	}
+	for _, setting := range []string{"49", "201", "many"} {
+		if _, err := ParseLunsPerFlexvol(setting); err == nil {
+			t.Errorf("Expected an error for %q", setting)
+		}
+	}
+}
+
+func TestOntap_ParseOntapMaxRecords(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_ParseOntapMaxRecords...")
+
+	for setting, expected := range map[string]int{"": ontap.DefaultMaxRecords, "1": 1, "500": 500} {
+		if records, err := ParseOntapMaxRecords(setting); err != nil || records != expected {
+			t.Errorf("Expected %v for %q, got %v %v", expected, setting, records, err)
+		}
+	}
+	for _, setting := range []string{"0", "-5", "all"} {
+		if _, err := ParseOntapMaxRecords(setting); err == nil {
+			t.Errorf("Expected an error for %q", setting)
+		}
+	}
+}
+
+func TestOntap_LunPool(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_LunPool...")
+
+	if growth := OntapLunPoolGrowth(1000, -1); growth != 1052 {
+		t.Errorf("Expected room for the default 5%% snapshot reserve, got %v", growth)
+	}
+	if growth := OntapLunPoolGrowth(1000, 0); growth != 1000 {
+		t.Errorf("Expected no growth without a snapshot reserve, got %v", growth)
+	}
+	if growth := OntapLunPoolGrowth(1000, 20); growth != 1250 {
+		t.Errorf("Expected room for a 20%% snapshot reserve, got %v", growth)
+	}
+
+	pool := map[string]string{"spaceReserve": "none", "encryption": "false", "aggregate": "aggr1"}
+	if !OntapLunPoolMatches(pool, map[string]string{"spaceReserve": "none", "encryption": "false"}, "") {
+		t.Error("Expected matching options to match")
+	}
+	if OntapLunPoolMatches(pool, map[string]string{"spaceReserve": "volume"}, "") {
+		t.Error("Expected different options not to match")
+	}
+	if OntapLunPoolMatches(pool, map[string]string{"spaceReserve": "none"}, "aggr2") {
+		t.Error("Expected a different aggregate not to match")
+	}
+	if name := OntapLunSnapshotName("netappdvp_vol1", "snap1"); name != "netappdvp_vol1_snapshot_snap1" {
+		t.Errorf("Unexpected snapshot name %v", name)
+	}
+}
+
+// fakeOntapAPI keeps the volumes of a single SVM in memory, for driver operations that span several calls.
+// Calls the tests do not need are left to the embedded nil API, which panics.
+type fakeOntapAPI struct {
+	ontap.API
+	m         sync.Mutex
+	volumes   map[string]*fakeOntapVolume
+	luns      map[string]int    // LUN sizes by path
+	jobExists bool              // another host is creating the volume: VolumeCreate creates it without options and fails
+	lunFails  bool              // LunCreate fails, say because the FlexVol is out of space
+	attrFails bool              // LunSetAttribute fails
+	reserve   int               // the snapshot reserve of volumes created without one
+	events    []string          // descriptions of the EMS events logged
+	splits    map[int]string    // clones being split, by job id
+	waited    []string          // clones whose split jobs were waited on
+	stuck     string            // a clone whose split job never ends
+	qos       map[string]string // QoS policy group throughput ceilings by name
+	qosFails  bool              // assigning a volume to a QoS policy group fails
+}
+
+type fakeOntapVolume struct {
+	size    int
+	reserve int
+	comment string
+	parent  string // the volume this one is a clone of, until it is split
+}
+
+func newFakeOntapAPI() *fakeOntapAPI {
+	return &fakeOntapAPI{volumes: make(map[string]*fakeOntapVolume), luns: make(map[string]int),
+		reserve: ontapDefaultSnapshotReserve, splits: make(map[int]string), qos: make(map[string]string)}
+}
+
+func fakeOntapNotFound(api, name string) error {
+	return &ontap.APIError{API: api, Status: "failed", Errno: azgo.EVOLUMEDOESNOTEXIST, Reason: "Volume " + name + " not found"}
+}
+
+func (f *fakeOntapAPI) setComment(name, comment string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.volumes[name].comment = comment
+}
+
+func (f *fakeOntapAPI) VolumeCreate(name, aggregateName, size, spaceReserve, snapshotPolicy, unixPermissions,
+	exportPolicy, securityStyle, tieringPolicy string, snapshotReserve int, encrypt bool) (azgo.VolumeCreateResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	bytes, _ := utils.ConvertSizeToBytes(size)
+	volumeSize, _ := strconv.Atoi(bytes)
+	if snapshotReserve < 0 {
+		snapshotReserve = f.reserve
+	}
+	f.volumes[name] = &fakeOntapVolume{size: volumeSize, reserve: snapshotReserve}
+	if f.jobExists {
+		return azgo.VolumeCreateResponse{}, &ontap.APIError{API: "volume-create", Status: "failed",
+			Errno: azgo.EAPIERROR, Reason: "Job exists"}
+	}
+	return azgo.VolumeCreateResponse{}, nil
+}
+
+func (f *fakeOntapAPI) VolumeSize(name string) (response azgo.VolumeSizeResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	volume, ok := f.volumes[name]
+	if !ok {
+		return response, fakeOntapNotFound("volume-size", name)
+	}
+	response.Result.SetVolumeSize(strconv.Itoa(volume.size))
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeGet(name string) (response azgo.VolumeGetIterResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	attributes := make([]azgo.VolumeAttributesType, 0)
+	for volumeName, volume := range f.volumes {
+		if volumeName != name && !(strings.HasSuffix(name, "*") && strings.HasPrefix(volumeName, strings.TrimSuffix(name, "*"))) {
+			continue
+		}
+		idattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(volumeName)).SetComment(volume.comment)
+		spaceattr := azgo.NewVolumeSpaceAttributesType().SetPercentageSnapshotReserve(volume.reserve)
+		attributes = append(attributes, *azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*idattr).
+			SetVolumeSpaceAttributes(*spaceattr))
+	}
+	response.Result.SetAttributesList(attributes).SetNumRecords(len(attributes))
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeSetComment(name, comment string) (response azgo.VolumeModifyIterResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	volume, ok := f.volumes[name]
+	if !ok {
+		return response, fakeOntapNotFound("volume-modify-iter", name)
+	}
+	volume.comment = comment
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeMount(name, junctionPath string) (azgo.VolumeMountResponse, error) {
+	return azgo.VolumeMountResponse{}, nil
+}
+
+func (f *fakeOntapAPI) VolumeSetSize(name, newSize string) (response azgo.VolumeSizeResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	volume, ok := f.volumes[name]
+	if !ok {
+		return response, fakeOntapNotFound("volume-size", name)
+	}
+	change, _ := strconv.Atoi(strings.TrimPrefix(newSize, "+"))
+	if strings.HasPrefix(newSize, "+") || strings.HasPrefix(newSize, "-") {
+		volume.size += change
+	} else {
+		volume.size = change
+	}
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeDestroy(name string, force bool) (response azgo.VolumeDestroyResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	delete(f.volumes, name)
+	return response, nil
+}
+
+func (f *fakeOntapAPI) LunCreate(lunPath string, sizeInBytes int, osType string,
+	spaceReserved bool) (response azgo.LunCreateBySizeResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if f.lunFails {
+		return response, &ontap.APIError{API: "lun-create-by-size", Status: "failed", Errno: azgo.EAPIERROR,
+			Reason: "Not enough space"}
+	}
+	f.luns[lunPath] = sizeInBytes
+	return response, nil
+}
+
+func (f *fakeOntapAPI) LunGet(lunPath string) (response azgo.LunGetIterResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	luns := make([]azgo.LunInfoType, 0)
+	for p, size := range f.luns {
+		if matched, _ := path.Match(lunPath, p); matched {
+			luns = append(luns, *azgo.NewLunInfoType().SetPath(p).SetVolume(strings.Split(p, "/")[2]).SetSize(size))
+		}
+	}
+	response.Result.SetAttributesList(luns).SetNumRecords(len(luns))
+	return response, nil
+}
+
+func (f *fakeOntapAPI) LunSetAttribute(lunPath, name, value string) (response azgo.LunSetAttributeResponse,
+	err error) {
+	if f.attrFails {
+		return response, &ontap.APIError{API: "lun-set-attribute", Status: "failed", Errno: azgo.EAPIERROR,
+			Reason: "Cannot set attribute"}
+	}
+	return response, nil
+}
+
+func (f *fakeOntapAPI) LunGetAttribute(lunPath, name string) (response azgo.LunGetAttributeResponse, err error) {
+	return response, nil
+}
+
+func (f *fakeOntapAPI) LunDestroy(lunPath string) (response azgo.LunDestroyResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	delete(f.luns, lunPath)
+	return response, nil
+}
+
+func (f *fakeOntapAPI) LunCloneCreate(volumeName, source, destination, snapshot string) (response azgo.CloneCreateResponse,
+	err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.luns[ontapLunPath(volumeName, destination)] = f.luns[ontapLunPath(volumeName, source)]
+	return response, nil
+}
+
+func (f *fakeOntapAPI) EmsAutosupportLog(appVersion string, autoSupport bool, category string, computerName string,
+	eventDescription string, eventID int, eventSource string, logLevel int) (azgo.EmsAutosupportLogResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.events = append(f.events, eventDescription)
+	return azgo.EmsAutosupportLogResponse{}, nil
+}
+
+func (f *fakeOntapAPI) VolumeListClones(parent string) (response azgo.VolumeGetIterResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	attributes := make([]azgo.VolumeAttributesType, 0)
+	for name, volume := range f.volumes {
+		if volume.parent == parent {
+			idattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+			attributes = append(attributes, *azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*idattr))
+		}
+	}
+	response.Result.SetAttributesList(attributes).SetNumRecords(len(attributes))
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeCloneGet(name string) (response azgo.VolumeCloneGetResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	volume, ok := f.volumes[name]
+	if !ok || volume.parent == "" {
+		return response, fakeOntapNotFound("volume-clone-get", name)
+	}
+	response.Result.SetAttributes(*azgo.NewVolumeCloneInfoType().SetParentVolume(volume.parent))
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeCloneSplitStart(name string) (response azgo.VolumeCloneSplitStartResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	jobID := len(f.splits) + 1
+	f.splits[jobID] = name
+	response.Result.SetResultJobid(jobID)
+	return response, nil
+}
+
+// JobGet reports split jobs as succeeded, which is when the clone stops being one
+func (f *fakeOntapAPI) JobGet(jobID int) (response azgo.JobGetIterResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	clone := f.splits[jobID]
+	f.waited = append(f.waited, clone)
+	state := ontap.JobStateSuccess
+	if clone == f.stuck {
+		state = "running"
+	} else if volume, ok := f.volumes[clone]; ok {
+		volume.parent = ""
+	}
+	job := azgo.NewJobInfoType().SetJobId(jobID).SetJobState(state)
+	response.Result.SetAttributesList([]azgo.JobInfoType{*job}).SetNumRecords(1)
+	return response, nil
+}
+
+func (f *fakeOntapAPI) QosPolicyGroupGet(name string) (response azgo.QosPolicyGroupGetIterResponse, err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	policies := make([]azgo.QosPolicyGroupInfoType, 0)
+	if maxThroughput, ok := f.qos[name]; ok {
+		policies = append(policies, *azgo.NewQosPolicyGroupInfoType().SetPolicyGroup(name).SetMaxThroughput(maxThroughput))
+	}
+	response.Result.SetAttributesList(policies).SetNumRecords(len(policies))
+	return response, nil
+}
+
+func (f *fakeOntapAPI) QosPolicyGroupCreate(name, maxThroughput string) (response azgo.QosPolicyGroupCreateResponse,
+	err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.qos[name] = maxThroughput
+	return response, nil
+}
+
+func (f *fakeOntapAPI) QosPolicyGroupDelete(name string, force bool) (response azgo.QosPolicyGroupDeleteResponse,
+	err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	delete(f.qos, name)
+	return response, nil
+}
+
+func (f *fakeOntapAPI) VolumeSetQosPolicyGroupName(name, policyGroup string) (response azgo.VolumeModifyIterResponse,
+	err error) {
+	if f.qosFails {
+		return response, &ontap.APIError{API: "volume-modify-iter", Status: "failed", Errno: azgo.EAPIERROR,
+			Reason: "Cannot assign QoS policy group"}
+	}
+	return response, nil
+}
+
+func (f *fakeOntapAPI) JobGetByDescription(description string) (response azgo.JobGetIterResponse, err error) {
+	response.Result.SetAttributesList([]azgo.JobInfoType{}).SetNumRecords(0)
+	return response, nil
+}
+
+func TestOntapNas_CreateWaitsForOtherHost(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapNas_CreateWaitsForOtherHost...")
+
+	defer func(interval, timeout time.Duration) {
+		ontapCreatePollInterval, ontapCreateTimeout = interval, timeout
+	}(ontapCreatePollInterval, ontapCreateTimeout)
+	ontapCreatePollInterval = 10 * time.Millisecond
+
+	api := newFakeOntapAPI()
+	api.jobExists = true
+	d := &OntapNASStorageDriver{API: api}
+
+	// the other host's create job has completed, but it has not yet finished setting the volume up
+	done := make(chan error, 1)
+	go func() { done <- d.Create("netappdvp_vol1", map[string]string{"aggregate": "aggr1"}) }()
+	select {
+	case err := <-done:
+		t.Fatalf("Expected Create to wait for the other host, got %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	api.setComment("netappdvp_vol1", `{"size":"1g"}`)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected Create to succeed once the other host finished, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected Create to return once the other host finished")
+	}
+
+	// a host that never finishes leaves the volume unusable
+	ontapCreateTimeout = 50 * time.Millisecond
+	if err := d.Create("netappdvp_vol2", map[string]string{"aggregate": "aggr1"}); err == nil {
+		t.Error("Expected Create to time out waiting for the other host")
+	}
+}
+
+func TestOntapNas_CreateRefusesLongOptions(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapNas_CreateRefusesLongOptions...")
+
+	api := newFakeOntapAPI()
+	d := &OntapNASStorageDriver{API: api}
+
+	// options that do not fit in the volume comment are refused before the volume is created
+	opts := map[string]string{"aggregate": "aggr1", "label": strings.Repeat("x", ontapMaxCommentLength)}
+	if err := d.Create("netappdvp_vol1", opts); err == nil {
+		t.Error("Expected an error for options that do not fit in the volume comment")
+	}
+	if _, ok := api.volumes["netappdvp_vol1"]; ok {
+		t.Error("Expected no volume to be created for options that do not fit in the volume comment")
+	}
+
+	if _, err := EncodeOntapVolumeOpts("netappdvp_vol1", map[string]string{"aggregate": "aggr1"}); err != nil {
+		t.Errorf("Unexpected error encoding options that fit: %v", err)
+	}
+}
+
+func TestOntapSanEconomy_CreateReleasesPool(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapSanEconomy_CreateReleasesPool...")
+
+	api := newFakeOntapAPI()
+	d := &OntapSANEconomyStorageDriver{API: api, lunsPerFlexvol: DefaultLunsPerFlexvol}
+	opts := map[string]string{"aggregate": "aggr1", "size": "1g"}
+
+	// a LUN that cannot be created leaves no empty FlexVol behind
+	api.lunFails = true
+	if err := d.Create("vol1", opts); err == nil {
+		t.Fatal("Expected an error creating a LUN")
+	}
+	if len(api.volumes) != 0 {
+		t.Errorf("Expected the new FlexVol to be destroyed, found %v", len(api.volumes))
+	}
+
+	api.lunFails = false
+	if err := d.Create("vol1", opts); err != nil {
+		t.Fatalf("Unexpected error creating a LUN: %v", err)
+	}
+	if len(api.volumes) != 1 {
+		t.Fatalf("Expected one FlexVol, found %v", len(api.volumes))
+	}
+	var pool *fakeOntapVolume
+	for _, volume := range api.volumes {
+		pool = volume
+	}
+	size := pool.size
+
+	// nor does it leave an existing FlexVol grown
+	api.lunFails = true
+	if err := d.Create("vol2", opts); err == nil {
+		t.Fatal("Expected an error creating a LUN")
+	}
+	if len(api.volumes) != 1 || pool.size != size {
+		t.Errorf("Expected the FlexVol to be shrunk back to %v, got %v in %v FlexVols", size, pool.size, len(api.volumes))
+	}
+}
+
+func TestOntapSanEconomy_CreateDestroysLunWithoutOpts(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapSanEconomy_CreateDestroysLunWithoutOpts...")
+
+	api := newFakeOntapAPI()
+	d := &OntapSANEconomyStorageDriver{API: api, lunsPerFlexvol: DefaultLunsPerFlexvol}
+	opts := map[string]string{"aggregate": "aggr1", "size": "1g", "fstype": "xfs"}
+
+	// a LUN whose options cannot be recorded is destroyed, along with the FlexVol created for it
+	api.attrFails = true
+	if err := d.Create("vol1", opts); err == nil {
+		t.Fatal("Expected an error setting the LUN options")
+	}
+	if len(api.luns) != 0 || len(api.volumes) != 0 {
+		t.Errorf("Expected no LUN or FlexVol to remain, found %v and %v", api.luns, api.volumes)
+	}
+
+	api.attrFails = false
+	if err := d.Create("vol1", opts); err != nil {
+		t.Fatalf("Unexpected error creating a LUN: %v", err)
+	}
+	var pool *fakeOntapVolume
+	for _, volume := range api.volumes {
+		pool = volume
+	}
+	size := pool.size
+
+	// in a shared FlexVol, the LUN and the room made for it go
+	api.attrFails = true
+	if err := d.Create("vol2", opts); err == nil {
+		t.Fatal("Expected an error setting the LUN options")
+	}
+	if err := d.CreateClone("vol3", "vol1", "snap1", ""); err == nil {
+		t.Fatal("Expected an error setting the LUN clone options")
+	}
+	if len(api.luns) != 1 || len(api.volumes) != 1 || pool.size != size {
+		t.Errorf("Expected only the first LUN in a FlexVol of %v, found %v and a FlexVol of %v", size, api.luns, pool.size)
+	}
+}
+
+func TestOntapSanEconomy_PoolGrowthFollowsReserve(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapSanEconomy_PoolGrowthFollowsReserve...")
+
+	api := newFakeOntapAPI()
+	d := &OntapSANEconomyStorageDriver{API: api, lunsPerFlexvol: DefaultLunsPerFlexvol}
+	lunSize := 1000000000
+
+	// a FlexVol whose reserve is left to ONTAP is sized for the reserve it actually got
+	api.reserve = 10
+	if err := d.Create("vol1", map[string]string{"aggregate": "aggr1", "size": "1000000000"}); err != nil {
+		t.Fatalf("Unexpected error creating a LUN: %v", err)
+	}
+	var pool *fakeOntapVolume
+	for _, volume := range api.volumes {
+		pool = volume
+	}
+	if expected := OntapLunPoolGrowth(lunSize, 10); pool.size != expected {
+		t.Errorf("Expected a FlexVol of %v for a 10%% reserve, got %v", expected, pool.size)
+	}
+
+	// and grows by as much for each LUN added to it
+	if err := d.Create("vol2", map[string]string{"aggregate": "aggr1", "size": "1000000000"}); err != nil {
+		t.Fatalf("Unexpected error creating a LUN: %v", err)
+	}
+	if expected := 2 * OntapLunPoolGrowth(lunSize, 10); len(api.volumes) != 1 || pool.size != expected {
+		t.Errorf("Expected one FlexVol of %v, got %v in %v FlexVols", expected, pool.size, len(api.volumes))
+	}
+}
+
+func TestOntapSanEconomy_EmsHeartbeatCountsLuns(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapSanEconomy_EmsHeartbeatCountsLuns...")
+
+	api := newFakeOntapAPI()
+	d := &OntapSANEconomyStorageDriver{API: api, lunsPerFlexvol: DefaultLunsPerFlexvol}
+	for _, name := range []string{"vol1", "vol2", "vol3"} {
+		if err := d.Create(name, map[string]string{"aggregate": "aggr1"}); err != nil {
+			t.Fatalf("Unexpected error creating a LUN: %v", err)
+		}
+	}
+
+	// the three LUNs share one FlexVol, and it is the LUNs that are the volumes
+	e := NewOntapEms(d.Name(), api, d.Config.StoragePrefix(d.DefaultStoragePrefix()), d.lunTotal)
+	e.heartbeat()
+	if len(api.events) != 1 || !strings.Contains(api.events[0], "has 3 volumes") {
+		t.Errorf("Expected a heartbeat counting 3 volumes, got %v", api.events)
+	}
+
+	// drivers with a FlexVol per volume count the FlexVols
+	api.events = nil
+	e = NewOntapEms(OntapNASStorageDriverName, api, "netappdvp_", OntapFlexvolCounter(api, "netappdvp_"))
+	e.heartbeat()
+	if len(api.events) != 1 || !strings.Contains(api.events[0], "has 1 volumes") {
+		t.Errorf("Expected a heartbeat counting 1 volume, got %v", api.events)
+	}
+}
+
+func TestOntap_CloneDestroyPolicySplit(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_CloneDestroyPolicySplit...")
+
+	api := newFakeOntapAPI()
+	api.volumes["parent"] = &fakeOntapVolume{}
+	api.volumes["clone1"] = &fakeOntapVolume{parent: "parent"}
+	api.volumes["clone2"] = &fakeOntapVolume{parent: "parent"}
+
+	// each clone is split, and the splits are waited on before the parent may go
+	destroy, err := ApplyOntapCloneDestroyPolicy("parent", CloneDestroyPolicySplit, api)
+	if err != nil || !destroy {
+		t.Fatalf("Expected the parent to be destroyed after splitting its clones, got %v %v", destroy, err)
+	}
+	if len(api.splits) != 2 || len(api.waited) != 2 {
+		t.Errorf("Expected 2 splits started and waited on, got %v and %v", api.splits, api.waited)
+	}
+	if clones, _ := GetOntapClones("parent", api); len(clones) != 0 {
+		t.Errorf("Expected no clones left, got %v", clones)
+	}
+
+	// a split that outlasts its timeout does not stop the others, and is named in the error
+	defer func(timeout time.Duration) { ontapCloneSplitTimeout = timeout }(ontapCloneSplitTimeout)
+	ontapCloneSplitTimeout = 0
+	api.volumes["clone3"] = &fakeOntapVolume{parent: "parent"}
+	api.volumes["clone4"] = &fakeOntapVolume{parent: "parent"}
+	api.stuck = "clone3"
+	destroy, err = ApplyOntapCloneDestroyPolicy("parent", CloneDestroyPolicySplit, api)
+	if err == nil || destroy || !strings.Contains(err.Error(), "clone3") || strings.Contains(err.Error(), "clone4") {
+		t.Errorf("Expected a timeout naming only clone3, got %v %v", destroy, err)
+	}
+	if api.volumes["clone4"].parent != "" {
+		t.Error("Expected clone4 to be split")
+	}
+}
+
+func TestOntap_CloneDestroyPolicyDefer(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntap_CloneDestroyPolicyDefer...")
+
+	api := newFakeOntapAPI()
+	api.volumes["grandparent"] = &fakeOntapVolume{comment: "{}"}
+	api.volumes["parent"] = &fakeOntapVolume{comment: "{}", parent: "grandparent"}
+	api.volumes["clone1"] = &fakeOntapVolume{comment: "{}", parent: "parent"}
+
+	// removing volumes with clones only marks them
+	for _, name := range []string{"grandparent", "parent"} {
+		destroy, err := ApplyOntapCloneDestroyPolicy(name, CloneDestroyPolicyDefer, api)
+		if err != nil || destroy {
+			t.Fatalf("Expected the destruction of %v to be deferred, got %v %v", name, destroy, err)
+		}
+		if !IsOntapVolumeDeletePending(name, api) {
+			t.Errorf("Expected %v to be pending deletion", name)
+		}
+	}
+
+	// they stay while they have clones
+	DestroyDeferredOntapParent("parent", api)
+	if _, ok := api.volumes["parent"]; !ok {
+		t.Fatal("Expected a volume with clones to remain")
+	}
+
+	// and go, along with their own deferred parents, once the last clone is destroyed
+	delete(api.volumes, "clone1")
+	DestroyDeferredOntapParent("parent", api)
+	if len(api.volumes) != 0 {
+		t.Errorf("Expected the deferred volumes to be destroyed, found %v", api.volumes)
+	}
+
+	// volumes that were not removed are left alone
+	api.volumes["other"] = &fakeOntapVolume{comment: "{}"}
+	DestroyDeferredOntapParent("other", api)
+	if _, ok := api.volumes["other"]; !ok {
+		t.Error("Expected a volume that is not pending deletion to remain")
+	}
+}
+
+func TestOntapNas_CreateQosFailures(t *testing.T) {
+	log.Debug("Running storage_drivers.TestOntapNas_CreateQosFailures...")
+
+	api := newFakeOntapAPI()
+	d := &OntapNASStorageDriver{API: api}
+
+	// a mistyped policy group name is refused before the volume is created
+	if err := d.Create("netappdvp_vol1", map[string]string{"aggregate": "aggr1", "qosPolicy": "golld"}); err == nil {
+		t.Fatal("Expected an error for a QoS policy group that does not exist")
+	}
+	if _, ok := api.volumes["netappdvp_vol1"]; ok {
+		t.Error("Expected no volume to be created for a QoS policy group that does not exist")
+	}
+
+	// a volume that cannot be given its QoS is destroyed, along with the policy group created for it
+	api.qosFails = true
+	if err := d.Create("netappdvp_vol1", map[string]string{"aggregate": "aggr1", "maxIops": "1000"}); err == nil {
+		t.Fatal("Expected an error assigning the QoS policy group")
+	}
+	if len(api.volumes) != 0 || len(api.qos) != 0 {
+		t.Errorf("Expected the volume and its policy group to be destroyed, found %v and %v", api.volumes, api.qos)
+	}
+
+	api.qosFails = false
+	if err := d.Create("netappdvp_vol1", map[string]string{"aggregate": "aggr1", "maxIops": "1000"}); err != nil {
+		t.Fatalf("Unexpected error creating a volume: %v", err)
+	}
+	if api.qos[ontapAutoQosPolicyName("netappdvp_vol1")] != "1000iops" || api.volumes["netappdvp_vol1"].comment == "" {
+		t.Errorf("Expected a volume with its QoS policy group and options, found %v and %v", api.volumes, api.qos)
+	}
+}
@@ -5,8 +5,10 @@ package storage_drivers
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/units"
 	"github.com/netapp/netappdvp/apis/sfapi"
@@ -37,10 +39,90 @@ func formatOpts(opts map[string]string) {
 			opts["type"] = v
 		} else if strings.EqualFold(k, "qos") {
 			opts["qos"] = v
+		} else if strings.EqualFold(k, "fstype") {
+			opts["fstype"] = v
+		} else if strings.EqualFold(k, "access") {
+			opts["access"] = v
+		} else if strings.EqualFold(k, "enable512e") {
+			opts["enable512e"] = v
+		} else if strings.EqualFold(k, "replicateTo") {
+			opts["replicateTo"] = v
+		} else if strings.EqualFold(k, "restore") {
+			opts["restore"] = v
+		} else if strings.EqualFold(k, "group") {
+			opts["group"] = v
 		}
 	}
 }
 
+// ValidateSolidfireAccess checks the access option is one of the modes a Docker user may set on a volume
+func ValidateSolidfireAccess(access string) error {
+	switch access {
+	case "readWrite", "readOnly", "locked":
+		return nil
+	}
+	return fmt.Errorf("Unsupported access mode: %v, expected readWrite, readOnly or locked", access)
+}
+
+// Limits on the size of a SolidFire volume
+const (
+	MinimumSolidfireVolumeSize = int64(1000000000)     // 1GB
+	MaximumSolidfireVolumeSize = int64(17592186044416) // 16TiB
+)
+
+// ParseSolidfireSize converts the size option to bytes.  A bare number is GiB, as it was before units were
+// accepted; a number with a unit, such as 500m or 2t, is converted as for the ONTAP drivers.
+func ParseSolidfireSize(size string) (int64, error) {
+	var bytes int64
+
+	size = strings.TrimSpace(size)
+	if gib, err := strconv.ParseInt(size, 10, 64); err == nil {
+		if gib > MaximumSolidfireVolumeSize/int64(units.GiB) {
+			return 0, fmt.Errorf("Size %v is larger than the SolidFire maximum of %v bytes", size,
+				MaximumSolidfireVolumeSize)
+		}
+		bytes = gib * int64(units.GiB)
+	} else {
+		converted, err := utils.ConvertSizeToBytes64(size)
+		if err != nil {
+			return 0, fmt.Errorf("Invalid size option: %v", size)
+		}
+		if bytes, err = strconv.ParseInt(converted, 10, 64); err != nil {
+			return 0, fmt.Errorf("Invalid size option: %v", size)
+		}
+	}
+
+	if bytes < MinimumSolidfireVolumeSize {
+		return 0, fmt.Errorf("Size %v is smaller than the SolidFire minimum of %v bytes", size,
+			MinimumSolidfireVolumeSize)
+	}
+	if bytes > MaximumSolidfireVolumeSize {
+		return 0, fmt.Errorf("Size %v is larger than the SolidFire maximum of %v bytes", size,
+			MaximumSolidfireVolumeSize)
+	}
+	return bytes, nil
+}
+
+// ParseSolidfireQoS parses the qos option, given as "minIOPS,maxIOPS,burstIOPS"
+func ParseSolidfireQoS(qosOpt string) (sfapi.QoS, error) {
+	var qos sfapi.QoS
+
+	iops := strings.Split(qosOpt, ",")
+	if len(iops) != 3 {
+		return qos, fmt.Errorf("Invalid qos option: %v, expected minIOPS,maxIOPS,burstIOPS", qosOpt)
+	}
+	values := make([]int64, len(iops))
+	for i, value := range iops {
+		iop, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || iop <= 0 {
+			return qos, fmt.Errorf("Invalid qos option: %v, IOPS must be positive integers", qosOpt)
+		}
+		values[i] = iop
+	}
+	qos.MinIOPS, qos.MaxIOPS, qos.BurstIOPS = values[0], values[1], values[2]
+	return qos, nil
+}
+
 // SolidfireSANStorageDriver is for iSCSI storage provisioning
 type SolidfireSANStorageDriver struct {
 	Initialized    bool
@@ -79,51 +161,65 @@ func (d *SolidfireSANStorageDriver) Initialize(configJSON string) error {
 	}).Debugf("Reparsed into solidfireConfig")
 
 	c.DefaultVolSz = c.DefaultVolSz * int64(units.GiB)
-	log.Debugf("Decoded to %v", c)
+	log.WithFields(log.Fields{
+		"TenantName":   c.TenantName,
+		"MVIP":         c.MVIP,
+		"SVIP":         c.SVIP,
+		"APIVersion":   c.APIVersion,
+		"DefaultVolSz": c.DefaultVolSz,
+		"UseVAG":       c.UseVAG,
+	}).Debugf("Decoded solidfireConfig")
 	d.Config = *c
 
+	validationErr := d.Validate()
+	if validationErr != nil {
+		return fmt.Errorf("Problem validating SolidfireSANStorageDriver error: %v", validationErr)
+	}
+
 	var tenantID int64
 
 	// create a new sfapi.Config object from the read in json config file
-	endpoint := c.EndPoint
+	endpoint, err := SolidfireEndpoint(c)
+	if err != nil {
+		return err
+	}
+	c.EndPoint = endpoint
+	d.Config.EndPoint = endpoint
 	defaultSizeGiB := c.DefaultVolSz
 	svip := c.SVIP
 	cfg := sfapi.Config{
 		TenantName:     c.TenantName,
-		EndPoint:       c.EndPoint,
+		EndPoint:       endpoint,
 		DefaultVolSz:   c.DefaultVolSz,
 		SVIP:           c.SVIP,
 		InitiatorIFace: c.InitiatorIFace,
 		Types:          c.Types,
+		UseVAG:         c.UseVAG,
+		VerifyTLS:      c.VerifyTLS,
+		Timeout:        time.Duration(c.APITimeout) * time.Second,
 	}
 	defaultTenantName := c.TenantName
 
 	log.WithFields(log.Fields{
-		"endpoint":          endpoint,
+		"endpoint":          sfapi.RedactEndpoint(endpoint),
 		"defaultSizeGiB":    defaultSizeGiB,
 		"svip":              svip,
-		"cfg":               cfg,
 		"defaultTenantName": defaultTenantName,
 	}).Debug("About to call NewFromParameters")
 
 	// creaet a new sfapi.Client object for interacting with the SolidFire storage system
-	client, _ := sfapi.NewFromParameters(endpoint, defaultSizeGiB, svip, cfg, defaultTenantName)
-	req := sfapi.GetAccountByNameRequest{
-		Name: c.TenantName,
+	client, err := sfapi.NewFromParameters(endpoint, defaultSizeGiB, svip, cfg, defaultTenantName)
+	if err != nil {
+		return fmt.Errorf("Problem creating SolidFire client: %v", err)
+	}
+	if err := checkSolidfireCluster(client, apiVersion(endpoint)); err != nil {
+		return err
 	}
 
 	// lookup the specified account; if not found, dynamically create it
-	account, err := client.GetAccountByName(&req)
+	tenantID, err = solidfireAccountID(client, c.TenantName)
 	if err != nil {
-		req := sfapi.AddAccountRequest{
-			Username: c.TenantName,
-		}
-		tenantID, err = client.AddAccount(&req)
-		if err != nil {
-			log.Fatal("Failed to initialize solidfire driver while creating tenant: ", err)
-		}
-	} else {
-		tenantID = account.AccountID
+		return fmt.Errorf("Failed to initialize solidfire driver while creating tenant: %v", err)
 	}
 
 	iscsiInterface := "default"
@@ -150,9 +246,10 @@ func (d *SolidfireSANStorageDriver) Initialize(configJSON string) error {
 		"InitiatorIFace": iscsiInterface,
 	}).Debug("Driver initialized with the following settings")
 
-	validationErr := d.Validate()
-	if validationErr != nil {
-		return fmt.Errorf("Problem validating SolidfireSANStorageDriver error: %v", validationErr)
+	if c.UseVAG {
+		if err := d.initializeVAG(); err != nil {
+			return fmt.Errorf("Problem initializing volume access group: %v", err)
+		}
 	}
 
 	// log an informational message when this plugin starts
@@ -170,16 +267,19 @@ func (d *SolidfireSANStorageDriver) Validate() error {
 
 	// We want to verify we have everything we need to run the Docker driver
 	if d.Config.TenantName == "" {
-		log.Fatal("TenantName required in SolidFire Docker config")
+		return fmt.Errorf("TenantName required in SolidFire Docker config")
 	}
-	if d.Config.EndPoint == "" {
-		log.Fatal("EndPoint required in SolidFire Docker config")
+	if _, err := SolidfireEndpoint(&d.Config); err != nil {
+		return err
 	}
 	if d.Config.DefaultVolSz == 0 {
-		log.Fatal("DefaultVolSz required in SolidFire Docker config")
+		return fmt.Errorf("DefaultVolSz required in SolidFire Docker config")
+	}
+	if d.Config.DefaultVolSz > MaximumSolidfireVolumeSize {
+		return fmt.Errorf("DefaultVolSz is larger than the SolidFire maximum of %v bytes", MaximumSolidfireVolumeSize)
 	}
 	if d.Config.SVIP == "" {
-		log.Fatal("SVIP required in SolidFire Docker config")
+		return fmt.Errorf("SVIP required in SolidFire Docker config")
 	}
 
 	// Validate the environment
@@ -198,22 +298,29 @@ func (d *SolidfireSANStorageDriver) Create(name string, opts map[string]string)
 	var req sfapi.CreateVolumeRequest
 	var qos sfapi.QoS
 	var vsz int64
-	var meta = map[string]string{"platform": "Docker-NDVP"}
 
 	log.Debugf("GetVolumeByName: %s, %d", name, d.TenantID)
 	log.Debugf("Options passed in to create: %+v", opts)
+	formatOpts(opts)
+	log.Debugf("Options after conversion: %+v", opts)
+
 	v, err := d.Client.GetVolumeByName(name, d.TenantID)
 	if err == nil && v.VolumeID != 0 {
+		// Docker repeats the create on every host that does not know the volume yet, so the options are not
+		// applied again; existing volumes are changed with the modify command instead
 		log.Infof("Found existing Volume by name: %s", name)
 		return nil
 	}
 
-	formatOpts(opts)
-	log.Debugf("Options after conversion: %+v", opts)
+	if restore, _ := strconv.ParseBool(opts["restore"]); restore {
+		return d.Restore(name)
+	}
 	if opts["size"] != "" {
-		s, _ := strconv.ParseInt(opts["size"], 10, 64)
-		log.Info("Received size request in Create: ", s)
-		vsz = int64(units.GiB) * s
+		vsz, err = ParseSolidfireSize(opts["size"])
+		if err != nil {
+			return err
+		}
+		log.Info("Received size request in Create: ", vsz)
 	} else {
 		// NOTE(jdg): We need to cleanup the conversions and such when we read
 		// in from the config file, it's sort of ugly.  BUT, just remember that
@@ -224,35 +331,192 @@ func (d *SolidfireSANStorageDriver) Create(name string, opts map[string]string)
 	}
 
 	if opts["qos"] != "" {
-		iops := strings.Split(opts["qos"], ",")
-		qos.MinIOPS, _ = strconv.ParseInt(iops[0], 10, 64)
-		qos.MaxIOPS, _ = strconv.ParseInt(iops[1], 10, 64)
-		qos.BurstIOPS, _ = strconv.ParseInt(iops[2], 10, 64)
+		qos, err = ParseSolidfireQoS(opts["qos"])
+		if err != nil {
+			return err
+		}
 		req.Qos = qos
 		log.Infof("Received qos opts in Create: %+v", req.Qos)
 	}
 
+	fsType := utils.GetV(opts, "fstype", DefaultFileSystemType)
+	if err := ValidateFileSystemType(fsType); err != nil {
+		return err
+	}
+
 	if opts["type"] != "" {
-		for _, t := range *d.Client.VolumeTypes {
-			if strings.EqualFold(t.Type, opts["type"]) {
-				req.Qos = t.QOS
-				log.Infof("Received Type opts in Create and set QoS: %+v", req.Qos)
-				break
-			}
+		req.Qos, err = d.typeQoS(opts["type"])
+		if err != nil {
+			return err
+		}
+		log.Infof("Received Type opts in Create and set QoS: %+v", req.Qos)
+	}
+
+	access := opts["access"]
+	if access != "" {
+		if err := ValidateSolidfireAccess(access); err != nil {
+			return err
+		}
+	}
+
+	if opts["enable512e"] != "" {
+		if req.Enable512e, err = strconv.ParseBool(opts["enable512e"]); err != nil {
+			return fmt.Errorf("Invalid enable512e option: %v", opts["enable512e"])
 		}
 	}
 
+	var replicationTarget *SolidfireReplicationTarget
+	if opts["replicateTo"] != "" {
+		if replicationTarget, err = d.replicationTarget(opts["replicateTo"]); err != nil {
+			return err
+		}
+	}
+
+	// remember the options used so they are available after a restart or on another host
+	effectiveOpts := utils.CopyOpts(opts)
+	if opts["size"] == "" {
+		effectiveOpts["size"] = strconv.FormatInt(vsz/int64(units.GiB), 10)
+	}
+	effectiveOpts["fstype"] = fsType
+
 	req.TotalSize = vsz
 	req.AccountID = d.TenantID
 	req.Name = name
-	req.Attributes = meta
-	_, err = d.Client.CreateVolume(&req)
+	req.Attributes = volumeAttributes(effectiveOpts)
+	v, err = d.Client.CreateVolume(&req)
 	if err != nil {
 		return err
 	}
+
+	// new volumes are always readWrite, other access modes are applied afterwards
+	if access != "" && access != v.Access {
+		modifyReq := sfapi.ModifyVolumeRequest{
+			VolumeID: v.VolumeID,
+			Access:   access,
+		}
+		if err := d.Client.ModifyVolume(&modifyReq); err != nil {
+			// don't leave a writable volume behind for a retried create to accept
+			d.Client.DeleteVolume(v.VolumeID)
+			return fmt.Errorf("Problem setting access mode %v on volume %v: %v", access, name, err)
+		}
+	}
+
+	if replicationTarget != nil {
+		if err := d.replicate(v, replicationTarget); err != nil {
+			d.Client.DeleteVolume(v.VolumeID)
+			return fmt.Errorf("Problem replicating volume %v to %v: %v", name, replicationTarget.Name, err)
+		}
+	}
+	return d.addToVAG(v)
+}
+
+// Modify changes the QoS, type, size or access mode of an existing volume to match the supplied options
+func (d *SolidfireSANStorageDriver) Modify(name string, opts map[string]string) error {
+	log.Debugf("SolidfireSANStorageDriver#Modify(%v, %v)", name, opts)
+
+	for k := range opts {
+		switch strings.ToLower(k) {
+		case "qos", "type", "size", "access":
+		default:
+			return fmt.Errorf("The %v option cannot be modified, expected qos, type, size or access", k)
+		}
+	}
+	formatOpts(opts)
+
+	v, err := d.Client.GetVolumeByName(name, d.TenantID)
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve volume by name in modify operation; name: %v error: %v", name, err)
+	}
+	return d.modifyVolume(v, opts)
+}
+
+// modifyVolume applies the qos, type, size and access options to the volume with ModifyVolume and records
+// the new values with the options it was created with; options that match the volume already are skipped
+func (d *SolidfireSANStorageDriver) modifyVolume(v sfapi.Volume, opts map[string]string) error {
+	req := sfapi.ModifyVolumeRequest{VolumeID: v.VolumeID}
+	volumeOpts := volumeOptsFromAttributes(v.Attributes)
+	changed := false
+
+	if opts["qos"] != "" {
+		qos, err := ParseSolidfireQoS(opts["qos"])
+		if err != nil {
+			return err
+		}
+		if qos != v.Qos {
+			req.Qos = &qos
+			changed = true
+		}
+		volumeOpts["qos"] = opts["qos"]
+	}
+
+	if opts["type"] != "" {
+		qos, err := d.typeQoS(opts["type"])
+		if err != nil {
+			return err
+		}
+		if qos != v.Qos {
+			req.Qos = &qos
+			changed = true
+		}
+		volumeOpts["type"] = opts["type"]
+	}
+
+	if opts["size"] != "" {
+		totalSize, err := ParseSolidfireSize(opts["size"])
+		if err != nil {
+			return err
+		}
+		if totalSize < v.TotalSize {
+			return fmt.Errorf("Cannot shrink volume %v from %v to %v bytes", v.Name, v.TotalSize, totalSize)
+		}
+		if totalSize > v.TotalSize {
+			req.TotalSize = totalSize
+			changed = true
+		}
+		volumeOpts["size"] = opts["size"]
+	}
+
+	if opts["access"] != "" {
+		if err := ValidateSolidfireAccess(opts["access"]); err != nil {
+			return err
+		}
+		if opts["access"] != v.Access {
+			req.Access = opts["access"]
+			changed = true
+		}
+		volumeOpts["access"] = opts["access"]
+	}
+
+	if !changed {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"volume":    v.Name,
+		"qos":       req.Qos,
+		"totalSize": req.TotalSize,
+		"access":    req.Access,
+	}).Info("Modifying volume")
+
+	req.Attributes = volumeAttributes(volumeOpts)
+	if err := d.Client.ModifyVolume(&req); err != nil {
+		return fmt.Errorf("Problem modifying volume %v: %v", v.Name, err)
+	}
 	return nil
 }
 
+// typeQoS returns the QoS of the named entry in the configured Types
+func (d *SolidfireSANStorageDriver) typeQoS(volType string) (sfapi.QoS, error) {
+	if d.Client.VolumeTypes != nil {
+		for _, t := range *d.Client.VolumeTypes {
+			if strings.EqualFold(t.Type, volType) {
+				return t.QOS, nil
+			}
+		}
+	}
+	return sfapi.QoS{}, fmt.Errorf("Volume type %v is not defined in the configured Types", volType)
+}
+
 // Create a volume clone
 func (d *SolidfireSANStorageDriver) CreateClone(name, source, snapshot, newSnapshotPrefix string) error {
 	log.Debugf("SolidfireSANStorageDriver#CreateClone(%v, %v, %v, %v)", name, source, snapshot, newSnapshotPrefix)
@@ -266,7 +530,13 @@ func (d *SolidfireSANStorageDriver) CreateClone(name, source, snapshot, newSnaps
 		return nil
 	}
 
-	// If a snapshot was specified, use that
+	// Get the volume ID for the source volume
+	v, err = d.Client.GetVolumeByName(source, d.TenantID)
+	if err != nil || v.VolumeID == 0 {
+		return fmt.Errorf("Failed to find source volume: error: %v", err)
+	}
+
+	// If a snapshot was specified, use that; the members of a group snapshot carry the group's name
 	if snapshot != "" {
 		s, err := d.Client.GetSnapshot(0, v.VolumeID, snapshot)
 		if err != nil || s.SnapshotID == 0 {
@@ -275,20 +545,17 @@ func (d *SolidfireSANStorageDriver) CreateClone(name, source, snapshot, newSnaps
 		req.SnapshotID = s.SnapshotID
 	}
 
-	// Get the volume ID for the source volume
-	v, err = d.Client.GetVolumeByName(source, d.TenantID)
-	if err != nil || v.VolumeID == 0 {
-		return fmt.Errorf("Failed to find source volume: error: %v", err)
-	}
-
-	// Create the clone of the source volume with the name specified
+	// Create the clone of the source volume with the name specified; it does not join the source's group
+	cloneOpts := CloneVolumeOpts(volumeOptsFromAttributes(v.Attributes), source, snapshot)
+	delete(cloneOpts, "group")
 	req.VolumeID = v.VolumeID
 	req.Name = name
-	_, err = d.Client.CloneVolume(&req)
+	req.Attributes = volumeAttributes(cloneOpts)
+	clone, err := d.Client.CloneVolume(&req)
 	if err != nil {
 		return fmt.Errorf("Failed to create clone: error: %v", err)
 	}
-	return nil
+	return d.addToVAG(clone)
 }
 
 // Destroy the requested docker volume
@@ -300,10 +567,18 @@ func (d *SolidfireSANStorageDriver) Destroy(name string) error {
 		return fmt.Errorf("Failed to retrieve volume named %s during Remove operation;  error: %v", name, err)
 	}
 	d.Client.DetachVolume(v)
+	if len(v.VolumePairs) > 0 {
+		d.unpair(v)
+	}
 	err = d.Client.DeleteVolume(v.VolumeID)
-	if err != nil {
-		// FIXME(jdg): Check if it's a "DNE" error in that case we're golden
+	if sfapi.IsNotFound(err) {
+		log.Debugf("Volume %v was already deleted", name)
+	} else if err != nil {
 		log.Error("Error encountered during delete: ", err)
+	} else if d.Config.PurgeOnDelete {
+		if err := d.Client.PurgeDeletedVolume(v.VolumeID); err != nil {
+			log.Error("Error encountered during purge: ", err)
+		}
 	}
 
 	// perform rediscovery to remove the deleted LUN
@@ -313,6 +588,40 @@ func (d *SolidfireSANStorageDriver) Destroy(name string) error {
 	return nil
 }
 
+// Restore brings back the most recently deleted volume of the given name that has not been purged yet
+func (d *SolidfireSANStorageDriver) Restore(name string) error {
+	log.Debugf("SolidfireSANStorageDriver#Restore(%v)", name)
+
+	deleted, err := d.Client.ListDeletedVolumes()
+	if err != nil {
+		return fmt.Errorf("Problem listing deleted volumes: %v", err)
+	}
+
+	var found *sfapi.Volume
+	for i, v := range deleted {
+		if v.Name != name || v.AccountID != d.TenantID {
+			continue
+		}
+		if found == nil || v.DeleteTime > found.DeleteTime {
+			found = &deleted[i]
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("No deleted volume named %v to restore", name)
+	}
+
+	if err := d.Client.RestoreDeletedVolume(found.VolumeID); err != nil {
+		return fmt.Errorf("Problem restoring volume %v: %v", name, err)
+	}
+	log.WithFields(log.Fields{
+		"volume":     name,
+		"volumeID":   found.VolumeID,
+		"deleteTime": found.DeleteTime,
+	}).Info("Restored deleted volume")
+
+	return d.addToVAG(*found)
+}
+
 // Attach the lun
 func (d *SolidfireSANStorageDriver) Attach(name, mountpoint string, opts map[string]string) error {
 	log.Debugf("SolidfireSANStorageDriver#Attach(%v, %v, %v)", name, mountpoint, opts)
@@ -321,6 +630,9 @@ func (d *SolidfireSANStorageDriver) Attach(name, mountpoint string, opts map[str
 	if err != nil {
 		return fmt.Errorf("Failed to retrieve volume by name in mount operation;  name: %v error: %v", name, err)
 	}
+	if err := d.addToVAG(v); err != nil {
+		return err
+	}
 	path, device, err := d.Client.AttachVolume(&v, d.InitiatorIFace)
 	if path == "" || device == "" && err == nil {
 		return fmt.Errorf("Problem attaching docker volume but err is nil;  path: %v device: %v", path, device)
@@ -330,8 +642,9 @@ func (d *SolidfireSANStorageDriver) Attach(name, mountpoint string, opts map[str
 	}
 	log.Debugf("Attached volume at (path, devfile): %s, %s", path, device)
 	if utils.GetFSType(device) == "" {
-		//TODO(jdg): Enable selection of *other* fs types
-		err := utils.FormatVolume(device, "ext4")
+		// format with the filesystem requested at create time
+		fsType := utils.GetV(volumeOptsFromAttributes(v.Attributes), "fstype", DefaultFileSystemType)
+		err := utils.FormatVolume(device, fsType)
 		if err != nil {
 			return fmt.Errorf("Failed to format device: %v error: %v", device, err)
 		}
@@ -358,7 +671,7 @@ func (d *SolidfireSANStorageDriver) Detach(name, mountpoint string) error {
 	}
 	d.Client.DetachVolume(v)
 
-	return nil
+	return d.removeFromVAG(v)
 }
 
 // DefaultStoragePrefix is the driver specific prefix for created storage, can be overridden in the config file
@@ -398,3 +711,623 @@ func (d *SolidfireSANStorageDriver) SnapshotList(name string) ([]CommonSnapshot,
 
 	return snapshots, nil
 }
+
+// Return the options the named volume was created with
+func (d *SolidfireSANStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	log.Debugf("SolidfireSANStorageDriver#GetVolumeOpts(%v)", name)
+
+	v, err := d.Client.GetVolumeByName(name, d.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve volume by name; name: %v error: %v", name, err)
+	}
+
+	return volumeOptsFromAttributes(v.Attributes), nil
+}
+
+// Return additional status of the named volume, including the state of any replication pairs
+func (d *SolidfireSANStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	v, err := d.Client.GetVolumeByName(name, d.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve volume by name; name: %v error: %v", name, err)
+	}
+
+	status := make(map[string]interface{})
+
+	groupReq := sfapi.ListGroupSnapshotsRequest{
+		Volumes: []int64{v.VolumeID},
+	}
+	groupSnapshots, err := d.Client.ListGroupSnapshots(&groupReq)
+	if err != nil {
+		return nil, fmt.Errorf("Problem listing group snapshots: %v", err)
+	}
+	if len(groupSnapshots) > 0 {
+		var names []string
+		for _, groupSnapshot := range groupSnapshots {
+			names = append(names, groupSnapshot.Name)
+		}
+		status["GroupSnapshots"] = names
+	}
+
+	req := sfapi.ListActivePairedVolumesRequest{
+		StartVolumeID: v.VolumeID,
+		Limit:         1,
+	}
+	paired, err := d.Client.ListActivePairedVolumes(&req)
+	if err != nil {
+		return nil, fmt.Errorf("Problem listing paired volumes: %v", err)
+	}
+	for _, p := range paired {
+		if p.VolumeID != v.VolumeID {
+			continue
+		}
+		var pairs []map[string]interface{}
+		for _, pair := range p.VolumePairs {
+			pairs = append(pairs, map[string]interface{}{
+				"ClusterPairID":    pair.ClusterPairID,
+				"RemoteVolumeID":   pair.RemoteVolumeID,
+				"RemoteVolumeName": pair.RemoteVolumeName,
+				"Mode":             pair.RemoteReplication.Mode,
+				"State":            pair.RemoteReplication.State,
+			})
+		}
+		status["Replication"] = pairs
+	}
+
+	return status, nil
+}
+
+// GroupSnapshot takes a crash-consistent snapshot of a set of volumes, given by name or else by group; each
+// volume's member snapshot carries the snapshot name, so it can be cloned with the fromSnapshot option
+func (d *SolidfireSANStorageDriver) GroupSnapshot(snapshot string, volumes []string, group string) error {
+	log.Debugf("SolidfireSANStorageDriver#GroupSnapshot(%v, %v, %v)", snapshot, volumes, group)
+
+	members, err := d.groupMembers(volumes, group)
+	if err != nil {
+		return err
+	}
+	var ids []int64
+	for _, member := range members {
+		ids = append(ids, member.VolumeID)
+	}
+
+	req := sfapi.CreateGroupSnapshotRequest{
+		Volumes:    ids,
+		Name:       snapshot,
+		Attributes: map[string]interface{}{"platform": "Docker-NDVP"},
+	}
+	groupSnapshot, err := d.Client.CreateGroupSnapshot(&req)
+	if err != nil {
+		return fmt.Errorf("Problem creating group snapshot %v: %v", snapshot, err)
+	}
+	log.WithFields(log.Fields{
+		"snapshot":        snapshot,
+		"groupSnapshotID": groupSnapshot.GroupSnapshotID,
+		"volumes":         ids,
+	}).Info("Created group snapshot")
+	return nil
+}
+
+// GroupRollback rolls the set of volumes back to the named group snapshot; the volumes should not be in use
+func (d *SolidfireSANStorageDriver) GroupRollback(snapshot string, volumes []string, group string) error {
+	log.Debugf("SolidfireSANStorageDriver#GroupRollback(%v, %v, %v)", snapshot, volumes, group)
+
+	groupSnapshot, _, err := d.findGroupSnapshot(snapshot, volumes, group)
+	if err != nil {
+		return err
+	}
+	req := sfapi.RollbackToGroupSnapshotRequest{
+		GroupSnapshotID: groupSnapshot.GroupSnapshotID,
+	}
+	if err := d.Client.RollbackToGroupSnapshot(&req); err != nil {
+		return fmt.Errorf("Problem rolling back to group snapshot %v: %v", snapshot, err)
+	}
+	return nil
+}
+
+// GroupSnapshotDelete deletes the named group snapshot of the set of volumes along with its member snapshots
+func (d *SolidfireSANStorageDriver) GroupSnapshotDelete(snapshot string, volumes []string, group string) error {
+	log.Debugf("SolidfireSANStorageDriver#GroupSnapshotDelete(%v, %v, %v)", snapshot, volumes, group)
+
+	groupSnapshot, _, err := d.findGroupSnapshot(snapshot, volumes, group)
+	if err != nil {
+		return err
+	}
+	if err := d.Client.DeleteGroupSnapshot(groupSnapshot.GroupSnapshotID, false); err != nil {
+		return fmt.Errorf("Problem deleting group snapshot %v: %v", snapshot, err)
+	}
+	return nil
+}
+
+// GroupClone clones every volume of the set from one group snapshot, so that the clones share its point in time.
+// Each clone is named after its source with suffix added, and is put in cloneGroup if one is given.  If any clone
+// cannot be created, those already created are deleted again, so the set of clones is made whole or not at all.
+func (d *SolidfireSANStorageDriver) GroupClone(snapshot string, volumes []string, group, suffix,
+	cloneGroup string) error {
+	log.Debugf("SolidfireSANStorageDriver#GroupClone(%v, %v, %v, %v, %v)", snapshot, volumes, group, suffix, cloneGroup)
+
+	if suffix == "" {
+		return fmt.Errorf("The suffix option is required to name the clones")
+	}
+	groupSnapshot, members, err := d.findGroupSnapshot(snapshot, volumes, group)
+	if err != nil {
+		return err
+	}
+	sources := make(map[int64]sfapi.Volume)
+	for _, member := range members {
+		if v, err := d.Client.GetVolumeByName(member.Name+suffix, d.TenantID); err == nil && v.VolumeID != 0 {
+			return fmt.Errorf("Volume %v already exists", v.Name)
+		}
+		sources[member.VolumeID] = member
+	}
+
+	var clones []sfapi.Volume
+	for _, memberSnapshot := range groupSnapshot.Members {
+		source := sources[memberSnapshot.VolumeID]
+		cloneOpts := CloneVolumeOpts(volumeOptsFromAttributes(source.Attributes), source.Name, snapshot)
+		delete(cloneOpts, "group")
+		if cloneGroup != "" {
+			cloneOpts["group"] = cloneGroup
+		}
+		req := sfapi.CloneVolumeRequest{
+			VolumeID:   source.VolumeID,
+			SnapshotID: memberSnapshot.SnapshotID,
+			Name:       source.Name + suffix,
+			Attributes: volumeAttributes(cloneOpts),
+		}
+		clone, err := d.Client.CloneVolume(&req)
+		if err == nil {
+			clones = append(clones, clone)
+			err = d.addToVAG(clone)
+		}
+		if err != nil {
+			for _, clone := range clones {
+				d.Client.DeleteVolume(clone.VolumeID)
+			}
+			return fmt.Errorf("Problem cloning volume %v from group snapshot %v: %v", source.Name, snapshot, err)
+		}
+	}
+	log.WithFields(log.Fields{
+		"snapshot":        snapshot,
+		"groupSnapshotID": groupSnapshot.GroupSnapshotID,
+		"clones":          len(clones),
+	}).Info("Cloned group snapshot")
+	return nil
+}
+
+// groupMembers returns the volumes named in the list, or if there is none those created with the group option
+func (d *SolidfireSANStorageDriver) groupMembers(volumes []string, group string) ([]sfapi.Volume, error) {
+	var members []sfapi.Volume
+
+	if len(volumes) > 0 {
+		for _, name := range volumes {
+			v, err := d.Client.GetVolumeByName(d.storageName(strings.TrimSpace(name)), d.TenantID)
+			if err != nil {
+				return nil, fmt.Errorf("Problem finding volume %v: %v", name, err)
+			}
+			members = append(members, v)
+		}
+		return members, nil
+	}
+
+	if group == "" {
+		return nil, fmt.Errorf("Either the volumes or the group option is required to select volumes")
+	}
+	listReq := sfapi.ListVolumesForAccountRequest{
+		AccountID: d.TenantID,
+	}
+	all, err := d.Client.ListVolumesForAccount(&listReq)
+	if err != nil {
+		return nil, fmt.Errorf("Problem listing volumes: %v", err)
+	}
+	for _, v := range all {
+		if v.Status == "active" && volumeOptsFromAttributes(v.Attributes)["group"] == group {
+			members = append(members, v)
+		}
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("No volumes found in group %v", group)
+	}
+	return members, nil
+}
+
+// findGroupSnapshot returns the most recent group snapshot with the given name that covers exactly the set of
+// volumes, along with the volumes
+func (d *SolidfireSANStorageDriver) findGroupSnapshot(snapshot string, volumes []string,
+	group string) (*sfapi.GroupSnapshot, []sfapi.Volume, error) {
+	members, err := d.groupMembers(volumes, group)
+	if err != nil {
+		return nil, nil, err
+	}
+	wanted := make(map[int64]bool)
+	var ids []int64
+	for _, member := range members {
+		wanted[member.VolumeID] = true
+		ids = append(ids, member.VolumeID)
+	}
+
+	req := sfapi.ListGroupSnapshotsRequest{
+		Volumes: ids,
+	}
+	groupSnapshots, err := d.Client.ListGroupSnapshots(&req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Problem listing group snapshots: %v", err)
+	}
+
+	var found *sfapi.GroupSnapshot
+	for i, groupSnapshot := range groupSnapshots {
+		if groupSnapshot.Name != snapshot || len(groupSnapshot.Members) != len(wanted) {
+			continue
+		}
+		covered := true
+		for _, member := range groupSnapshot.Members {
+			covered = covered && wanted[member.VolumeID]
+		}
+		if covered && (found == nil || groupSnapshot.CreateTime > found.CreateTime) {
+			found = &groupSnapshots[i]
+		}
+	}
+	if found == nil {
+		return nil, nil, fmt.Errorf("No group snapshot %v of volumes %v", snapshot, ids)
+	}
+	return found, members, nil
+}
+
+// storageName adds the storage prefix to a Docker volume name, as the Docker driver does for requests
+func (d *SolidfireSANStorageDriver) storageName(name string) string {
+	prefix := d.Config.StoragePrefix(d.DefaultStoragePrefix())
+	if strings.HasPrefix(name, prefix) {
+		return name
+	}
+	return prefix + name
+}
+
+// replicationTarget returns the configured replication target with the given name
+func (d *SolidfireSANStorageDriver) replicationTarget(name string) (*SolidfireReplicationTarget, error) {
+	for i, target := range d.Config.ReplicationTargets {
+		if target.Name == name {
+			return &d.Config.ReplicationTargets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Replication target %v is not defined in the configured ReplicationTargets", name)
+}
+
+// remoteClient returns a client for the replication target cluster and the ID of the account volumes are
+// created in there
+func (d *SolidfireSANStorageDriver) remoteClient(target *SolidfireReplicationTarget) (*sfapi.Client, int64, error) {
+	tenantName := target.TenantName
+	if tenantName == "" {
+		tenantName = d.Config.TenantName
+	}
+	cfg := sfapi.Config{
+		TenantName: tenantName,
+		EndPoint:   target.EndPoint,
+		VerifyTLS:  d.Config.VerifyTLS,
+		Timeout:    time.Duration(d.Config.APITimeout) * time.Second,
+	}
+	client, err := sfapi.NewFromParameters(target.EndPoint, d.Config.DefaultVolSz, "", cfg, tenantName)
+	if err != nil {
+		return nil, 0, err
+	}
+	accountID, err := solidfireAccountID(client, tenantName)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Problem finding account %v on %v: %v", tenantName, target.Name, err)
+	}
+	return client, accountID, nil
+}
+
+// replicate creates a volume of the same name and size on the target cluster and pairs it with the local volume;
+// the remote volume is removed again if pairing fails
+func (d *SolidfireSANStorageDriver) replicate(v sfapi.Volume, target *SolidfireReplicationTarget) error {
+	remote, accountID, err := d.remoteClient(target)
+	if err != nil {
+		return err
+	}
+
+	createReq := sfapi.CreateVolumeRequest{
+		Name:       v.Name,
+		AccountID:  accountID,
+		TotalSize:  v.TotalSize,
+		Enable512e: v.Enable512e,
+		Qos:        v.Qos,
+		Attributes: v.Attributes,
+	}
+	remoteVol, err := remote.CreateVolume(&createReq)
+	if err != nil {
+		return fmt.Errorf("Problem creating remote volume: %v", err)
+	}
+
+	pairErr := func() error {
+		// only replication may write to the remote volume
+		modifyReq := sfapi.ModifyVolumeRequest{
+			VolumeID: remoteVol.VolumeID,
+			Access:   "replicationTarget",
+		}
+		if err := remote.ModifyVolume(&modifyReq); err != nil {
+			return fmt.Errorf("Problem making remote volume a replication target: %v", err)
+		}
+
+		startReq := sfapi.StartVolumePairingRequest{
+			VolumeID: v.VolumeID,
+			Mode:     target.Mode,
+		}
+		key, err := d.Client.StartVolumePairing(&startReq)
+		if err != nil {
+			return fmt.Errorf("Problem starting volume pairing: %v", err)
+		}
+
+		completeReq := sfapi.CompleteVolumePairingRequest{
+			VolumeID:         remoteVol.VolumeID,
+			VolumePairingKey: key,
+		}
+		if err := remote.CompleteVolumePairing(&completeReq); err != nil {
+			d.Client.RemoveVolumePair(v.VolumeID)
+			return fmt.Errorf("Problem completing volume pairing: %v", err)
+		}
+		return nil
+	}()
+	if pairErr != nil {
+		remote.DeleteVolume(remoteVol.VolumeID)
+		return pairErr
+	}
+
+	log.WithFields(log.Fields{
+		"volume":         v.Name,
+		"target":         target.Name,
+		"remoteVolumeID": remoteVol.VolumeID,
+	}).Info("Paired volume with replication target")
+	return nil
+}
+
+// unpair removes the volume's replication pairs on both clusters so it can be deleted; the remote volumes are
+// kept as the last replicated copy.  Problems are logged rather than returned, as for the rest of Destroy.
+func (d *SolidfireSANStorageDriver) unpair(v sfapi.Volume) {
+	if err := d.Client.RemoveVolumePair(v.VolumeID); err != nil {
+		log.Warnf("Problem removing volume pair of %v: %v", v.Name, err)
+	}
+
+	targetName := volumeOptsFromAttributes(v.Attributes)["replicateTo"]
+	target, err := d.replicationTarget(targetName)
+	if err != nil {
+		log.Warnf("Cannot remove remote volume pair of %v: %v", v.Name, err)
+		return
+	}
+	remote, _, err := d.remoteClient(target)
+	if err != nil {
+		log.Warnf("Cannot remove remote volume pair of %v: %v", v.Name, err)
+		return
+	}
+	for _, pair := range v.VolumePairs {
+		if err := remote.RemoveVolumePair(pair.RemoteVolumeID); err != nil {
+			log.Warnf("Problem removing volume pair of remote volume %v: %v", pair.RemoteVolumeID, err)
+		}
+	}
+}
+
+// SolidfireEndpoint returns the JSON-RPC endpoint URL for the config, either EndPoint as given or one built from
+// MVIP, Username, Password and APIVersion
+func SolidfireEndpoint(c *SolidfireStorageDriverConfig) (string, error) {
+	if c.EndPoint != "" {
+		if c.MVIP != "" || c.Username != "" || c.Password != "" || c.APIVersion != "" {
+			return "", fmt.Errorf("Set either EndPoint or MVIP, Username, Password and APIVersion in SolidFire Docker config")
+		}
+		return c.EndPoint, nil
+	}
+	if c.MVIP == "" || c.Username == "" || c.Password == "" {
+		return "", fmt.Errorf("MVIP, Username and Password, or EndPoint, required in SolidFire Docker config")
+	}
+
+	version := c.APIVersion
+	if version == "" {
+		version = DefaultSolidfireAPIVersion
+	}
+	if _, err := strconv.ParseFloat(version, 64); err != nil {
+		return "", fmt.Errorf("Invalid APIVersion %v in SolidFire Docker config", version)
+	}
+	endpoint := url.URL{
+		Scheme: "https",
+		User:   url.UserPassword(c.Username, c.Password),
+		Host:   c.MVIP,
+		Path:   "/json-rpc/" + version,
+	}
+	return endpoint.String(), nil
+}
+
+// DefaultSolidfireAPIVersion is the Element API version used when APIVersion is not set; volume pairing and group
+// snapshots need at least this version
+const DefaultSolidfireAPIVersion = "8.0"
+
+// apiVersion returns the API version at the end of an endpoint URL, or "" if there is none
+func apiVersion(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Path[strings.LastIndex(u.Path, "/")+1:]
+}
+
+// checkSolidfireCluster makes sure the cluster can be reached and supports the API version in use, if the
+// endpoint names one
+func checkSolidfireCluster(client *sfapi.Client, version string) error {
+	api, err := client.GetAPI()
+	if err != nil {
+		return fmt.Errorf("Problem reaching SolidFire cluster at %v: %v", sfapi.RedactEndpoint(client.Endpoint), err)
+	}
+	_, err = strconv.ParseFloat(version, 64)
+	supported := err != nil
+	for _, v := range api.SupportedVersions {
+		supported = supported || v == version
+	}
+	if !supported {
+		return fmt.Errorf("SolidFire cluster does not support API version %v; current version %v, supported versions %v",
+			version, api.CurrentVersion, api.SupportedVersions)
+	}
+
+	info, err := client.GetClusterInfo()
+	if err != nil {
+		return fmt.Errorf("Problem reading SolidFire cluster info: %v", err)
+	}
+	log.WithFields(log.Fields{
+		"cluster":    info.Name,
+		"mvip":       info.MVIP,
+		"svip":       info.SVIP,
+		"apiVersion": version,
+	}).Info("Connected to SolidFire cluster")
+	return nil
+}
+
+// solidfireAccountID looks up the named account, creating it if it does not exist
+func solidfireAccountID(client *sfapi.Client, tenantName string) (int64, error) {
+	req := sfapi.GetAccountByNameRequest{
+		Name: tenantName,
+	}
+	account, err := client.GetAccountByName(&req)
+	if err == nil {
+		return account.AccountID, nil
+	}
+	if !sfapi.IsNotFound(err) {
+		return 0, err
+	}
+
+	addReq := sfapi.AddAccountRequest{
+		Username: tenantName,
+	}
+	return client.AddAccount(&addReq)
+}
+
+// initializeVAG finds the volume access group named for the tenant, creating it if needed, and makes sure
+// it holds the initiator IQNs of this host
+func (d *SolidfireSANStorageDriver) initializeVAG() error {
+	iqns, err := utils.GetInitiatorIqns()
+	if err != nil {
+		return fmt.Errorf("Problem reading initiator IQNs: %v", err)
+	}
+	if len(iqns) == 0 {
+		return fmt.Errorf("No initiator IQNs found on this host")
+	}
+
+	vags, err := d.Client.ListVolumeAccessGroups(&sfapi.ListVolumeAccessGroupsRequest{})
+	if err != nil {
+		return fmt.Errorf("Problem listing volume access groups: %v", err)
+	}
+
+	for _, vag := range vags {
+		if vag.Name != d.Config.TenantName {
+			continue
+		}
+		d.VagID = vag.VAGID
+
+		var missing []string
+		for _, iqn := range iqns {
+			found := false
+			for _, initiator := range vag.Initiators {
+				if strings.EqualFold(initiator, iqn) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = append(missing, iqn)
+			}
+		}
+		if len(missing) > 0 {
+			log.Debugf("Adding initiators %v to volume access group %v", missing, vag.VAGID)
+			req := sfapi.AddInitiatorsToVolumeAccessGroupRequest{
+				Initiators: missing,
+				VAGID:      vag.VAGID,
+			}
+			if err := d.Client.AddInitiatorsToVolumeAccessGroup(&req); err != nil {
+				return fmt.Errorf("Problem adding initiators to volume access group %v: %v", vag.VAGID, err)
+			}
+		}
+		return nil
+	}
+
+	req := sfapi.CreateVolumeAccessGroupRequest{
+		Name:       d.Config.TenantName,
+		Initiators: iqns,
+	}
+	vagID, err := d.Client.CreateVolumeAccessGroup(&req)
+	if err != nil {
+		return fmt.Errorf("Problem creating volume access group %v: %v", d.Config.TenantName, err)
+	}
+	if vagID == 0 {
+		return fmt.Errorf("Volume access group %v was not created", d.Config.TenantName)
+	}
+	log.Debugf("Created volume access group %v with ID %v", d.Config.TenantName, vagID)
+	d.VagID = vagID
+	return nil
+}
+
+// addToVAG grants this host access to the volume when useVAG is set; it is a no-op for CHAP
+func (d *SolidfireSANStorageDriver) addToVAG(v sfapi.Volume) error {
+	if !d.Config.UseVAG || volumeInVAG(v, d.VagID) {
+		return nil
+	}
+	if err := d.Client.AddVolumeToAccessGroup(d.VagID, []int64{v.VolumeID}); err != nil {
+		return fmt.Errorf("Problem adding volume %v to volume access group %v: %v", v.Name, d.VagID, err)
+	}
+	return nil
+}
+
+// removeFromVAG takes a detached volume out of the volume access group, unless another host still has an iSCSI
+// session to it: the group is shared by every host of the tenant, so removing the volume would cut those off.
+// Attach adds the volume back.
+func (d *SolidfireSANStorageDriver) removeFromVAG(v sfapi.Volume) error {
+	if !d.Config.UseVAG || !volumeInVAG(v, d.VagID) {
+		return nil
+	}
+	sessions, err := d.Client.ListISCSISessions()
+	if err != nil {
+		return fmt.Errorf("Problem listing iSCSI sessions: %v", err)
+	}
+	for _, session := range sessions {
+		if session.VolumeID == v.VolumeID {
+			log.Debugf("Leaving volume %v in volume access group %v for session of %v", v.Name, d.VagID,
+				session.InitiatorName)
+			return nil
+		}
+	}
+	if err := d.Client.RemoveVolumesFromAccessGroup(d.VagID, []int64{v.VolumeID}); err != nil {
+		return fmt.Errorf("Problem removing volume %v from volume access group %v: %v", v.Name, d.VagID, err)
+	}
+	return nil
+}
+
+// volumeInVAG reports whether the volume is already a member of the volume access group
+func volumeInVAG(v sfapi.Volume, vagID int64) bool {
+	for _, id := range v.VolumeAccessGroups {
+		if id == vagID {
+			return true
+		}
+	}
+	return false
+}
+
+// volumeAttributes builds the SolidFire attributes stored with a volume, including the options it was created with
+func volumeAttributes(opts map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"platform": "Docker-NDVP",
+		"options":  opts,
+	}
+}
+
+// volumeOptsFromAttributes extracts the create options stored by volumeAttributes; volumes
+// created before options were recorded yield an empty map
+func volumeOptsFromAttributes(attributes interface{}) map[string]string {
+	opts := make(map[string]string)
+
+	attrs, ok := attributes.(map[string]interface{})
+	if !ok {
+		return opts
+	}
+	stored, ok := attrs["options"].(map[string]interface{})
+	if !ok {
+		return opts
+	}
+	for k, v := range stored {
+		if value, ok := v.(string); ok {
+			opts[k] = value
+		}
+	}
+	return opts
+}
@@ -5,8 +5,10 @@ package storage_drivers
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/netapp/netappdvp/apis/sfapi"
+	"github.com/netapp/netappdvp/utils"
 )
 
 // CurrentDriverVersion is the expected version in the config file
@@ -48,16 +50,54 @@ func ValidateCommonSettings(configJSON string) (*CommonStorageDriverConfig, erro
 	return config, nil
 }
 
+// StoragePrefix returns the storagePrefix from the config file, or defaultPrefix if it is not set; an empty
+// string in the config file means volumes have no prefix
+func (c CommonStorageDriverConfig) StoragePrefix(defaultPrefix string) string {
+	s := string(c.StoragePrefixRaw) // this is a raw version of the json value, we will get quotes in it
+	if len(s) < 2 {
+		return defaultPrefix
+	}
+	if s == "\"\"" {
+		return ""
+	}
+	// trim quotes from start and end of string
+	return s[1 : len(s)-1]
+}
+
+// OntapStorageDriverConfigDefaults holds config file defaults for ONTAP volume create options
+type OntapStorageDriverConfigDefaults struct {
+	Deduplication       string `json:"deduplication"`       // true or false
+	Compression         string `json:"compression"`         // none, background or inline
+	Encryption          string `json:"encryption"`          // true or false
+	TieringPolicy       string `json:"tieringPolicy"`       // none, snapshot-only, auto or backup
+	SnapshotReserve     string `json:"snapshotReserve"`     // percent of the volume
+	SecurityStyle       string `json:"securityStyle"`       // unix, ntfs or mixed
+	AutosizeMode        string `json:"autosizeMode"`        // off, grow or grow_shrink
+	AutosizeMaximumSize string `json:"autosizeMaximumSize"` // such as 10g
+}
+
 // OntapStorageDriverConfig holds settings for OntapStorageDrivers
 type OntapStorageDriverConfig struct {
-	CommonStorageDriverConfig        // embedded types replicate all fields
-	ManagementLIF             string `json:"managementLIF"`
-	DataLIF                   string `json:"dataLIF"`
-	IgroupName                string `json:"igroupName"`
-	SVM                       string `json:"svm"`
-	Username                  string `json:"username"`
-	Password                  string `json:"password"`
-	Aggregate                 string `json:"aggregate"`
+	CommonStorageDriverConfig          // embedded types replicate all fields
+	ManagementLIF             string   `json:"managementLIF"`
+	DataLIF                   string   `json:"dataLIF"`
+	IgroupName                string   `json:"igroupName"`
+	SVM                       string   `json:"svm"`
+	Username                  string   `json:"username"`
+	Password                  string   `json:"password"`
+	Aggregate                 string   `json:"aggregate"`
+	Aggregates                []string `json:"aggregates"`           // optional, additional aggregates to place volumes on
+	AggregatePolicy           string   `json:"aggregatePolicy"`      // mostFree (default) or roundRobin
+	CloneDestroyPolicy        string   `json:"cloneDestroyPolicy"`   // refuse (default), split or defer
+	APITransport              string   `json:"apiTransport"`         // zapi (default) or rest
+	EmsHeartbeatInterval      string   `json:"emsHeartbeatInterval"` // such as 12h, 24h by default, 0 for none
+	CIFSUsername              string   `json:"cifsUsername"`         // ontap-cifs, the user that mounts shares
+	CIFSPassword              string   `json:"cifsPassword"`         // ontap-cifs, the password of cifsUsername
+	CIFSDomain                string   `json:"cifsDomain"`           // ontap-cifs, optional, the domain of cifsUsername
+	LunsPerFlexvol            string   `json:"lunsPerFlexvol"`       // ontap-san-economy, 50 to 200, 100 by default
+	MaxRecords                string   `json:"maxRecords"`           // records per page of list calls, 100 by default
+
+	OntapStorageDriverConfigDefaults // create option defaults, at the top level of the config file
 }
 
 // ESeriesStorageDriverConfig holds settings for ESeriesStorageDriver
@@ -80,17 +120,36 @@ type ESeriesStorageDriverConfig struct {
 
 	//Host Networking
 	HostDataIP string `json:"hostData_IP"` //for iSCSI can be either port if multipathing is setup
+	HostGroup  string `json:"hostGroup"`   //optional, host group shared by the hosts of the cluster
+	HostType   string `json:"hostType"`    //optional, type of the hosts created on the array
 }
 
 // SolidfireStorageDriverConfig holds settings for SolidfireStorageDrivers
 type SolidfireStorageDriverConfig struct {
 	CommonStorageDriverConfig // embedded types replicate all fields
 	TenantName                string
-	EndPoint                  string
-	DefaultVolSz              int64 //Default volume size in GiB
+	EndPoint                  string //https://<Username>:<Password>@<MVIP>/json-rpc/<APIVersion>, or set these instead
+	MVIP                      string
+	Username                  string
+	Password                  string
+	APIVersion                string //Element API version, DefaultSolidfireAPIVersion if not set
+	DefaultVolSz              int64  //Default volume size in GiB
 	SVIP                      string
 	InitiatorIFace            string //iface to use of iSCSI initiator
 	Types                     *[]sfapi.VolType
+	UseVAG                    bool                         //grant access through a volume access group instead of CHAP
+	ReplicationTargets        []SolidfireReplicationTarget //remote clusters named by the replicateTo option
+	PurgeOnDelete             bool                         //purge removed volumes at once instead of keeping them restorable
+	VerifyTLS                 bool                         //verify the cluster's TLS certificate
+	APITimeout                int                          //seconds to wait for each API request
+}
+
+// SolidfireReplicationTarget is a paired SolidFire cluster that volumes can be replicated to
+type SolidfireReplicationTarget struct {
+	Name       string //value of the replicateTo option
+	EndPoint   string
+	TenantName string //defaults to the local TenantName
+	Mode       string //Async (default), Sync or SnapshotsOnly
 }
 
 // CommonSnapshot contains the normalized volume snapshot format we report to Docker
@@ -99,6 +158,56 @@ type CommonSnapshot struct {
 	Created string // The UTC time that the snapshot was created, in RFC3339 format
 }
 
+// Option keys recorded alongside the create options when a volume is cloned
+const (
+	CloneSourceOpt   = "from"
+	CloneSnapshotOpt = "fromSnapshot"
+)
+
+// EncodeVolumeOpts serializes the options a volume was created with so they can be stored on the storage system
+func EncodeVolumeOpts(opts map[string]string) (string, error) {
+	if opts == nil {
+		opts = make(map[string]string)
+	}
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return "", fmt.Errorf("Cannot encode volume options: %v", err)
+	}
+	return string(b), nil
+}
+
+// DecodeVolumeOpts is the reverse of EncodeVolumeOpts; an empty string decodes to an empty set of options
+func DecodeVolumeOpts(s string) (map[string]string, error) {
+	opts := make(map[string]string)
+	if s == "" {
+		return opts, nil
+	}
+	if err := json.Unmarshal([]byte(s), &opts); err != nil {
+		return make(map[string]string), fmt.Errorf("Cannot decode volume options: %v", err)
+	}
+	return opts, nil
+}
+
+// DefaultFileSystemType is used to format block devices when the fstype option is not specified
+const DefaultFileSystemType = "ext4"
+
+// ValidateFileSystemType checks the fstype option against the filesystems we know how to create
+func ValidateFileSystemType(fsType string) error {
+	switch fsType {
+	case "ext3", "ext4", "xfs":
+		return nil
+	}
+	return fmt.Errorf("Unsupported fstype: %v, expected one of ext3, ext4 or xfs", fsType)
+}
+
+// CloneVolumeOpts returns the options to record for a clone; the source volume's options are inherited
+func CloneVolumeOpts(sourceOpts map[string]string, source, snapshot string) map[string]string {
+	opts := utils.CopyOpts(sourceOpts)
+	opts[CloneSourceOpt] = source
+	opts[CloneSnapshotOpt] = snapshot
+	return opts
+}
+
 // Drivers is a map of driver names -> object
 var Drivers = make(map[string]StorageDriver)
 
@@ -115,4 +224,102 @@ type StorageDriver interface {
 	DefaultStoragePrefix() string
 	DefaultSnapshotPrefix() string
 	SnapshotList(name string) ([]CommonSnapshot, error)
+	GetVolumeOpts(name string) (map[string]string, error)
+	GetVolumeStatus(name string) (map[string]interface{}, error)
+}
+
+// VolumeModifier is implemented by drivers that can change the options of an existing volume in place
+type VolumeModifier interface {
+	Modify(name string, opts map[string]string) error
+}
+
+// GroupSnapshotter is implemented by drivers that can snapshot a set of volumes together at a single point in
+// time, and clone, roll back or delete those snapshots; the set is given by volume names or else by group
+type GroupSnapshotter interface {
+	GroupSnapshot(snapshot string, volumes []string, group string) error
+	GroupClone(snapshot string, volumes []string, group, suffix, cloneGroup string) error
+	GroupRollback(snapshot string, volumes []string, group string) error
+	GroupSnapshotDelete(snapshot string, volumes []string, group string) error
+}
+
+// VolumeCommandUsage describes the commands run by RunVolumeCommand, for the plugin's usage message
+const VolumeCommandUsage = `Commands, run once on any host instead of the plugin, for drivers that support them:
+  modify <volume> key=value...
+        change the options of an existing volume, such as size=20 or qos=1000,2000,4000; this command is the
+        only way to modify a volume, as Docker has no request for it
+  groupSnapshot <snapshot> volumes=<volume>,<volume> | group=<group>
+        snapshot a set of volumes together at a single point in time
+  groupClone <snapshot> volumes=... | group=... suffix=<suffix> [cloneGroup=<group>]
+        clone each volume of the set from the group snapshot, naming the clone after its source with suffix added
+  groupRollback <snapshot> volumes=... | group=...
+        roll the set of volumes back to the group snapshot
+  groupSnapshotDelete <snapshot> volumes=... | group=...
+        delete the group snapshot of the set of volumes
+A group is every volume created with the same -o group=<group> option, which is recorded with the volume on the
+storage.  It is not a Docker volume label: Docker does not pass the labels of docker volume create --label to
+plugins, so volumes cannot be selected by label.
+`
+
+// RunVolumeCommand performs a one-off operation on existing volumes, given on the plugin's command line after
+// the flags as a command, its target and key=value options, such as "modify vol1 size=20" or
+// "groupSnapshot nightly group=app1".  Docker only asks the plugin to create or remove volumes, and repeats a
+// create on each host that does not know the volume yet, so operations that must happen exactly once are run
+// this way by an administrator instead.  Volume names are given the storage prefix as the plugin does for
+// Docker requests.
+func RunVolumeCommand(sd StorageDriver, config CommonStorageDriverConfig, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("Expected a command and its target, such as: modify vol1 size=20")
+	}
+	command, target := args[0], args[1]
+
+	opts := make(map[string]string)
+	for _, arg := range args[2:] {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return fmt.Errorf("Invalid option %v, expected key=value", arg)
+		}
+		opts[kv[0]] = kv[1]
+	}
+
+	switch command {
+	case "modify":
+		modifier, ok := sd.(VolumeModifier)
+		if !ok {
+			return fmt.Errorf("The %v driver cannot modify volumes", sd.Name())
+		}
+		name := target
+		if prefix := config.StoragePrefix(sd.DefaultStoragePrefix()); !strings.HasPrefix(name, prefix) {
+			name = prefix + name
+		}
+		return modifier.Modify(name, opts)
+
+	case "groupSnapshot", "groupClone", "groupRollback", "groupSnapshotDelete":
+		snapshotter, ok := sd.(GroupSnapshotter)
+		if !ok {
+			return fmt.Errorf("The %v driver does not support group snapshots", sd.Name())
+		}
+		var volumes []string
+		for k, v := range opts {
+			switch {
+			case k == "volumes":
+				volumes = strings.Split(v, ",")
+			case k == "group":
+			case command == "groupClone" && (k == "suffix" || k == "cloneGroup"):
+			default:
+				return fmt.Errorf("Unknown option %v for %v, expected volumes or group", k, command)
+			}
+		}
+		switch command {
+		case "groupSnapshot":
+			return snapshotter.GroupSnapshot(target, volumes, opts["group"])
+		case "groupClone":
+			return snapshotter.GroupClone(target, volumes, opts["group"], opts["suffix"], opts["cloneGroup"])
+		case "groupRollback":
+			return snapshotter.GroupRollback(target, volumes, opts["group"])
+		default:
+			return snapshotter.GroupSnapshotDelete(target, volumes, opts["group"])
+		}
+	}
+	return fmt.Errorf("Unknown command %v, expected modify, groupSnapshot, groupClone, groupRollback or "+
+		"groupSnapshotDelete", command)
 }
@@ -32,3 +32,31 @@ func TestStorageDrivers(t *testing.T) {
 		t.Error("Expected to have at least OntapNAS and OntapSAN in the list of storage drivers")
 	}
 }
+
+func TestEncodeDecodeVolumeOpts(t *testing.T) {
+	log.Debug("Running TestEncodeDecodeVolumeOpts...")
+
+	opts := map[string]string{"size": "1g", "fstype": "xfs"}
+	encoded, err := EncodeVolumeOpts(opts)
+	if err != nil {
+		t.Fatalf("Unexpected error encoding options: %v", err)
+	}
+
+	decoded, err := DecodeVolumeOpts(encoded)
+	if err != nil {
+		t.Fatalf("Unexpected error decoding options: %v", err)
+	}
+	if len(decoded) != len(opts) || decoded["size"] != "1g" || decoded["fstype"] != "xfs" {
+		t.Errorf("Expected %v, got %v", opts, decoded)
+	}
+
+	// volumes created before options were recorded have no metadata
+	decoded, err = DecodeVolumeOpts("")
+	if err != nil || len(decoded) != 0 {
+		t.Errorf("Expected empty options for empty metadata, got %v, %v", decoded, err)
+	}
+
+	if err := ValidateFileSystemType("btrfs"); err == nil {
+		t.Error("Expected an error for an unsupported filesystem type")
+	}
+}
@@ -92,3 +92,15 @@ func (d *FakeStorageDriver) SnapshotList(name string) ([]storage_drivers.CommonS
   //TODO: Add necessary stuff here
   return snapshots, nil
 }
+
+func (d *FakeStorageDriver) GetVolumeOpts(name string) (map[string]string, error) {
+	log.Debugf("FakeStorageDriver.GetVolumeOpts()- name: %v", name)
+  //TODO: Add logic once theres a need
+  return map[string]string{}, nil
+}
+
+func (d *FakeStorageDriver) GetVolumeStatus(name string) (map[string]interface{}, error) {
+	log.Debugf("FakeStorageDriver.GetVolumeStatus()- name: %v", name)
+  //TODO: Add logic once theres a need
+  return map[string]interface{}{}, nil
+}
@@ -477,11 +477,16 @@ func GetFSType(device string) string {
 func FormatVolume(device, fsType string) error {
 	log.Debugf("Begin osutils.FormatVolume: %s, %s", device, fsType)
 	cmd := "mkfs.ext4"
-	if fsType == "xfs" {
+	force := "-F"
+	switch fsType {
+	case "xfs":
 		cmd = "mkfs.xfs"
+		force = "-f"
+	case "ext3":
+		cmd = "mkfs.ext3"
 	}
 	log.Debug("Perform ", cmd, " on device: ", device)
-	out, err := exec.Command(cmd, "-F", device).CombinedOutput()
+	out, err := exec.Command(cmd, force, device).CombinedOutput()
 	log.Debug("Result of mkfs cmd: ", string(out))
 	return err
 }
@@ -524,6 +529,31 @@ func IscsiadmCmd(args []string) ([]byte, error) {
 	return resp, err
 }
 
+// LoginWithoutChap will login to the iscsi target without authentication, relying on the target to admit the initiator
+func LoginWithoutChap(tiqn, portal, iface string) error {
+	log.Debugf("Begin osutils.LoginWithoutChap: iqn: %s, portal: %s, iface: %s", tiqn, portal, iface)
+	args := []string{"-m", "node", "-T", tiqn, "-p", portal + ":3260"}
+	createArgs := append(args, []string{"--interface", iface, "--op", "new"}...)
+
+	if _, err := exec.Command("iscsiadm", createArgs...).CombinedOutput(); err != nil {
+		log.Error("Error running iscsiadm node create: ", err)
+		return err
+	}
+
+	authMethodArgs := append(args, []string{"--op=update", "--name", "node.session.auth.authmethod", "--value=None"}...)
+	if out, err := exec.Command("iscsiadm", authMethodArgs...).CombinedOutput(); err != nil {
+		log.Error("Error running iscsiadm set authmethod: ", err, "{", out, "}")
+		return err
+	}
+
+	loginArgs := append(args, []string{"--login"}...)
+	if _, err := exec.Command("iscsiadm", loginArgs...).CombinedOutput(); err != nil {
+		log.Error("Error running iscsiadm login: ", err)
+		return err
+	}
+	return nil
+}
+
 // LoginWithChap will login to the iscsi target with the supplied credentials
 func LoginWithChap(tiqn, portal, username, password, iface string) error {
 	log.Debugf("Begin osutils.LoginWithChap: iqn: %s, portal: %s, username: %s, password=xxxx, iface: %s", tiqn, portal, username, iface)
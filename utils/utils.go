@@ -138,3 +138,12 @@ func GetV(opts map[string]string, key string, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// CopyOpts returns a copy of the supplied options map; a nil map yields an empty copy
+func CopyOpts(opts map[string]string) map[string]string {
+	c := make(map[string]string, len(opts))
+	for k, v := range opts {
+		c[k] = v
+	}
+	return c
+}